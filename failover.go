@@ -0,0 +1,590 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchtransport"
+)
+
+// HeaderFailoverCluster is the name of the response header set by the failover client
+// to the name of the cluster which served the request.
+const HeaderFailoverCluster = "X-Opensearch-Cluster"
+
+const (
+	defaultFailoverErrorRateThreshold = 0.5
+	defaultFailoverErrorRateWindow    = time.Minute
+	defaultFailoverMinRequests        = 10
+	defaultFailbackDelay              = 5 * time.Minute
+	defaultFailoverMaxConnErrors      = 1
+
+	failoverWindowBuckets = 10
+)
+
+// Reasons for switching the active cluster.
+const (
+	FailoverReasonNoLiveNodes = "no live nodes"
+	FailoverReasonErrorRate   = "error rate exceeded"
+	FailoverReasonFailback    = "failback delay elapsed"
+	FailoverReasonManual      = "manual failback"
+)
+
+// FailbackPolicy defines when the failover client returns to the primary cluster.
+type FailbackPolicy int
+
+// Failback policies.
+const (
+	// FailbackAfterDelay returns to the primary cluster once FailbackDelay has elapsed since the failover
+	// and the primary cluster has live nodes.
+	FailbackAfterDelay FailbackPolicy = iota
+	// FailbackManual stays on the current cluster until Failback is called.
+	FailbackManual
+)
+
+// ClusterConfig represents the configuration of a cluster used by the failover client.
+type ClusterConfig struct {
+	Name   string // Name of the cluster, reported in the metrics and the X-Opensearch-Cluster response header.
+	Config Config // Client configuration for the cluster, such as the addresses, credentials and signer.
+}
+
+// FailoverConfig represents the configuration of the failover client.
+type FailoverConfig struct {
+	// Clusters in the order of preference; the first cluster is the primary one.
+	Clusters []ClusterConfig
+
+	ErrorRateThreshold float64       // Fail over when the ratio of failed requests exceeds the threshold. Default: 0.5.
+	ErrorRateWindow    time.Duration // Time window for the error rate. Default: 1m.
+	MinRequests        int           // Minimum number of requests in the window to evaluate the error rate. Default: 10.
+
+	// Consider a cluster with a single connection, eg. the address of a load balancer, to have no live nodes
+	// after this many consecutive request errors within the error rate window, as the connection
+	// is never marked dead. Default: 1.
+	MaxConnectionErrors int
+
+	Failback      FailbackPolicy // Default: FailbackAfterDelay.
+	FailbackDelay time.Duration  // Default: 5m.
+
+	// Optional callback for changes of the active cluster. Default: nil.
+	// It is called synchronously and must not perform requests with the same client.
+	OnFailover func(from, to, reason string)
+}
+
+// FailoverMetrics represents the metrics of the failover client.
+type FailoverMetrics struct {
+	Active    string           `json:"active"`
+	Failovers int              `json:"failovers"` // Switches to a secondary cluster.
+	Failbacks int              `json:"failbacks"` // Switches back to the primary cluster.
+	Clusters  []ClusterMetrics `json:"clusters"`
+}
+
+// ClusterMetrics represents the metrics of a cluster used by the failover client.
+type ClusterMetrics struct {
+	Name      string  `json:"name"`
+	Active    bool    `json:"active"`
+	Requests  int     `json:"requests"`
+	Failures  int     `json:"failures"`
+	ErrorRate float64 `json:"error_rate"` // Error rate within the window.
+
+	Transport *opensearchtransport.Metrics `json:"transport,omitempty"` // Only when EnableMetrics is set for the cluster.
+}
+
+// FailoverTransport performs requests against the active cluster,
+// and switches to the next cluster when the active one has no live nodes
+// or its error rate exceeds the threshold.
+type FailoverTransport struct {
+	sync.Mutex
+
+	clusters  []*failoverCluster
+	active    int
+	failovers int
+	failbacks int
+	since     time.Time // Time of the last switch away from the primary cluster.
+
+	threshold     float64
+	minRequests   int
+	maxConnErrors int
+	connErrorTTL  time.Duration
+	failback      FailbackPolicy
+	failbackDelay time.Duration
+	onFailover    func(from, to, reason string)
+
+	now func() time.Time
+}
+
+type failoverCluster struct {
+	name      string
+	transport opensearchtransport.Interface
+	window    errorWindow
+
+	requests int
+	failures int
+
+	connErrors    int       // Consecutive request errors.
+	lastConnError time.Time // Time of the last request error.
+}
+
+type failoverEvent struct {
+	from, to, reason string
+}
+
+// NewFailoverClient creates a new client which fails over between the clusters from cfg.
+func NewFailoverClient(cfg FailoverConfig) (*Client, error) {
+	tp, err := NewFailoverTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &Client{Transport: tp}
+	client.API = opensearchapi.New(client)
+
+	return client, nil
+}
+
+// NewFailoverTransport creates a new failover transport with a client for each cluster from cfg.
+func NewFailoverTransport(cfg FailoverConfig) (*FailoverTransport, error) {
+	if len(cfg.Clusters) == 0 {
+		return nil, errors.New("cannot create failover client: no clusters")
+	}
+
+	t := FailoverTransport{
+		threshold:     cfg.ErrorRateThreshold,
+		minRequests:   cfg.MinRequests,
+		maxConnErrors: cfg.MaxConnectionErrors,
+		failback:      cfg.Failback,
+		failbackDelay: cfg.FailbackDelay,
+		onFailover:    cfg.OnFailover,
+		now:           time.Now,
+	}
+
+	if t.threshold <= 0 {
+		t.threshold = defaultFailoverErrorRateThreshold
+	}
+	if t.minRequests <= 0 {
+		t.minRequests = defaultFailoverMinRequests
+	}
+	if t.failbackDelay <= 0 {
+		t.failbackDelay = defaultFailbackDelay
+	}
+	if t.maxConnErrors <= 0 {
+		t.maxConnErrors = defaultFailoverMaxConnErrors
+	}
+
+	window := cfg.ErrorRateWindow
+	if window <= 0 {
+		window = defaultFailoverErrorRateWindow
+	}
+	t.connErrorTTL = window
+
+	names := make(map[string]bool, len(cfg.Clusters))
+	for i, cc := range cfg.Clusters {
+		name := cc.Name
+		if name == "" {
+			name = "cluster-" + strconv.Itoa(i)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("cannot create failover client: duplicate cluster name %q", name)
+		}
+		names[name] = true
+
+		if len(cc.Config.Addresses) == 0 {
+			return nil, fmt.Errorf("cannot create failover client: cluster %q has no addresses", name)
+		}
+
+		client, err := NewClient(cc.Config)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create failover client: cluster %q: %w", name, err)
+		}
+
+		t.clusters = append(t.clusters, &failoverCluster{
+			name:      name,
+			transport: client,
+			window:    newErrorWindow(window),
+		})
+	}
+
+	return &t, nil
+}
+
+// Perform executes the request against the active cluster.
+//
+// When the request fails with an error and the cluster has no live nodes left,
+// the request is retried once against the next cluster.
+//
+// The body is read again with GetBody for the retry; it is only buffered when GetBody isn't set.
+func (t *FailoverTransport) Perform(req *http.Request) (*http.Response, error) {
+	var tmpl *http.Request
+
+	if len(t.clusters) > 1 {
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			body, err := io.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("cannot read request body: %w", err)
+			}
+			setFailoverReqBody(req, body)
+		}
+		tmpl = req.Clone(req.Context())
+	}
+
+	idx := t.pick()
+	res, err := t.perform(idx, req)
+	if err != nil && tmpl != nil && !isLocalError(err) {
+		if next := t.pick(); next != idx {
+			retry := tmpl.Clone(tmpl.Context())
+			if retry.GetBody != nil {
+				body, bodyErr := retry.GetBody()
+				if bodyErr != nil {
+					return res, fmt.Errorf("cannot read request body: %w", bodyErr)
+				}
+				retry.Body = body
+			}
+			res, err = t.perform(next, retry)
+		}
+	}
+
+	return res, err
+}
+
+// Active returns the name of the active cluster.
+func (t *FailoverTransport) Active() string {
+	t.Lock()
+	defer t.Unlock()
+	return t.clusters[t.active].name
+}
+
+// Failback switches back to the primary cluster.
+func (t *FailoverTransport) Failback() {
+	var events []failoverEvent
+	defer func() { t.emit(events) }()
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.active != 0 {
+		events = append(events, t.switchTo(0, FailoverReasonManual))
+	}
+}
+
+// Metrics returns the transport metrics summed across the clusters.
+func (t *FailoverTransport) Metrics() (opensearchtransport.Metrics, error) {
+	var (
+		m     = opensearchtransport.Metrics{Responses: make(map[int]int)}
+		found bool
+	)
+
+	for _, c := range t.clusters {
+		mt, ok := c.transport.(opensearchtransport.Measurable)
+		if !ok {
+			continue
+		}
+		cm, err := mt.Metrics()
+		if err != nil {
+			continue
+		}
+		found = true
+
+		m.Requests += cm.Requests
+		m.Failures += cm.Failures
+		for code, n := range cm.Responses {
+			m.Responses[code] += n
+		}
+		m.Connections = append(m.Connections, cm.Connections...)
+	}
+
+	if !found {
+		return opensearchtransport.Metrics{}, errors.New("transport metrics not enabled")
+	}
+	return m, nil
+}
+
+// FailoverMetrics returns the metrics of the failover client.
+func (t *FailoverTransport) FailoverMetrics() FailoverMetrics {
+	t.Lock()
+	now := t.now()
+	m := FailoverMetrics{
+		Active:    t.clusters[t.active].name,
+		Failovers: t.failovers,
+		Failbacks: t.failbacks,
+	}
+	for i, c := range t.clusters {
+		cm := ClusterMetrics{
+			Name:     c.name,
+			Active:   i == t.active,
+			Requests: c.requests,
+			Failures: c.failures,
+		}
+		if requests, failures := c.window.counts(now); requests > 0 {
+			cm.ErrorRate = float64(failures) / float64(requests)
+		}
+		m.Clusters = append(m.Clusters, cm)
+	}
+	t.Unlock()
+
+	// The cluster transports are locked separately to not block requests.
+	for i, c := range t.clusters {
+		if mt, ok := c.transport.(opensearchtransport.Measurable); ok {
+			if tm, err := mt.Metrics(); err == nil {
+				m.Clusters[i].Transport = &tm
+			}
+		}
+	}
+
+	return m
+}
+
+// DiscoverNodes reloads the connections of every cluster.
+func (t *FailoverTransport) DiscoverNodes() error {
+	return t.DiscoverNodesWithContext(context.Background())
+}
+
+// DiscoverNodesWithContext reloads the connections of every cluster.
+//
+// Discovery continues when it fails for a cluster; the first error is returned.
+func (t *FailoverTransport) DiscoverNodesWithContext(ctx context.Context) error {
+	var firstErr error
+
+	for _, c := range t.clusters {
+		var err error
+		switch dt := c.transport.(type) {
+		case opensearchtransport.ContextDiscoverable:
+			err = dt.DiscoverNodesWithContext(ctx)
+		case opensearchtransport.Discoverable:
+			err = dt.DiscoverNodes()
+		default:
+			continue
+		}
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("cluster %s: %w", c.name, err)
+		}
+	}
+
+	return firstErr
+}
+
+// pick returns the index of the cluster for the next request,
+// failing back or over when needed.
+func (t *FailoverTransport) pick() int {
+	var events []failoverEvent
+	defer func() { t.emit(events) }()
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.active != 0 && t.failback == FailbackAfterDelay && t.now().Sub(t.since) >= t.failbackDelay {
+		if t.hasLiveNodes(0) {
+			events = append(events, t.switchTo(0, FailoverReasonFailback))
+		} else {
+			t.since = t.now()
+		}
+	}
+
+	if !t.hasLiveNodes(t.active) {
+		if next := t.nextAvailable(); next >= 0 {
+			events = append(events, t.switchTo(next, FailoverReasonNoLiveNodes))
+		}
+	}
+
+	return t.active
+}
+
+// perform executes the request against the cluster and records the result.
+func (t *FailoverTransport) perform(idx int, req *http.Request) (*http.Response, error) {
+	c := t.clusters[idx]
+
+	res, err := c.transport.Perform(req)
+	if !isLocalError(err) {
+		t.record(idx, err != nil, res != nil && res.StatusCode >= http.StatusInternalServerError)
+	}
+
+	if err != nil {
+		return res, fmt.Errorf("cluster %s: %w", c.name, err)
+	}
+
+	if res != nil {
+		if res.Header == nil {
+			res.Header = make(http.Header)
+		}
+		res.Header.Set(HeaderFailoverCluster, c.name)
+	}
+
+	return res, nil
+}
+
+// record updates the counters of the cluster, and fails over
+// when the error rate of the active cluster exceeds the threshold.
+func (t *FailoverTransport) record(idx int, reqErr, serverErr bool) {
+	var events []failoverEvent
+	defer func() { t.emit(events) }()
+
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.now()
+	c := t.clusters[idx]
+
+	if reqErr {
+		c.connErrors++
+		c.lastConnError = now
+	} else {
+		c.connErrors = 0
+	}
+
+	failed := reqErr || serverErr
+	c.requests++
+	if failed {
+		c.failures++
+	}
+	c.window.record(now, failed)
+
+	if idx != t.active || !failed {
+		return
+	}
+
+	requests, failures := c.window.counts(now)
+	if requests >= t.minRequests && float64(failures)/float64(requests) > t.threshold {
+		if next := t.nextAvailable(); next >= 0 {
+			events = append(events, t.switchTo(next, FailoverReasonErrorRate))
+		}
+	}
+}
+
+// nextAvailable returns the index of the next cluster with live nodes, or -1.
+// The calling code is responsible for locking the transport.
+func (t *FailoverTransport) nextAvailable() int {
+	for i := 1; i < len(t.clusters); i++ {
+		j := (t.active + i) % len(t.clusters)
+		if t.hasLiveNodes(j) {
+			return j
+		}
+	}
+	return -1
+}
+
+// switchTo makes the cluster active and returns the event to emit.
+// The calling code is responsible for locking the transport.
+func (t *FailoverTransport) switchTo(idx int, reason string) failoverEvent {
+	e := failoverEvent{from: t.clusters[t.active].name, to: t.clusters[idx].name, reason: reason}
+
+	t.active = idx
+	t.since = t.now()
+	if idx == 0 {
+		t.failbacks++
+	} else {
+		t.failovers++
+	}
+	t.clusters[idx].window.reset()
+
+	return e
+}
+
+// emit passes the events to the callback, if any.
+func (t *FailoverTransport) emit(events []failoverEvent) {
+	if t.onFailover == nil {
+		return
+	}
+	for _, e := range events {
+		t.onFailover(e.from, e.to, e.reason)
+	}
+}
+
+// hasLiveNodes returns false when the cluster transport reports no live connections.
+// As a single connection is never marked dead, eg. the address of a load balancer,
+// a cluster with one connection has no live nodes while its last requests failed with an error.
+// The calling code is responsible for locking the transport.
+func (t *FailoverTransport) hasLiveNodes(idx int) bool {
+	c := t.clusters[idx]
+
+	var tp interface{} = c.transport
+	if client, ok := tp.(*Client); ok {
+		tp = client.Transport
+	}
+	if n := liveURLs(tp); n != 1 {
+		return n != 0
+	}
+	return c.connErrors < t.maxConnErrors || t.now().Sub(c.lastConnError) >= t.connErrorTTL
+}
+
+// liveURLs returns the number of live connections reported by the transport, or -1.
+func liveURLs(tp interface{}) int {
+	if u, ok := tp.(interface{ URLs() []*url.URL }); ok {
+		return len(u.URLs())
+	}
+	return -1
+}
+
+// isLocalError reports whether the request failed without reaching the cluster, eg. canceled
+// or rejected in serverless mode, so the error doesn't count against the cluster.
+func isLocalError(err error) bool {
+	var serr *ServerlessUnsupportedError
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.As(err, &serr)
+}
+
+func setFailoverReqBody(req *http.Request, body []byte) {
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	req.ContentLength = int64(len(body))
+}
+
+// errorWindow counts requests and failures in a sliding time window split into buckets.
+type errorWindow struct {
+	size    time.Duration
+	buckets [failoverWindowBuckets]errorBucket
+}
+
+type errorBucket struct {
+	start    time.Time
+	requests int
+	failures int
+}
+
+func newErrorWindow(d time.Duration) errorWindow {
+	size := d / failoverWindowBuckets
+	if size <= 0 {
+		size = 1
+	}
+	return errorWindow{size: size}
+}
+
+func (w *errorWindow) record(now time.Time, failed bool) {
+	start := now.Truncate(w.size)
+	b := &w.buckets[int((start.UnixNano()/int64(w.size))%failoverWindowBuckets)]
+	if !b.start.Equal(start) {
+		*b = errorBucket{start: start}
+	}
+	b.requests++
+	if failed {
+		b.failures++
+	}
+}
+
+func (w *errorWindow) counts(now time.Time) (requests, failures int) {
+	for _, b := range w.buckets {
+		if b.requests > 0 && now.Sub(b.start) < w.size*failoverWindowBuckets {
+			requests += b.requests
+			failures += b.failures
+		}
+	}
+	return requests, failures
+}
+
+func (w *errorWindow) reset() {
+	w.buckets = [failoverWindowBuckets]errorBucket{}
+}
@@ -0,0 +1,381 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearch
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2/opensearchtransport"
+)
+
+type failoverRecorder struct {
+	sync.Mutex
+	events []string
+}
+
+func (r *failoverRecorder) OnFailover(from, to, reason string) {
+	r.Lock()
+	defer r.Unlock()
+	r.events = append(r.events, from+"->"+to+": "+reason)
+}
+
+func newFailoverTestTransport(status int, bodies *[]string) *mockTransp {
+	return &mockTransp{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			if bodies != nil && req.Body != nil {
+				b, _ := io.ReadAll(req.Body)
+				*bodies = append(*bodies, string(b))
+			}
+			return &http.Response{
+				StatusCode: status,
+				Status:     http.StatusText(status),
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		},
+	}
+}
+
+func TestFailoverClient(t *testing.T) {
+	t.Run("Config", func(t *testing.T) {
+		if _, err := NewFailoverClient(FailoverConfig{}); err == nil {
+			t.Errorf("Expected error for missing clusters")
+		}
+
+		_, err := NewFailoverClient(FailoverConfig{Clusters: []ClusterConfig{
+			{Name: "a", Config: Config{Addresses: []string{"http://a1"}}},
+			{Name: "a", Config: Config{Addresses: []string{"http://a2"}}},
+		}})
+		if err == nil || !strings.Contains(err.Error(), "duplicate") {
+			t.Errorf("Expected error for duplicate names, got: %v", err)
+		}
+
+		_, err = NewFailoverClient(FailoverConfig{Clusters: []ClusterConfig{{Name: "a"}}})
+		if err == nil || !strings.Contains(err.Error(), "no addresses") {
+			t.Errorf("Expected error for missing addresses, got: %v", err)
+		}
+	})
+
+	t.Run("Serving cluster header", func(t *testing.T) {
+		c, err := NewFailoverClient(FailoverConfig{Clusters: []ClusterConfig{
+			{Name: "primary", Config: Config{Addresses: []string{"http://a1"}, Transport: newFailoverTestTransport(http.StatusOK, nil)}},
+			{Name: "secondary", Config: Config{Addresses: []string{"http://b1"}, Transport: newFailoverTestTransport(http.StatusOK, nil)}},
+		}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		res, err := c.Info()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if res.Header.Get(HeaderFailoverCluster) != "primary" {
+			t.Errorf("Unexpected cluster header: %q", res.Header.Get(HeaderFailoverCluster))
+		}
+	})
+
+	t.Run("No live nodes", func(t *testing.T) {
+		var (
+			rec    failoverRecorder
+			bodies []string
+			ft     = opensearchtransport.NewFaultTransport(newFailoverTestTransport(http.StatusOK, nil), nil)
+		)
+		ft.AddRule(opensearchtransport.FaultRule{Fault: opensearchtransport.Fault{Kind: opensearchtransport.FaultRefuse}})
+
+		c, _ := NewFailoverClient(FailoverConfig{
+			Clusters: []ClusterConfig{
+				{Name: "primary", Config: Config{Addresses: []string{"http://a1", "http://a2"}, Transport: ft}},
+				{Name: "secondary", Config: Config{Addresses: []string{"http://b1"}, Transport: newFailoverTestTransport(http.StatusOK, &bodies)}},
+			},
+			OnFailover: rec.OnFailover,
+		})
+
+		req, _ := http.NewRequest(http.MethodPost, "/_bulk", strings.NewReader(`{"foo":"bar"}`))
+		res, err := c.Perform(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if res.Header.Get(HeaderFailoverCluster) != "secondary" {
+			t.Errorf("Expected the request to be retried on the secondary cluster, got: %q", res.Header.Get(HeaderFailoverCluster))
+		}
+		if len(bodies) != 1 || bodies[0] != `{"foo":"bar"}` {
+			t.Errorf("Unexpected request bodies: %q", bodies)
+		}
+
+		tp := c.Transport.(*FailoverTransport)
+		if tp.Active() != "secondary" {
+			t.Errorf("Unexpected active cluster: %s", tp.Active())
+		}
+		if len(rec.events) != 1 || rec.events[0] != "primary->secondary: "+FailoverReasonNoLiveNodes {
+			t.Errorf("Unexpected events: %q", rec.events)
+		}
+	})
+
+	t.Run("No live nodes with a single address", func(t *testing.T) {
+		var (
+			rec    failoverRecorder
+			bodies []string
+			down   = true
+		)
+		primary := &mockTransp{RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			if down {
+				return nil, errors.New("connection refused")
+			}
+			return newFailoverTestTransport(http.StatusOK, nil).RoundTrip(req)
+		}}
+
+		tp, _ := NewFailoverTransport(FailoverConfig{
+			Clusters: []ClusterConfig{
+				{Name: "primary", Config: Config{Addresses: []string{"http://lb-a"}, Transport: primary, DisableRetry: true}},
+				{Name: "secondary", Config: Config{Addresses: []string{"http://lb-b"}, Transport: newFailoverTestTransport(http.StatusOK, &bodies)}},
+			},
+			FailbackDelay: 5 * time.Minute,
+			OnFailover:    rec.OnFailover,
+		})
+
+		now := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+		tp.now = func() time.Time { return now }
+
+		req, _ := http.NewRequest(http.MethodPost, "/_bulk", strings.NewReader(`{"foo":"bar"}`))
+		res, err := tp.Perform(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if res.Header.Get(HeaderFailoverCluster) != "secondary" {
+			t.Errorf("Expected the request to be retried on the secondary cluster, got: %q", res.Header.Get(HeaderFailoverCluster))
+		}
+		if len(bodies) != 1 || bodies[0] != `{"foo":"bar"}` {
+			t.Errorf("Unexpected request bodies: %q", bodies)
+		}
+		if len(rec.events) != 1 || rec.events[0] != "primary->secondary: "+FailoverReasonNoLiveNodes {
+			t.Errorf("Unexpected events: %q", rec.events)
+		}
+
+		down = false
+		now = now.Add(5 * time.Minute)
+		if idx := tp.pick(); idx != 0 {
+			t.Errorf("Expected failback to the primary cluster, got: %d", idx)
+		}
+	})
+
+	t.Run("Request body", func(t *testing.T) {
+		var bodies []string
+		tp, _ := NewFailoverTransport(FailoverConfig{Clusters: []ClusterConfig{
+			{Name: "primary", Config: Config{Addresses: []string{"http://a1"}, Transport: newFailoverTestTransport(http.StatusOK, &bodies)}},
+			{Name: "secondary", Config: Config{Addresses: []string{"http://b1"}, Transport: newFailoverTestTransport(http.StatusOK, nil)}},
+		}})
+
+		req, _ := http.NewRequest(http.MethodPost, "/_bulk", strings.NewReader(`{"foo":"bar"}`))
+		body := req.Body
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.Body != body {
+			t.Errorf("Expected the body to be read with GetBody, not buffered")
+		}
+		if len(bodies) != 1 || bodies[0] != `{"foo":"bar"}` {
+			t.Errorf("Unexpected request bodies: %q", bodies)
+		}
+
+		req, _ = http.NewRequest(http.MethodPost, "/_bulk", io.MultiReader(strings.NewReader(`{"foo":"baz"}`)))
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if req.GetBody == nil || len(bodies) != 2 || bodies[1] != `{"foo":"baz"}` {
+			t.Errorf("Expected the body to be buffered, got: %q", bodies)
+		}
+	})
+
+	t.Run("Error rate", func(t *testing.T) {
+		var rec failoverRecorder
+
+		c, _ := NewFailoverClient(FailoverConfig{
+			Clusters: []ClusterConfig{
+				{Name: "primary", Config: Config{
+					Addresses:    []string{"http://a1"},
+					Transport:    newFailoverTestTransport(http.StatusServiceUnavailable, nil),
+					DisableRetry: true,
+				}},
+				{Name: "secondary", Config: Config{Addresses: []string{"http://b1"}, Transport: newFailoverTestTransport(http.StatusOK, nil)}},
+			},
+			MinRequests: 4,
+			OnFailover:  rec.OnFailover,
+		})
+
+		var served []string
+		for i := 0; i < 6; i++ {
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			res, err := c.Perform(req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			served = append(served, res.Header.Get(HeaderFailoverCluster))
+		}
+
+		if strings.Join(served, ",") != "primary,primary,primary,primary,secondary,secondary" {
+			t.Errorf("Unexpected serving clusters: %s", served)
+		}
+		if len(rec.events) != 1 || rec.events[0] != "primary->secondary: "+FailoverReasonErrorRate {
+			t.Errorf("Unexpected events: %q", rec.events)
+		}
+
+		m := c.Transport.(*FailoverTransport).FailoverMetrics()
+		if m.Active != "secondary" || m.Failovers != 1 {
+			t.Errorf("Unexpected metrics: %+v", m)
+		}
+		if m.Clusters[0].Requests != 4 || m.Clusters[0].Failures != 4 || m.Clusters[0].ErrorRate != 1 {
+			t.Errorf("Unexpected primary metrics: %+v", m.Clusters[0])
+		}
+		if m.Clusters[1].Requests != 2 || m.Clusters[1].Failures != 0 || !m.Clusters[1].Active {
+			t.Errorf("Unexpected secondary metrics: %+v", m.Clusters[1])
+		}
+	})
+
+	t.Run("Failback", func(t *testing.T) {
+		for _, policy := range []FailbackPolicy{FailbackAfterDelay, FailbackManual} {
+			var rec failoverRecorder
+
+			tp, _ := NewFailoverTransport(FailoverConfig{
+				Clusters: []ClusterConfig{
+					{Name: "primary", Config: Config{Addresses: []string{"http://a1"}, Transport: newFailoverTestTransport(http.StatusOK, nil)}},
+					{Name: "secondary", Config: Config{Addresses: []string{"http://b1"}, Transport: newFailoverTestTransport(http.StatusOK, nil)}},
+				},
+				Failback:      policy,
+				FailbackDelay: time.Minute,
+				OnFailover:    rec.OnFailover,
+			})
+
+			now := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+			tp.now = func() time.Time { return now }
+
+			tp.Lock()
+			tp.switchTo(1, FailoverReasonErrorRate)
+			tp.Unlock()
+
+			now = now.Add(30 * time.Second)
+			if idx := tp.pick(); idx != 1 {
+				t.Errorf("Expected to stay on the secondary cluster before the delay, got: %d", idx)
+			}
+
+			now = now.Add(30 * time.Second)
+			idx := tp.pick()
+
+			switch policy {
+			case FailbackAfterDelay:
+				if idx != 0 || len(rec.events) != 1 || rec.events[0] != "secondary->primary: "+FailoverReasonFailback {
+					t.Errorf("Expected failback after the delay, got: %d, %q", idx, rec.events)
+				}
+			case FailbackManual:
+				if idx != 1 || len(rec.events) != 0 {
+					t.Errorf("Expected no failback, got: %d, %q", idx, rec.events)
+				}
+				tp.Failback()
+				if tp.Active() != "primary" || len(rec.events) != 1 || rec.events[0] != "secondary->primary: "+FailoverReasonManual {
+					t.Errorf("Expected manual failback, got: %s, %q", tp.Active(), rec.events)
+				}
+			}
+
+			if m := tp.FailoverMetrics(); m.Failovers != 1 || m.Failbacks != 1 {
+				t.Errorf("Unexpected metrics: %+v", m)
+			}
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockErr := errors.New("MOCK ERROR")
+
+		c, _ := NewFailoverClient(FailoverConfig{Clusters: []ClusterConfig{
+			{Name: "primary", Config: Config{
+				Addresses:    []string{"http://a1"},
+				DisableRetry: true,
+				Transport: &mockTransp{RoundTripFunc: func(*http.Request) (*http.Response, error) {
+					return nil, mockErr
+				}},
+			}},
+		}})
+
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		//nolint:bodyclose // No response is returned on error
+		_, err := c.Perform(req)
+		if !errors.Is(err, mockErr) || !strings.Contains(err.Error(), "cluster primary") {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Metrics", func(t *testing.T) {
+		c, _ := NewFailoverClient(FailoverConfig{Clusters: []ClusterConfig{
+			{Name: "primary", Config: Config{Addresses: []string{"http://a1"}, Transport: newFailoverTestTransport(http.StatusOK, nil), EnableMetrics: true}},
+			{Name: "secondary", Config: Config{Addresses: []string{"http://b1"}, Transport: newFailoverTestTransport(http.StatusOK, nil), EnableMetrics: true}},
+		}})
+
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		if _, err := c.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		m, err := c.Metrics()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if m.Requests != 1 || m.Responses[http.StatusOK] != 1 || len(m.Connections) != 2 {
+			t.Errorf("Unexpected metrics: %s", m)
+		}
+
+		fm := c.Transport.(*FailoverTransport).FailoverMetrics()
+		if fm.Clusters[0].Transport == nil || fm.Clusters[0].Transport.Requests != 1 {
+			t.Errorf("Expected transport metrics for the primary cluster, got: %+v", fm.Clusters[0])
+		}
+	})
+
+	t.Run("Serverless", func(t *testing.T) {
+		var calls int
+		c, err := NewFailoverClient(FailoverConfig{Clusters: []ClusterConfig{
+			{Name: "primary", Config: Config{Addresses: []string{"http://a1"}, Serverless: true, Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					calls++
+					return newFailoverTestTransport(http.StatusOK, nil).RoundTrip(req)
+				},
+			}}},
+			{Name: "secondary", Config: Config{Addresses: []string{"http://b1"}, Transport: newFailoverTestTransport(http.StatusOK, nil)}},
+		}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		var serr *ServerlessUnsupportedError
+		//nolint:bodyclose // No response is returned on error
+		_, err = c.Cluster.Health()
+		if !errors.As(err, &serr) || serr.Path != "/_cluster/health" {
+			t.Errorf("Expected ServerlessUnsupportedError, got: %v", err)
+		}
+		if calls != 0 {
+			t.Errorf("Expected no requests, got: %d", calls)
+		}
+
+		if _, err := c.Search(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if calls != 1 {
+			t.Errorf("Expected 1 request, got: %d", calls)
+		}
+
+		if err := c.DiscoverNodes(); !errors.As(err, &serr) || !strings.Contains(err.Error(), "cluster primary") {
+			t.Errorf("Expected ServerlessUnsupportedError, got: %v", err)
+		}
+	})
+}
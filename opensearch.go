@@ -27,6 +27,7 @@
 package opensearch
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -91,6 +92,9 @@ type Config struct {
 	DiscoverNodesOnStart  bool          // Discover nodes when initializing the client. Default: false.
 	DiscoverNodesInterval time.Duration // Discover nodes periodically. Default: disabled.
 
+	// Optional source of nodes for the node discovery, such as a file or DNS records. Default: nodes info API.
+	NodeSource opensearchtransport.NodeSource
+
 	EnableMetrics     bool // Enable the metrics collection.
 	EnableDebugLogger bool // Enable the debug logging.
 
@@ -100,8 +104,21 @@ type Config struct {
 	Logger    opensearchtransport.Logger   // The logger object.
 	Selector  opensearchtransport.Selector // The selector object.
 
+	// Optional listener for the connection pool events, such as dead or discovered nodes. Default: nil.
+	ConnectionPoolListener opensearchtransport.ConnectionPoolListener
+
 	// Optional constructor function for a custom ConnectionPool. Default: nil.
 	ConnectionPoolFunc func([]*opensearchtransport.Connection, opensearchtransport.Selector) opensearchtransport.ConnectionPool
+
+	// Serverless enables the compatibility mode for Amazon OpenSearch Serverless. Default: false.
+	//
+	// The client requires a single collection endpoint, and returns an error when the node discovery
+	// or the connection pool options are set. Requests to APIs which are not supported
+	// by serverless collections fail without being sent, with a *ServerlessUnsupportedError.
+	Serverless bool
+
+	// The type of the serverless collection, used by helpers to adjust their defaults. Default: empty.
+	CollectionType CollectionType
 }
 
 // Client represents the OpenSearch client.
@@ -109,6 +126,9 @@ type Config struct {
 type Client struct {
 	*opensearchapi.API   // Embeds the API methods
 	Transport            opensearchtransport.Interface
+
+	serverless     bool
+	collectionType CollectionType
 }
 
 type esVersion struct {
@@ -174,6 +194,18 @@ func NewClient(cfg Config) (*Client, error) {
 		cfg.Password = pw
 	}
 
+	if cfg.Serverless {
+		if len(urls) > 1 {
+			return nil, errors.New("cannot create client: serverless mode supports a single collection endpoint")
+		}
+
+		if opt := serverlessUnsupportedOption(&cfg); opt != "" {
+			return nil, fmt.Errorf("cannot create client: %s is not supported in serverless mode", opt)
+		}
+	} else {
+		cfg.CollectionType = ""
+	}
+
 	tp, err := opensearchtransport.New(opensearchtransport.Config{
 		URLs:     urls,
 		Username: cfg.Username,
@@ -196,17 +228,20 @@ func NewClient(cfg Config) (*Client, error) {
 		EnableDebugLogger: cfg.EnableDebugLogger,
 
 		DiscoverNodesInterval: cfg.DiscoverNodesInterval,
+		NodeSource:            cfg.NodeSource,
 
 		Transport:          cfg.Transport,
 		Logger:             cfg.Logger,
 		Selector:           cfg.Selector,
 		ConnectionPoolFunc: cfg.ConnectionPoolFunc,
+
+		ConnectionPoolListener: cfg.ConnectionPoolListener,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error creating transport: %s", err)
 	}
 
-	client := &Client{Transport: tp}
+	client := &Client{Transport: tp, serverless: cfg.Serverless, collectionType: cfg.CollectionType}
 	client.API = opensearchapi.New(client)
 
 	if cfg.DiscoverNodesOnStart {
@@ -263,6 +298,10 @@ func ParseVersion(version string) (int64, int64, int64, error) {
 // Perform delegates to Transport to execute a request and return a response.
 //
 func (c *Client) Perform(req *http.Request) (*http.Response, error) {
+	if err := c.checkServerless(req); err != nil {
+		return nil, err
+	}
+
 	// Perform the original request.
 	return c.Transport.Perform(req)
 }
@@ -279,12 +318,43 @@ func (c *Client) Metrics() (opensearchtransport.Metrics, error) {
 // DiscoverNodes reloads the client connections by fetching information from the cluster.
 //
 func (c *Client) DiscoverNodes() error {
+	if c.serverless {
+		return serverlessDiscoveryError()
+	}
 	if dt, ok := c.Transport.(opensearchtransport.Discoverable); ok {
 		return dt.DiscoverNodes()
 	}
 	return errors.New("transport is missing method DiscoverNodes()")
 }
 
+// DiscoverNodesWithContext reloads the client connections by fetching information from the cluster.
+//
+func (c *Client) DiscoverNodesWithContext(ctx context.Context) error {
+	if c.serverless {
+		return serverlessDiscoveryError()
+	}
+	if dt, ok := c.Transport.(opensearchtransport.ContextDiscoverable); ok {
+		return dt.DiscoverNodesWithContext(ctx)
+	}
+	return errors.New("transport is missing method DiscoverNodesWithContext()")
+}
+
+// WithOpaqueID returns a copy of ctx which carries the opaque ID.
+//
+// The client sets the X-Opaque-Id header to this value for every request performed
+// with the returned context, including requests made by helpers such as the bulk indexer,
+// unless the request already has the header set.
+//
+func WithOpaqueID(ctx context.Context, id string) context.Context {
+	return opensearchtransport.ContextWithOpaqueID(ctx, id)
+}
+
+// OpaqueIDFromContext returns the opaque ID carried by ctx, if any.
+//
+func OpaqueIDFromContext(ctx context.Context) (string, bool) {
+	return opensearchtransport.OpaqueIDFromContext(ctx)
+}
+
 // addrsFromEnvironment returns a list of addresses by splitting
 // the given environment variable with comma, or an empty list.
 //
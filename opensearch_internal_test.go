@@ -29,6 +29,7 @@
 package opensearch
 
 import (
+	"context"
 	"errors"
 	"io/ioutil"
 	"net/http"
@@ -320,6 +321,30 @@ func TestClientMetrics(t *testing.T) {
 	}
 }
 
+func TestClientOpaqueID(t *testing.T) {
+	var header string
+
+	c, _ := NewClient(Config{Transport: &mockTransp{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			header = req.Header.Get("X-Opaque-Id")
+			return defaultRoundTripFunc(req)
+		},
+	}})
+
+	ctx := WithOpaqueID(context.Background(), "req-1")
+	if id, ok := OpaqueIDFromContext(ctx); !ok || id != "req-1" {
+		t.Errorf("Unexpected opaque ID: %q", id)
+	}
+
+	if _, err := c.Info(c.Info.WithContext(ctx)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if header != "req-1" {
+		t.Errorf("Unexpected X-Opaque-Id header: %q", header)
+	}
+}
+
 func TestParseElasticsearchVersion(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -28,15 +28,26 @@ package opensearchapi
 
 // API contains the OpenSearch APIs
 type API struct {
-	Cat         *Cat
-	Cluster     *Cluster
-	Indices     *Indices
-	Ingest      *Ingest
-	Nodes       *Nodes
-	Remote      *Remote
-	Snapshot    *Snapshot
-	Tasks       *Tasks
-	PointInTime *PointInTime
+	Cat              *Cat
+	Cluster          *Cluster
+	Indices          *Indices
+	Ingest           *Ingest
+	Nodes            *Nodes
+	Remote           *Remote
+	Snapshot         *Snapshot
+	Tasks            *Tasks
+	PointInTime      *PointInTime
+	Security         *Security
+	ISM              *ISM
+	KNN              *KNN
+	SQL              *SQL
+	PPL              *PPL
+	Alerting         *Alerting
+	AnomalyDetection *AnomalyDetection
+	Rollup           *Rollup
+	Transform        *Transform
+	AsyncSearch      *AsyncSearch
+	Replication      *Replication
 
 	Bulk                               Bulk
 	ClearScroll                        ClearScroll
@@ -233,6 +244,161 @@ type PointInTime struct {
 	Get    PointInTimeGet
 }
 
+// Security contains the Security plugin APIs
+type Security struct {
+	GetAccount        SecurityGetAccount
+	ChangePassword    SecurityChangePassword
+	AuthInfo          SecurityAuthInfo
+	Health            SecurityHealth
+	FlushCache        SecurityFlushCache
+	GetConfig         SecurityGetConfig
+	PutConfig         SecurityPutConfig
+	PatchConfig       SecurityPatchConfig
+	GetUsers          SecurityGetUsers
+	PutUser           SecurityPutUser
+	DeleteUser        SecurityDeleteUser
+	PatchUsers        SecurityPatchUsers
+	GetRoles          SecurityGetRoles
+	PutRole           SecurityPutRole
+	DeleteRole        SecurityDeleteRole
+	PatchRoles        SecurityPatchRoles
+	GetRoleMappings   SecurityGetRoleMappings
+	PutRoleMapping    SecurityPutRoleMapping
+	DeleteRoleMapping SecurityDeleteRoleMapping
+	PatchRoleMappings SecurityPatchRoleMappings
+	GetActionGroups   SecurityGetActionGroups
+	PutActionGroup    SecurityPutActionGroup
+	DeleteActionGroup SecurityDeleteActionGroup
+	PatchActionGroups SecurityPatchActionGroups
+	GetTenants        SecurityGetTenants
+	PutTenant         SecurityPutTenant
+	DeleteTenant      SecurityDeleteTenant
+	PatchTenants      SecurityPatchTenants
+}
+
+// ISM contains the Index State Management plugin APIs
+type ISM struct {
+	PutPolicy    ISMPutPolicy
+	GetPolicy    ISMGetPolicy
+	GetPolicies  ISMGetPolicies
+	DeletePolicy ISMDeletePolicy
+	AddPolicy    ISMAddPolicy
+	RemovePolicy ISMRemovePolicy
+	ChangePolicy ISMChangePolicy
+	Retry        ISMRetry
+	Explain      ISMExplain
+}
+
+// KNN contains the k-NN plugin APIs
+type KNN struct {
+	Stats        KNNStats
+	Warmup       KNNWarmup
+	TrainModel   KNNTrainModel
+	GetModel     KNNGetModel
+	DeleteModel  KNNDeleteModel
+	SearchModels KNNSearchModels
+}
+
+// SQL contains the SQL plugin APIs
+type SQL struct {
+	Query   SQLQuery
+	Explain SQLExplain
+	Close   SQLClose
+}
+
+// PPL contains the PPL (Piped Processing Language) plugin APIs
+type PPL struct {
+	Query   PPLQuery
+	Explain PPLExplain
+}
+
+// Alerting contains the Alerting plugin APIs
+type Alerting struct {
+	CreateMonitor       AlertingCreateMonitor
+	UpdateMonitor       AlertingUpdateMonitor
+	GetMonitor          AlertingGetMonitor
+	DeleteMonitor       AlertingDeleteMonitor
+	SearchMonitors      AlertingSearchMonitors
+	RunMonitor          AlertingRunMonitor
+	AcknowledgeAlerts   AlertingAcknowledgeAlerts
+	GetAlerts           AlertingGetAlerts
+	CreateDestination   AlertingCreateDestination
+	UpdateDestination   AlertingUpdateDestination
+	DeleteDestination   AlertingDeleteDestination
+	GetDestinations     AlertingGetDestinations
+	CreateEmailAccount  AlertingCreateEmailAccount
+	UpdateEmailAccount  AlertingUpdateEmailAccount
+	DeleteEmailAccount  AlertingDeleteEmailAccount
+	GetEmailAccount     AlertingGetEmailAccount
+	SearchEmailAccounts AlertingSearchEmailAccounts
+	CreateEmailGroup    AlertingCreateEmailGroup
+	UpdateEmailGroup    AlertingUpdateEmailGroup
+	DeleteEmailGroup    AlertingDeleteEmailGroup
+	GetEmailGroup       AlertingGetEmailGroup
+	SearchEmailGroups   AlertingSearchEmailGroups
+}
+
+// AnomalyDetection contains the Anomaly Detection plugin APIs
+type AnomalyDetection struct {
+	CreateDetector  AnomalyDetectionCreateDetector
+	UpdateDetector  AnomalyDetectionUpdateDetector
+	GetDetector     AnomalyDetectionGetDetector
+	DeleteDetector  AnomalyDetectionDeleteDetector
+	SearchDetectors AnomalyDetectionSearchDetectors
+	StartDetector   AnomalyDetectionStartDetector
+	StopDetector    AnomalyDetectionStopDetector
+	Profile         AnomalyDetectionProfile
+	Stats           AnomalyDetectionStats
+	PreviewDetector AnomalyDetectionPreviewDetector
+	SearchResults   AnomalyDetectionSearchResults
+	SearchTasks     AnomalyDetectionSearchTasks
+}
+
+// Rollup contains the Index Rollup plugin APIs
+type Rollup struct {
+	Put     RollupPut
+	Get     RollupGet
+	Delete  RollupDelete
+	Start   RollupStart
+	Stop    RollupStop
+	Explain RollupExplain
+}
+
+// Transform contains the Index Transform plugin APIs
+type Transform struct {
+	Put     TransformPut
+	Get     TransformGet
+	GetAll  TransformGetAll
+	Delete  TransformDelete
+	Start   TransformStart
+	Stop    TransformStop
+	Explain TransformExplain
+	Preview TransformPreview
+}
+
+// AsyncSearch contains the Asynchronous Search plugin APIs
+type AsyncSearch struct {
+	Submit AsyncSearchSubmit
+	Get    AsyncSearchGet
+	Delete AsyncSearchDelete
+	Stats  AsyncSearchStats
+}
+
+// Replication contains the Cross-Cluster Replication plugin APIs
+type Replication struct {
+	Start            ReplicationStart
+	Stop             ReplicationStop
+	Pause            ReplicationPause
+	Resume           ReplicationResume
+	Status           ReplicationStatus
+	UpdateSettings   ReplicationUpdateSettings
+	CreateAutofollow ReplicationCreateAutofollow
+	DeleteAutofollow ReplicationDeleteAutofollow
+	LeaderStats      ReplicationLeaderStats
+	FollowerStats    ReplicationFollowerStats
+	AutofollowStats  ReplicationAutofollowStats
+}
+
 // New creates new API
 func New(t Transport) *API {
 	return &API{
@@ -408,5 +574,138 @@ func New(t Transport) *API {
 			Delete: newPointInTimeDeleteFunc(t),
 			Get:    newPointInTimeGetFunc(t),
 		},
+		Security: &Security{
+			GetAccount:        newSecurityGetAccountFunc(t),
+			ChangePassword:    newSecurityChangePasswordFunc(t),
+			AuthInfo:          newSecurityAuthInfoFunc(t),
+			Health:            newSecurityHealthFunc(t),
+			FlushCache:        newSecurityFlushCacheFunc(t),
+			GetConfig:         newSecurityGetConfigFunc(t),
+			PutConfig:         newSecurityPutConfigFunc(t),
+			PatchConfig:       newSecurityPatchConfigFunc(t),
+			GetUsers:          newSecurityGetUsersFunc(t),
+			PutUser:           newSecurityPutUserFunc(t),
+			DeleteUser:        newSecurityDeleteUserFunc(t),
+			PatchUsers:        newSecurityPatchUsersFunc(t),
+			GetRoles:          newSecurityGetRolesFunc(t),
+			PutRole:           newSecurityPutRoleFunc(t),
+			DeleteRole:        newSecurityDeleteRoleFunc(t),
+			PatchRoles:        newSecurityPatchRolesFunc(t),
+			GetRoleMappings:   newSecurityGetRoleMappingsFunc(t),
+			PutRoleMapping:    newSecurityPutRoleMappingFunc(t),
+			DeleteRoleMapping: newSecurityDeleteRoleMappingFunc(t),
+			PatchRoleMappings: newSecurityPatchRoleMappingsFunc(t),
+			GetActionGroups:   newSecurityGetActionGroupsFunc(t),
+			PutActionGroup:    newSecurityPutActionGroupFunc(t),
+			DeleteActionGroup: newSecurityDeleteActionGroupFunc(t),
+			PatchActionGroups: newSecurityPatchActionGroupsFunc(t),
+			GetTenants:        newSecurityGetTenantsFunc(t),
+			PutTenant:         newSecurityPutTenantFunc(t),
+			DeleteTenant:      newSecurityDeleteTenantFunc(t),
+			PatchTenants:      newSecurityPatchTenantsFunc(t),
+		},
+		ISM: &ISM{
+			PutPolicy:    newISMPutPolicyFunc(t),
+			GetPolicy:    newISMGetPolicyFunc(t),
+			GetPolicies:  newISMGetPoliciesFunc(t),
+			DeletePolicy: newISMDeletePolicyFunc(t),
+			AddPolicy:    newISMAddPolicyFunc(t),
+			RemovePolicy: newISMRemovePolicyFunc(t),
+			ChangePolicy: newISMChangePolicyFunc(t),
+			Retry:        newISMRetryFunc(t),
+			Explain:      newISMExplainFunc(t),
+		},
+		KNN: &KNN{
+			Stats:        newKNNStatsFunc(t),
+			Warmup:       newKNNWarmupFunc(t),
+			TrainModel:   newKNNTrainModelFunc(t),
+			GetModel:     newKNNGetModelFunc(t),
+			DeleteModel:  newKNNDeleteModelFunc(t),
+			SearchModels: newKNNSearchModelsFunc(t),
+		},
+		SQL: &SQL{
+			Query:   newSQLQueryFunc(t),
+			Explain: newSQLExplainFunc(t),
+			Close:   newSQLCloseFunc(t),
+		},
+		PPL: &PPL{
+			Query:   newPPLQueryFunc(t),
+			Explain: newPPLExplainFunc(t),
+		},
+		Alerting: &Alerting{
+			CreateMonitor:       newAlertingCreateMonitorFunc(t),
+			UpdateMonitor:       newAlertingUpdateMonitorFunc(t),
+			GetMonitor:          newAlertingGetMonitorFunc(t),
+			DeleteMonitor:       newAlertingDeleteMonitorFunc(t),
+			SearchMonitors:      newAlertingSearchMonitorsFunc(t),
+			RunMonitor:          newAlertingRunMonitorFunc(t),
+			AcknowledgeAlerts:   newAlertingAcknowledgeAlertsFunc(t),
+			GetAlerts:           newAlertingGetAlertsFunc(t),
+			CreateDestination:   newAlertingCreateDestinationFunc(t),
+			UpdateDestination:   newAlertingUpdateDestinationFunc(t),
+			DeleteDestination:   newAlertingDeleteDestinationFunc(t),
+			GetDestinations:     newAlertingGetDestinationsFunc(t),
+			CreateEmailAccount:  newAlertingCreateEmailAccountFunc(t),
+			UpdateEmailAccount:  newAlertingUpdateEmailAccountFunc(t),
+			DeleteEmailAccount:  newAlertingDeleteEmailAccountFunc(t),
+			GetEmailAccount:     newAlertingGetEmailAccountFunc(t),
+			SearchEmailAccounts: newAlertingSearchEmailAccountsFunc(t),
+			CreateEmailGroup:    newAlertingCreateEmailGroupFunc(t),
+			UpdateEmailGroup:    newAlertingUpdateEmailGroupFunc(t),
+			DeleteEmailGroup:    newAlertingDeleteEmailGroupFunc(t),
+			GetEmailGroup:       newAlertingGetEmailGroupFunc(t),
+			SearchEmailGroups:   newAlertingSearchEmailGroupsFunc(t),
+		},
+		AnomalyDetection: &AnomalyDetection{
+			CreateDetector:  newAnomalyDetectionCreateDetectorFunc(t),
+			UpdateDetector:  newAnomalyDetectionUpdateDetectorFunc(t),
+			GetDetector:     newAnomalyDetectionGetDetectorFunc(t),
+			DeleteDetector:  newAnomalyDetectionDeleteDetectorFunc(t),
+			SearchDetectors: newAnomalyDetectionSearchDetectorsFunc(t),
+			StartDetector:   newAnomalyDetectionStartDetectorFunc(t),
+			StopDetector:    newAnomalyDetectionStopDetectorFunc(t),
+			Profile:         newAnomalyDetectionProfileFunc(t),
+			Stats:           newAnomalyDetectionStatsFunc(t),
+			PreviewDetector: newAnomalyDetectionPreviewDetectorFunc(t),
+			SearchResults:   newAnomalyDetectionSearchResultsFunc(t),
+			SearchTasks:     newAnomalyDetectionSearchTasksFunc(t),
+		},
+		Rollup: &Rollup{
+			Put:     newRollupPutFunc(t),
+			Get:     newRollupGetFunc(t),
+			Delete:  newRollupDeleteFunc(t),
+			Start:   newRollupStartFunc(t),
+			Stop:    newRollupStopFunc(t),
+			Explain: newRollupExplainFunc(t),
+		},
+		Transform: &Transform{
+			Put:     newTransformPutFunc(t),
+			Get:     newTransformGetFunc(t),
+			GetAll:  newTransformGetAllFunc(t),
+			Delete:  newTransformDeleteFunc(t),
+			Start:   newTransformStartFunc(t),
+			Stop:    newTransformStopFunc(t),
+			Explain: newTransformExplainFunc(t),
+			Preview: newTransformPreviewFunc(t),
+		},
+		AsyncSearch: &AsyncSearch{
+			Submit: newAsyncSearchSubmitFunc(t),
+			Get:    newAsyncSearchGetFunc(t),
+			Delete: newAsyncSearchDeleteFunc(t),
+			Stats:  newAsyncSearchStatsFunc(t),
+		},
+		Replication: &Replication{
+			Start:            newReplicationStartFunc(t),
+			Stop:             newReplicationStopFunc(t),
+			Pause:            newReplicationPauseFunc(t),
+			Resume:           newReplicationResumeFunc(t),
+			Status:           newReplicationStatusFunc(t),
+			UpdateSettings:   newReplicationUpdateSettingsFunc(t),
+			CreateAutofollow: newReplicationCreateAutofollowFunc(t),
+			DeleteAutofollow: newReplicationDeleteAutofollowFunc(t),
+			LeaderStats:      newReplicationLeaderStatsFunc(t),
+			FollowerStats:    newReplicationFollowerStatsFunc(t),
+			AutofollowStats:  newReplicationAutofollowStatsFunc(t),
+		},
 	}
 }
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newAlertingAcknowledgeAlertsFunc(t Transport) AlertingAcknowledgeAlerts {
+	return func(o ...func(*AlertingAcknowledgeAlertsRequest)) (*Response, *AlertingAcknowledgeResp, error) {
+		var r = AlertingAcknowledgeAlertsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingAcknowledgeAlerts acknowledges the alerts of a monitor.
+type AlertingAcknowledgeAlerts func(o ...func(*AlertingAcknowledgeAlertsRequest)) (*Response, *AlertingAcknowledgeResp, error)
+
+// AlertingAcknowledgeAlertsRequest configures the Alerting Acknowledge Alerts API request.
+type AlertingAcknowledgeAlertsRequest struct {
+	MonitorID string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingAcknowledgeResp and error.
+func (r AlertingAcknowledgeAlertsRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingAcknowledgeResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingAcknowledgeResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_alerting/monitors") + 1 + len(r.MonitorID) + len("/_acknowledge/alerts"))
+	path.WriteString("/_plugins/_alerting/monitors")
+	path.WriteString("/")
+	path.WriteString(r.MonitorID)
+	path.WriteString("/_acknowledge/alerts")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingAcknowledgeAlerts) WithContext(v context.Context) func(*AlertingAcknowledgeAlertsRequest) {
+	return func(r *AlertingAcknowledgeAlertsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithMonitorID - the ID of the monitor.
+func (f AlertingAcknowledgeAlerts) WithMonitorID(v string) func(*AlertingAcknowledgeAlertsRequest) {
+	return func(r *AlertingAcknowledgeAlertsRequest) {
+		r.MonitorID = v
+	}
+}
+
+// WithBody - the IDs of the alerts, see AlertingAcknowledgeBody.
+func (f AlertingAcknowledgeAlerts) WithBody(v io.Reader) func(*AlertingAcknowledgeAlertsRequest) {
+	return func(r *AlertingAcknowledgeAlertsRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingAcknowledgeAlerts) WithPretty() func(*AlertingAcknowledgeAlertsRequest) {
+	return func(r *AlertingAcknowledgeAlertsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingAcknowledgeAlerts) WithHuman() func(*AlertingAcknowledgeAlertsRequest) {
+	return func(r *AlertingAcknowledgeAlertsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingAcknowledgeAlerts) WithErrorTrace() func(*AlertingAcknowledgeAlertsRequest) {
+	return func(r *AlertingAcknowledgeAlertsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingAcknowledgeAlerts) WithFilterPath(v ...string) func(*AlertingAcknowledgeAlertsRequest) {
+	return func(r *AlertingAcknowledgeAlertsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingAcknowledgeAlerts) WithHeader(h map[string]string) func(*AlertingAcknowledgeAlertsRequest) {
+	return func(r *AlertingAcknowledgeAlertsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingAcknowledgeAlerts) WithOpaqueID(s string) func(*AlertingAcknowledgeAlertsRequest) {
+	return func(r *AlertingAcknowledgeAlertsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
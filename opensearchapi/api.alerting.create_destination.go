@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newAlertingCreateDestinationFunc(t Transport) AlertingCreateDestination {
+	return func(o ...func(*AlertingCreateDestinationRequest)) (*Response, *AlertingDestinationResp, error) {
+		var r = AlertingCreateDestinationRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingCreateDestination creates a destination.
+type AlertingCreateDestination func(o ...func(*AlertingCreateDestinationRequest)) (*Response, *AlertingDestinationResp, error)
+
+// AlertingCreateDestinationRequest configures the Alerting Create Destination API request.
+type AlertingCreateDestinationRequest struct {
+	Body io.Reader
+
+	Refresh string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingDestinationResp and error.
+func (r AlertingCreateDestinationRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingDestinationResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingDestinationResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_alerting/destinations"))
+	path.WriteString("/_plugins/_alerting/destinations")
+
+	params = make(map[string]string)
+
+	if r.Refresh != "" {
+		params["refresh"] = r.Refresh
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingCreateDestination) WithContext(v context.Context) func(*AlertingCreateDestinationRequest) {
+	return func(r *AlertingCreateDestinationRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - the destination, see AlertingDestination.
+func (f AlertingCreateDestination) WithBody(v io.Reader) func(*AlertingCreateDestinationRequest) {
+	return func(r *AlertingCreateDestinationRequest) {
+		r.Body = v
+	}
+}
+
+// WithRefresh - refreshes the affected shards to make the change visible to search: true, false or wait_for.
+func (f AlertingCreateDestination) WithRefresh(v string) func(*AlertingCreateDestinationRequest) {
+	return func(r *AlertingCreateDestinationRequest) {
+		r.Refresh = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingCreateDestination) WithPretty() func(*AlertingCreateDestinationRequest) {
+	return func(r *AlertingCreateDestinationRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingCreateDestination) WithHuman() func(*AlertingCreateDestinationRequest) {
+	return func(r *AlertingCreateDestinationRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingCreateDestination) WithErrorTrace() func(*AlertingCreateDestinationRequest) {
+	return func(r *AlertingCreateDestinationRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingCreateDestination) WithFilterPath(v ...string) func(*AlertingCreateDestinationRequest) {
+	return func(r *AlertingCreateDestinationRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingCreateDestination) WithHeader(h map[string]string) func(*AlertingCreateDestinationRequest) {
+	return func(r *AlertingCreateDestinationRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingCreateDestination) WithOpaqueID(s string) func(*AlertingCreateDestinationRequest) {
+	return func(r *AlertingCreateDestinationRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
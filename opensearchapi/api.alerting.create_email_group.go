@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newAlertingCreateEmailGroupFunc(t Transport) AlertingCreateEmailGroup {
+	return func(o ...func(*AlertingCreateEmailGroupRequest)) (*Response, *AlertingEmailGroupResp, error) {
+		var r = AlertingCreateEmailGroupRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingCreateEmailGroup creates an email group.
+type AlertingCreateEmailGroup func(o ...func(*AlertingCreateEmailGroupRequest)) (*Response, *AlertingEmailGroupResp, error)
+
+// AlertingCreateEmailGroupRequest configures the Alerting Create Email Group API request.
+type AlertingCreateEmailGroupRequest struct {
+	Body io.Reader
+
+	Refresh string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingEmailGroupResp and error.
+func (r AlertingCreateEmailGroupRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingEmailGroupResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingEmailGroupResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_alerting/destinations/email_groups"))
+	path.WriteString("/_plugins/_alerting/destinations/email_groups")
+
+	params = make(map[string]string)
+
+	if r.Refresh != "" {
+		params["refresh"] = r.Refresh
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingCreateEmailGroup) WithContext(v context.Context) func(*AlertingCreateEmailGroupRequest) {
+	return func(r *AlertingCreateEmailGroupRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - the email group, see AlertingEmailGroup.
+func (f AlertingCreateEmailGroup) WithBody(v io.Reader) func(*AlertingCreateEmailGroupRequest) {
+	return func(r *AlertingCreateEmailGroupRequest) {
+		r.Body = v
+	}
+}
+
+// WithRefresh - refreshes the affected shards to make the change visible to search: true, false or wait_for.
+func (f AlertingCreateEmailGroup) WithRefresh(v string) func(*AlertingCreateEmailGroupRequest) {
+	return func(r *AlertingCreateEmailGroupRequest) {
+		r.Refresh = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingCreateEmailGroup) WithPretty() func(*AlertingCreateEmailGroupRequest) {
+	return func(r *AlertingCreateEmailGroupRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingCreateEmailGroup) WithHuman() func(*AlertingCreateEmailGroupRequest) {
+	return func(r *AlertingCreateEmailGroupRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingCreateEmailGroup) WithErrorTrace() func(*AlertingCreateEmailGroupRequest) {
+	return func(r *AlertingCreateEmailGroupRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingCreateEmailGroup) WithFilterPath(v ...string) func(*AlertingCreateEmailGroupRequest) {
+	return func(r *AlertingCreateEmailGroupRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingCreateEmailGroup) WithHeader(h map[string]string) func(*AlertingCreateEmailGroupRequest) {
+	return func(r *AlertingCreateEmailGroupRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingCreateEmailGroup) WithOpaqueID(s string) func(*AlertingCreateEmailGroupRequest) {
+	return func(r *AlertingCreateEmailGroupRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
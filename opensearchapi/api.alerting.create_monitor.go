@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newAlertingCreateMonitorFunc(t Transport) AlertingCreateMonitor {
+	return func(o ...func(*AlertingCreateMonitorRequest)) (*Response, *AlertingMonitorResp, error) {
+		var r = AlertingCreateMonitorRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingCreateMonitor creates a monitor.
+type AlertingCreateMonitor func(o ...func(*AlertingCreateMonitorRequest)) (*Response, *AlertingMonitorResp, error)
+
+// AlertingCreateMonitorRequest configures the Alerting Create Monitor API request.
+type AlertingCreateMonitorRequest struct {
+	Body io.Reader
+
+	Refresh string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingMonitorResp and error.
+func (r AlertingCreateMonitorRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingMonitorResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingMonitorResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_alerting/monitors"))
+	path.WriteString("/_plugins/_alerting/monitors")
+
+	params = make(map[string]string)
+
+	if r.Refresh != "" {
+		params["refresh"] = r.Refresh
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingCreateMonitor) WithContext(v context.Context) func(*AlertingCreateMonitorRequest) {
+	return func(r *AlertingCreateMonitorRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - the monitor, see AlertingMonitor.
+func (f AlertingCreateMonitor) WithBody(v io.Reader) func(*AlertingCreateMonitorRequest) {
+	return func(r *AlertingCreateMonitorRequest) {
+		r.Body = v
+	}
+}
+
+// WithRefresh - refreshes the affected shards to make the change visible to search: true, false or wait_for.
+func (f AlertingCreateMonitor) WithRefresh(v string) func(*AlertingCreateMonitorRequest) {
+	return func(r *AlertingCreateMonitorRequest) {
+		r.Refresh = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingCreateMonitor) WithPretty() func(*AlertingCreateMonitorRequest) {
+	return func(r *AlertingCreateMonitorRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingCreateMonitor) WithHuman() func(*AlertingCreateMonitorRequest) {
+	return func(r *AlertingCreateMonitorRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingCreateMonitor) WithErrorTrace() func(*AlertingCreateMonitorRequest) {
+	return func(r *AlertingCreateMonitorRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingCreateMonitor) WithFilterPath(v ...string) func(*AlertingCreateMonitorRequest) {
+	return func(r *AlertingCreateMonitorRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingCreateMonitor) WithHeader(h map[string]string) func(*AlertingCreateMonitorRequest) {
+	return func(r *AlertingCreateMonitorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingCreateMonitor) WithOpaqueID(s string) func(*AlertingCreateMonitorRequest) {
+	return func(r *AlertingCreateMonitorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
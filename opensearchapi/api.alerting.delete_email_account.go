@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newAlertingDeleteEmailAccountFunc(t Transport) AlertingDeleteEmailAccount {
+	return func(o ...func(*AlertingDeleteEmailAccountRequest)) (*Response, *AlertingDeleteResp, error) {
+		var r = AlertingDeleteEmailAccountRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingDeleteEmailAccount deletes an email account.
+type AlertingDeleteEmailAccount func(o ...func(*AlertingDeleteEmailAccountRequest)) (*Response, *AlertingDeleteResp, error)
+
+// AlertingDeleteEmailAccountRequest configures the Alerting Delete Email Account API request.
+type AlertingDeleteEmailAccountRequest struct {
+	EmailAccountID string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingDeleteResp and error.
+func (r AlertingDeleteEmailAccountRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingDeleteResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingDeleteResp
+	)
+	method := "DELETE"
+
+	path.Grow(len("/_plugins/_alerting/destinations/email_accounts") + 1 + len(r.EmailAccountID))
+	path.WriteString("/_plugins/_alerting/destinations/email_accounts")
+	path.WriteString("/")
+	path.WriteString(r.EmailAccountID)
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingDeleteEmailAccount) WithContext(v context.Context) func(*AlertingDeleteEmailAccountRequest) {
+	return func(r *AlertingDeleteEmailAccountRequest) {
+		r.ctx = v
+	}
+}
+
+// WithEmailAccountID - the ID of the email account.
+func (f AlertingDeleteEmailAccount) WithEmailAccountID(v string) func(*AlertingDeleteEmailAccountRequest) {
+	return func(r *AlertingDeleteEmailAccountRequest) {
+		r.EmailAccountID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingDeleteEmailAccount) WithPretty() func(*AlertingDeleteEmailAccountRequest) {
+	return func(r *AlertingDeleteEmailAccountRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingDeleteEmailAccount) WithHuman() func(*AlertingDeleteEmailAccountRequest) {
+	return func(r *AlertingDeleteEmailAccountRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingDeleteEmailAccount) WithErrorTrace() func(*AlertingDeleteEmailAccountRequest) {
+	return func(r *AlertingDeleteEmailAccountRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingDeleteEmailAccount) WithFilterPath(v ...string) func(*AlertingDeleteEmailAccountRequest) {
+	return func(r *AlertingDeleteEmailAccountRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingDeleteEmailAccount) WithHeader(h map[string]string) func(*AlertingDeleteEmailAccountRequest) {
+	return func(r *AlertingDeleteEmailAccountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingDeleteEmailAccount) WithOpaqueID(s string) func(*AlertingDeleteEmailAccountRequest) {
+	return func(r *AlertingDeleteEmailAccountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newAlertingDeleteMonitorFunc(t Transport) AlertingDeleteMonitor {
+	return func(o ...func(*AlertingDeleteMonitorRequest)) (*Response, *AlertingDeleteResp, error) {
+		var r = AlertingDeleteMonitorRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingDeleteMonitor deletes a monitor.
+type AlertingDeleteMonitor func(o ...func(*AlertingDeleteMonitorRequest)) (*Response, *AlertingDeleteResp, error)
+
+// AlertingDeleteMonitorRequest configures the Alerting Delete Monitor API request.
+type AlertingDeleteMonitorRequest struct {
+	MonitorID string
+
+	Refresh string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingDeleteResp and error.
+func (r AlertingDeleteMonitorRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingDeleteResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingDeleteResp
+	)
+	method := "DELETE"
+
+	path.Grow(len("/_plugins/_alerting/monitors") + 1 + len(r.MonitorID))
+	path.WriteString("/_plugins/_alerting/monitors")
+	path.WriteString("/")
+	path.WriteString(r.MonitorID)
+
+	params = make(map[string]string)
+
+	if r.Refresh != "" {
+		params["refresh"] = r.Refresh
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingDeleteMonitor) WithContext(v context.Context) func(*AlertingDeleteMonitorRequest) {
+	return func(r *AlertingDeleteMonitorRequest) {
+		r.ctx = v
+	}
+}
+
+// WithMonitorID - the ID of the monitor.
+func (f AlertingDeleteMonitor) WithMonitorID(v string) func(*AlertingDeleteMonitorRequest) {
+	return func(r *AlertingDeleteMonitorRequest) {
+		r.MonitorID = v
+	}
+}
+
+// WithRefresh - refreshes the affected shards to make the change visible to search: true, false or wait_for.
+func (f AlertingDeleteMonitor) WithRefresh(v string) func(*AlertingDeleteMonitorRequest) {
+	return func(r *AlertingDeleteMonitorRequest) {
+		r.Refresh = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingDeleteMonitor) WithPretty() func(*AlertingDeleteMonitorRequest) {
+	return func(r *AlertingDeleteMonitorRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingDeleteMonitor) WithHuman() func(*AlertingDeleteMonitorRequest) {
+	return func(r *AlertingDeleteMonitorRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingDeleteMonitor) WithErrorTrace() func(*AlertingDeleteMonitorRequest) {
+	return func(r *AlertingDeleteMonitorRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingDeleteMonitor) WithFilterPath(v ...string) func(*AlertingDeleteMonitorRequest) {
+	return func(r *AlertingDeleteMonitorRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingDeleteMonitor) WithHeader(h map[string]string) func(*AlertingDeleteMonitorRequest) {
+	return func(r *AlertingDeleteMonitorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingDeleteMonitor) WithOpaqueID(s string) func(*AlertingDeleteMonitorRequest) {
+	return func(r *AlertingDeleteMonitorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func newAlertingGetAlertsFunc(t Transport) AlertingGetAlerts {
+	return func(o ...func(*AlertingGetAlertsRequest)) (*Response, *AlertingAlertsResp, error) {
+		var r = AlertingGetAlertsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingGetAlerts returns the alerts.
+type AlertingGetAlerts func(o ...func(*AlertingGetAlertsRequest)) (*Response, *AlertingAlertsResp, error)
+
+// AlertingGetAlertsRequest configures the Alerting Get Alerts API request.
+type AlertingGetAlertsRequest struct {
+	SortString    string
+	SortOrder     string
+	Size          *int
+	StartIndex    *int
+	SearchString  string
+	SeverityLevel string
+	AlertState    string
+	MonitorID     string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingAlertsResp and error.
+func (r AlertingGetAlertsRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingAlertsResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingAlertsResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_alerting/monitors/alerts"))
+	path.WriteString("/_plugins/_alerting/monitors/alerts")
+
+	params = make(map[string]string)
+
+	if r.SortString != "" {
+		params["sortString"] = r.SortString
+	}
+
+	if r.SortOrder != "" {
+		params["sortOrder"] = r.SortOrder
+	}
+
+	if r.Size != nil {
+		params["size"] = strconv.FormatInt(int64(*r.Size), 10)
+	}
+
+	if r.StartIndex != nil {
+		params["startIndex"] = strconv.FormatInt(int64(*r.StartIndex), 10)
+	}
+
+	if r.SearchString != "" {
+		params["searchString"] = r.SearchString
+	}
+
+	if r.SeverityLevel != "" {
+		params["severityLevel"] = r.SeverityLevel
+	}
+
+	if r.AlertState != "" {
+		params["alertState"] = r.AlertState
+	}
+
+	if r.MonitorID != "" {
+		params["monitorId"] = r.MonitorID
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingGetAlerts) WithContext(v context.Context) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithSortString - the field to sort the alerts by.
+func (f AlertingGetAlerts) WithSortString(v string) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.SortString = v
+	}
+}
+
+// WithSortOrder - the sort order, asc or desc.
+func (f AlertingGetAlerts) WithSortOrder(v string) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.SortOrder = v
+	}
+}
+
+// WithSize - the number of alerts to return.
+func (f AlertingGetAlerts) WithSize(v int) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.Size = &v
+	}
+}
+
+// WithStartIndex - the offset of the first alert.
+func (f AlertingGetAlerts) WithStartIndex(v int) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.StartIndex = &v
+	}
+}
+
+// WithSearchString - a string to filter the alerts.
+func (f AlertingGetAlerts) WithSearchString(v string) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.SearchString = v
+	}
+}
+
+// WithSeverityLevel - the severity of the alerts, eg. 1 or ALL.
+func (f AlertingGetAlerts) WithSeverityLevel(v string) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.SeverityLevel = v
+	}
+}
+
+// WithAlertState - the state of the alerts, eg. ACTIVE, ACKNOWLEDGED or ALL.
+func (f AlertingGetAlerts) WithAlertState(v string) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.AlertState = v
+	}
+}
+
+// WithMonitorID - the monitor of the alerts.
+func (f AlertingGetAlerts) WithMonitorID(v string) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.MonitorID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingGetAlerts) WithPretty() func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingGetAlerts) WithHuman() func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingGetAlerts) WithErrorTrace() func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingGetAlerts) WithFilterPath(v ...string) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingGetAlerts) WithHeader(h map[string]string) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingGetAlerts) WithOpaqueID(s string) func(*AlertingGetAlertsRequest) {
+	return func(r *AlertingGetAlertsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
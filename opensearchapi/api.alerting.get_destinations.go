@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newAlertingGetDestinationsFunc(t Transport) AlertingGetDestinations {
+	return func(o ...func(*AlertingGetDestinationsRequest)) (*Response, *AlertingDestinationsResp, error) {
+		var r = AlertingGetDestinationsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingGetDestinations returns the destinations, or a single destination by ID.
+type AlertingGetDestinations func(o ...func(*AlertingGetDestinationsRequest)) (*Response, *AlertingDestinationsResp, error)
+
+// AlertingGetDestinationsRequest configures the Alerting Get Destinations API request.
+type AlertingGetDestinationsRequest struct {
+	DestinationID string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingDestinationsResp and error.
+func (r AlertingGetDestinationsRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingDestinationsResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingDestinationsResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_alerting/destinations") + 1 + len(r.DestinationID))
+	path.WriteString("/_plugins/_alerting/destinations")
+	if r.DestinationID != "" {
+		path.WriteString("/")
+		path.WriteString(r.DestinationID)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingGetDestinations) WithContext(v context.Context) func(*AlertingGetDestinationsRequest) {
+	return func(r *AlertingGetDestinationsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithDestinationID - the ID of the destination.
+func (f AlertingGetDestinations) WithDestinationID(v string) func(*AlertingGetDestinationsRequest) {
+	return func(r *AlertingGetDestinationsRequest) {
+		r.DestinationID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingGetDestinations) WithPretty() func(*AlertingGetDestinationsRequest) {
+	return func(r *AlertingGetDestinationsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingGetDestinations) WithHuman() func(*AlertingGetDestinationsRequest) {
+	return func(r *AlertingGetDestinationsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingGetDestinations) WithErrorTrace() func(*AlertingGetDestinationsRequest) {
+	return func(r *AlertingGetDestinationsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingGetDestinations) WithFilterPath(v ...string) func(*AlertingGetDestinationsRequest) {
+	return func(r *AlertingGetDestinationsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingGetDestinations) WithHeader(h map[string]string) func(*AlertingGetDestinationsRequest) {
+	return func(r *AlertingGetDestinationsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingGetDestinations) WithOpaqueID(s string) func(*AlertingGetDestinationsRequest) {
+	return func(r *AlertingGetDestinationsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
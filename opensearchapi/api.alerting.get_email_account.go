@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newAlertingGetEmailAccountFunc(t Transport) AlertingGetEmailAccount {
+	return func(o ...func(*AlertingGetEmailAccountRequest)) (*Response, *AlertingEmailAccountResp, error) {
+		var r = AlertingGetEmailAccountRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingGetEmailAccount returns an email account.
+type AlertingGetEmailAccount func(o ...func(*AlertingGetEmailAccountRequest)) (*Response, *AlertingEmailAccountResp, error)
+
+// AlertingGetEmailAccountRequest configures the Alerting Get Email Account API request.
+type AlertingGetEmailAccountRequest struct {
+	EmailAccountID string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingEmailAccountResp and error.
+func (r AlertingGetEmailAccountRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingEmailAccountResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingEmailAccountResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_alerting/destinations/email_accounts") + 1 + len(r.EmailAccountID))
+	path.WriteString("/_plugins/_alerting/destinations/email_accounts")
+	path.WriteString("/")
+	path.WriteString(r.EmailAccountID)
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingGetEmailAccount) WithContext(v context.Context) func(*AlertingGetEmailAccountRequest) {
+	return func(r *AlertingGetEmailAccountRequest) {
+		r.ctx = v
+	}
+}
+
+// WithEmailAccountID - the ID of the email account.
+func (f AlertingGetEmailAccount) WithEmailAccountID(v string) func(*AlertingGetEmailAccountRequest) {
+	return func(r *AlertingGetEmailAccountRequest) {
+		r.EmailAccountID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingGetEmailAccount) WithPretty() func(*AlertingGetEmailAccountRequest) {
+	return func(r *AlertingGetEmailAccountRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingGetEmailAccount) WithHuman() func(*AlertingGetEmailAccountRequest) {
+	return func(r *AlertingGetEmailAccountRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingGetEmailAccount) WithErrorTrace() func(*AlertingGetEmailAccountRequest) {
+	return func(r *AlertingGetEmailAccountRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingGetEmailAccount) WithFilterPath(v ...string) func(*AlertingGetEmailAccountRequest) {
+	return func(r *AlertingGetEmailAccountRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingGetEmailAccount) WithHeader(h map[string]string) func(*AlertingGetEmailAccountRequest) {
+	return func(r *AlertingGetEmailAccountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingGetEmailAccount) WithOpaqueID(s string) func(*AlertingGetEmailAccountRequest) {
+	return func(r *AlertingGetEmailAccountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newAlertingGetMonitorFunc(t Transport) AlertingGetMonitor {
+	return func(o ...func(*AlertingGetMonitorRequest)) (*Response, *AlertingMonitorResp, error) {
+		var r = AlertingGetMonitorRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingGetMonitor returns a monitor.
+type AlertingGetMonitor func(o ...func(*AlertingGetMonitorRequest)) (*Response, *AlertingMonitorResp, error)
+
+// AlertingGetMonitorRequest configures the Alerting Get Monitor API request.
+type AlertingGetMonitorRequest struct {
+	MonitorID string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingMonitorResp and error.
+func (r AlertingGetMonitorRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingMonitorResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingMonitorResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_alerting/monitors") + 1 + len(r.MonitorID))
+	path.WriteString("/_plugins/_alerting/monitors")
+	path.WriteString("/")
+	path.WriteString(r.MonitorID)
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingGetMonitor) WithContext(v context.Context) func(*AlertingGetMonitorRequest) {
+	return func(r *AlertingGetMonitorRequest) {
+		r.ctx = v
+	}
+}
+
+// WithMonitorID - the ID of the monitor.
+func (f AlertingGetMonitor) WithMonitorID(v string) func(*AlertingGetMonitorRequest) {
+	return func(r *AlertingGetMonitorRequest) {
+		r.MonitorID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingGetMonitor) WithPretty() func(*AlertingGetMonitorRequest) {
+	return func(r *AlertingGetMonitorRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingGetMonitor) WithHuman() func(*AlertingGetMonitorRequest) {
+	return func(r *AlertingGetMonitorRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingGetMonitor) WithErrorTrace() func(*AlertingGetMonitorRequest) {
+	return func(r *AlertingGetMonitorRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingGetMonitor) WithFilterPath(v ...string) func(*AlertingGetMonitorRequest) {
+	return func(r *AlertingGetMonitorRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingGetMonitor) WithHeader(h map[string]string) func(*AlertingGetMonitorRequest) {
+	return func(r *AlertingGetMonitorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingGetMonitor) WithOpaqueID(s string) func(*AlertingGetMonitorRequest) {
+	return func(r *AlertingGetMonitorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
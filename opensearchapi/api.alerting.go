@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import "encoding/json"
+
+// The types of the alerting monitors.
+const (
+	AlertingQueryLevelMonitor    = "query_level_monitor"
+	AlertingBucketLevelMonitor   = "bucket_level_monitor"
+	AlertingDocumentLevelMonitor = "doc_level_monitor"
+)
+
+// AlertingMonitor represents a monitor: it runs the inputs on the schedule, and the actions
+// of the triggers whose condition is met. The fields set by the plugin are omitted when empty,
+// so that a monitor returned by the Get Monitor API can be sent back to the Update Monitor API.
+type AlertingMonitor struct {
+	Type           string            `json:"type"`
+	MonitorType    string            `json:"monitor_type,omitempty"`
+	Name           string            `json:"name"`
+	Enabled        bool              `json:"enabled"`
+	EnabledTime    *int64            `json:"enabled_time,omitempty"`
+	LastUpdateTime int64             `json:"last_update_time,omitempty"`
+	SchemaVersion  int               `json:"schema_version,omitempty"`
+	Owner          string            `json:"owner,omitempty"`
+	User           json.RawMessage   `json:"user,omitempty"`
+	Schedule       AlertingSchedule  `json:"schedule"`
+	Inputs         []AlertingInput   `json:"inputs"`
+	Triggers       []AlertingTrigger `json:"triggers"`
+	UIMetadata     json.RawMessage   `json:"ui_metadata,omitempty"`
+}
+
+// NewAlertingMonitor returns an enabled monitor of the type, eg. AlertingQueryLevelMonitor.
+func NewAlertingMonitor(monitorType, name string, schedule AlertingSchedule) AlertingMonitor {
+	return AlertingMonitor{Type: "monitor", MonitorType: monitorType, Name: name, Enabled: true, Schedule: schedule}
+}
+
+// AlertingSchedule represents the schedule of a monitor, either a period or a cron expression.
+type AlertingSchedule struct {
+	Period *AlertingPeriod `json:"period,omitempty"`
+	Cron   *AlertingCron   `json:"cron,omitempty"`
+}
+
+// AlertingPeriod represents a period, eg. {Interval: 1, Unit: "MINUTES"}.
+type AlertingPeriod struct {
+	Interval int    `json:"interval"`
+	Unit     string `json:"unit"`
+}
+
+// AlertingCron represents a cron expression, eg. {Expression: "0 */1 * * *", Timezone: "UTC"}.
+type AlertingCron struct {
+	Expression string `json:"expression"`
+	Timezone   string `json:"timezone"`
+}
+
+// AlertingInput represents an input of a monitor: a search for the query and bucket level monitors,
+// or the document level queries for the document level monitors.
+type AlertingInput struct {
+	Search        *AlertingSearchInput   `json:"search,omitempty"`
+	DocLevelInput *AlertingDocLevelInput `json:"doc_level_input,omitempty"`
+}
+
+// AlertingSearchInput represents a search of the indices; the query is a search request body.
+type AlertingSearchInput struct {
+	Indices []string        `json:"indices"`
+	Query   json.RawMessage `json:"query"`
+}
+
+// AlertingDocLevelInput represents the queries of a document level monitor.
+type AlertingDocLevelInput struct {
+	Description string                  `json:"description,omitempty"`
+	Indices     []string                `json:"indices"`
+	Queries     []AlertingDocLevelQuery `json:"queries"`
+}
+
+// AlertingDocLevelQuery represents a query of a document level monitor, in the query string syntax.
+type AlertingDocLevelQuery struct {
+	ID    string   `json:"id"`
+	Name  string   `json:"name"`
+	Query string   `json:"query"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+// AlertingTrigger represents a trigger of a monitor; the field of the monitor type must be set.
+type AlertingTrigger struct {
+	QueryLevelTrigger    *AlertingTriggerDefinition `json:"query_level_trigger,omitempty"`
+	BucketLevelTrigger   *AlertingTriggerDefinition `json:"bucket_level_trigger,omitempty"`
+	DocumentLevelTrigger *AlertingTriggerDefinition `json:"document_level_trigger,omitempty"`
+}
+
+// AlertingTriggerDefinition represents the condition, severity and actions of a trigger.
+// The Severity is a level from "1" (highest) to "5".
+type AlertingTriggerDefinition struct {
+	ID        string            `json:"id,omitempty"`
+	Name      string            `json:"name"`
+	Severity  string            `json:"severity"`
+	Condition AlertingCondition `json:"condition"`
+	Actions   []AlertingAction  `json:"actions"`
+}
+
+// AlertingCondition represents the condition of a trigger: a script returning a boolean, and for the
+// bucket level triggers the buckets path of the variables of the script and the parent aggregation.
+type AlertingCondition struct {
+	Script           *AlertingScript   `json:"script,omitempty"`
+	BucketsPath      map[string]string `json:"buckets_path,omitempty"`
+	ParentBucketPath string            `json:"parent_bucket_path,omitempty"`
+}
+
+// AlertingScript represents a script, in the painless language by default.
+type AlertingScript struct {
+	Source string                 `json:"source"`
+	Lang   string                 `json:"lang,omitempty"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// AlertingAction represents an action of a trigger, which sends a message to a destination
+// or a Notifications channel.
+type AlertingAction struct {
+	ID                    string            `json:"id,omitempty"`
+	Name                  string            `json:"name"`
+	DestinationID         string            `json:"destination_id"`
+	MessageTemplate       AlertingTemplate  `json:"message_template"`
+	SubjectTemplate       *AlertingTemplate `json:"subject_template,omitempty"`
+	ThrottleEnabled       bool              `json:"throttle_enabled"`
+	Throttle              *AlertingThrottle `json:"throttle,omitempty"`
+	ActionExecutionPolicy json.RawMessage   `json:"action_execution_policy,omitempty"`
+}
+
+// AlertingTemplate represents a mustache template, eg. "Monitor {{ctx.monitor.name}} just entered alert status.".
+type AlertingTemplate struct {
+	Source string `json:"source"`
+	Lang   string `json:"lang,omitempty"`
+}
+
+// AlertingThrottle represents the minimum time between two runs of an action, eg. {Value: 10, Unit: "MINUTES"}.
+type AlertingThrottle struct {
+	Value int    `json:"value"`
+	Unit  string `json:"unit"`
+}
+
+// AlertingMonitorResp is the response of the Alerting Create, Update and Get Monitor APIs.
+type AlertingMonitorResp struct {
+	ID          string          `json:"_id"`
+	Version     int64           `json:"_version"`
+	SeqNo       int64           `json:"_seq_no"`
+	PrimaryTerm int64           `json:"_primary_term"`
+	Monitor     AlertingMonitor `json:"monitor"`
+}
+
+// AlertingDeleteResp is the response of the Alerting Delete APIs.
+type AlertingDeleteResp struct {
+	Index         string     `json:"_index"`
+	ID            string     `json:"_id"`
+	Version       int64      `json:"_version"`
+	Result        string     `json:"result"`
+	ForcedRefresh bool       `json:"forced_refresh,omitempty"`
+	Shards        ShardsInfo `json:"_shards"`
+	SeqNo         int64      `json:"_seq_no"`
+	PrimaryTerm   int64      `json:"_primary_term"`
+}
+
+// AlertingRunMonitorResp is the response of the Alerting Run Monitor API.
+type AlertingRunMonitorResp struct {
+	MonitorName    string                           `json:"monitor_name"`
+	PeriodStart    int64                            `json:"period_start"`
+	PeriodEnd      int64                            `json:"period_end"`
+	Error          *string                          `json:"error"`
+	InputResults   AlertingInputResults             `json:"input_results"`
+	TriggerResults map[string]AlertingTriggerResult `json:"trigger_results"`
+}
+
+// AlertingInputResults represents the results of the inputs of a monitor, eg. the search responses.
+type AlertingInputResults struct {
+	Results []json.RawMessage `json:"results"`
+	Error   *string           `json:"error"`
+}
+
+// AlertingTriggerResult represents the result of a trigger, by trigger ID. The bucket level triggers
+// return the matching buckets, and the document level triggers the matching documents.
+type AlertingTriggerResult struct {
+	Name             string                     `json:"name"`
+	Triggered        bool                       `json:"triggered"`
+	Error            *string                    `json:"error"`
+	ActionResults    map[string]json.RawMessage `json:"action_results"`
+	AggResultBuckets json.RawMessage            `json:"agg_result_buckets,omitempty"`
+	TriggeredDocs    []string                   `json:"triggeredDocs,omitempty"`
+}
+
+// AlertingAcknowledgeBody is the body of the Alerting Acknowledge Alerts API.
+type AlertingAcknowledgeBody struct {
+	Alerts []string `json:"alerts"`
+}
+
+// AlertingAcknowledgeResp is the response of the Alerting Acknowledge Alerts API.
+type AlertingAcknowledgeResp struct {
+	Success []string          `json:"success"`
+	Failed  []json.RawMessage `json:"failed"`
+}
+
+// AlertingAlertsResp is the response of the Alerting Get Alerts API.
+type AlertingAlertsResp struct {
+	Alerts      []AlertingAlert `json:"alerts"`
+	TotalAlerts int             `json:"totalAlerts"`
+}
+
+// AlertingAlert represents an alert; the State is ACTIVE, ACKNOWLEDGED, COMPLETED, ERROR or DELETED.
+type AlertingAlert struct {
+	ID                     string            `json:"id"`
+	Version                int64             `json:"version"`
+	MonitorID              string            `json:"monitor_id"`
+	SchemaVersion          int               `json:"schema_version"`
+	MonitorVersion         int64             `json:"monitor_version"`
+	MonitorName            string            `json:"monitor_name"`
+	MonitorUser            json.RawMessage   `json:"monitor_user,omitempty"`
+	TriggerID              string            `json:"trigger_id"`
+	TriggerName            string            `json:"trigger_name"`
+	State                  string            `json:"state"`
+	ErrorMessage           *string           `json:"error_message"`
+	AlertHistory           []json.RawMessage `json:"alert_history"`
+	Severity               string            `json:"severity"`
+	ActionExecutionResults []json.RawMessage `json:"action_execution_results"`
+	StartTime              int64             `json:"start_time"`
+	LastNotificationTime   *int64            `json:"last_notification_time"`
+	EndTime                *int64            `json:"end_time"`
+	AcknowledgedTime       *int64            `json:"acknowledged_time"`
+}
+
+// AlertingDestination represents a destination of the actions. The settings of the type are
+// kept raw, eg. {"url": "https://hooks.slack.com/..."} for slack.
+//
+// The destinations are deprecated in favor of the channels of the Notifications plugin.
+type AlertingDestination struct {
+	ID             string          `json:"id,omitempty"`
+	Type           string          `json:"type"`
+	Name           string          `json:"name"`
+	SchemaVersion  int             `json:"schema_version,omitempty"`
+	SeqNo          int64           `json:"seq_no,omitempty"`
+	PrimaryTerm    int64           `json:"primary_term,omitempty"`
+	LastUpdateTime int64           `json:"last_update_time,omitempty"`
+	User           json.RawMessage `json:"user,omitempty"`
+	Slack          json.RawMessage `json:"slack,omitempty"`
+	Chime          json.RawMessage `json:"chime,omitempty"`
+	CustomWebhook  json.RawMessage `json:"custom_webhook,omitempty"`
+	Email          json.RawMessage `json:"email,omitempty"`
+}
+
+// AlertingDestinationResp is the response of the Alerting Create and Update Destination APIs.
+type AlertingDestinationResp struct {
+	ID          string              `json:"_id"`
+	Version     int64               `json:"_version"`
+	SeqNo       int64               `json:"_seq_no"`
+	PrimaryTerm int64               `json:"_primary_term"`
+	Destination AlertingDestination `json:"destination"`
+}
+
+// AlertingDestinationsResp is the response of the Alerting Get Destinations API.
+type AlertingDestinationsResp struct {
+	TotalDestinations int                   `json:"totalDestinations"`
+	Destinations      []AlertingDestination `json:"destinations"`
+}
+
+// AlertingEmailAccount represents the SMTP account of the email destinations; Method is none, ssl or starttls.
+type AlertingEmailAccount struct {
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+	Host   string `json:"host"`
+	Port   int    `json:"port"`
+	Method string `json:"method"`
+}
+
+// AlertingEmailAccountResp is the response of the Alerting Create, Update and Get Email Account APIs.
+type AlertingEmailAccountResp struct {
+	ID           string               `json:"_id"`
+	Version      int64                `json:"_version"`
+	SeqNo        int64                `json:"_seq_no"`
+	PrimaryTerm  int64                `json:"_primary_term"`
+	EmailAccount AlertingEmailAccount `json:"email_account"`
+}
+
+// AlertingEmailGroup represents a group of recipients of the email destinations.
+type AlertingEmailGroup struct {
+	Name   string                   `json:"name"`
+	Emails []AlertingEmailRecipient `json:"emails"`
+}
+
+// AlertingEmailRecipient represents a recipient of an email group.
+type AlertingEmailRecipient struct {
+	Email string `json:"email"`
+}
+
+// AlertingEmailGroupResp is the response of the Alerting Create, Update and Get Email Group APIs.
+type AlertingEmailGroupResp struct {
+	ID          string             `json:"_id"`
+	Version     int64              `json:"_version"`
+	SeqNo       int64              `json:"_seq_no"`
+	PrimaryTerm int64              `json:"_primary_term"`
+	EmailGroup  AlertingEmailGroup `json:"email_group"`
+}
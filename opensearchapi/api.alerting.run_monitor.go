@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newAlertingRunMonitorFunc(t Transport) AlertingRunMonitor {
+	return func(o ...func(*AlertingRunMonitorRequest)) (*Response, *AlertingRunMonitorResp, error) {
+		var r = AlertingRunMonitorRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingRunMonitor runs a monitor, or the monitor of the body when the ID is empty.
+type AlertingRunMonitor func(o ...func(*AlertingRunMonitorRequest)) (*Response, *AlertingRunMonitorResp, error)
+
+// AlertingRunMonitorRequest configures the Alerting Run Monitor API request.
+type AlertingRunMonitorRequest struct {
+	MonitorID string
+
+	Body io.Reader
+
+	DryRun bool
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingRunMonitorResp and error.
+func (r AlertingRunMonitorRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingRunMonitorResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingRunMonitorResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_alerting/monitors") + 1 + len(r.MonitorID) + len("/_execute"))
+	path.WriteString("/_plugins/_alerting/monitors")
+	if r.MonitorID != "" {
+		path.WriteString("/")
+		path.WriteString(r.MonitorID)
+	}
+	path.WriteString("/_execute")
+
+	params = make(map[string]string)
+
+	if r.DryRun {
+		params["dryrun"] = "true"
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingRunMonitor) WithContext(v context.Context) func(*AlertingRunMonitorRequest) {
+	return func(r *AlertingRunMonitorRequest) {
+		r.ctx = v
+	}
+}
+
+// WithMonitorID - the ID of the monitor to run.
+func (f AlertingRunMonitor) WithMonitorID(v string) func(*AlertingRunMonitorRequest) {
+	return func(r *AlertingRunMonitorRequest) {
+		r.MonitorID = v
+	}
+}
+
+// WithBody - the monitor to run when the ID is empty, see AlertingMonitor.
+func (f AlertingRunMonitor) WithBody(v io.Reader) func(*AlertingRunMonitorRequest) {
+	return func(r *AlertingRunMonitorRequest) {
+		r.Body = v
+	}
+}
+
+// WithDryRun - runs the monitor without running the actions.
+func (f AlertingRunMonitor) WithDryRun() func(*AlertingRunMonitorRequest) {
+	return func(r *AlertingRunMonitorRequest) {
+		r.DryRun = true
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingRunMonitor) WithPretty() func(*AlertingRunMonitorRequest) {
+	return func(r *AlertingRunMonitorRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingRunMonitor) WithHuman() func(*AlertingRunMonitorRequest) {
+	return func(r *AlertingRunMonitorRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingRunMonitor) WithErrorTrace() func(*AlertingRunMonitorRequest) {
+	return func(r *AlertingRunMonitorRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingRunMonitor) WithFilterPath(v ...string) func(*AlertingRunMonitorRequest) {
+	return func(r *AlertingRunMonitorRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingRunMonitor) WithHeader(h map[string]string) func(*AlertingRunMonitorRequest) {
+	return func(r *AlertingRunMonitorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingRunMonitor) WithOpaqueID(s string) func(*AlertingRunMonitorRequest) {
+	return func(r *AlertingRunMonitorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
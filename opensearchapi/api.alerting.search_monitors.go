@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newAlertingSearchMonitorsFunc(t Transport) AlertingSearchMonitors {
+	return func(o ...func(*AlertingSearchMonitorsRequest)) (*Response, *SearchResponse, error) {
+		var r = AlertingSearchMonitorsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingSearchMonitors searches the monitors.
+type AlertingSearchMonitors func(o ...func(*AlertingSearchMonitorsRequest)) (*Response, *SearchResponse, error)
+
+// AlertingSearchMonitorsRequest configures the Alerting Search Monitors API request.
+type AlertingSearchMonitorsRequest struct {
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SearchResponse and error.
+func (r AlertingSearchMonitorsRequest) Do(ctx context.Context, transport Transport) (*Response, *SearchResponse, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SearchResponse
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_alerting/monitors/_search"))
+	path.WriteString("/_plugins/_alerting/monitors/_search")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingSearchMonitors) WithContext(v context.Context) func(*AlertingSearchMonitorsRequest) {
+	return func(r *AlertingSearchMonitorsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - the search request, eg. {"query": {"match": {"monitor.name": "errors"}}}.
+func (f AlertingSearchMonitors) WithBody(v io.Reader) func(*AlertingSearchMonitorsRequest) {
+	return func(r *AlertingSearchMonitorsRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingSearchMonitors) WithPretty() func(*AlertingSearchMonitorsRequest) {
+	return func(r *AlertingSearchMonitorsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingSearchMonitors) WithHuman() func(*AlertingSearchMonitorsRequest) {
+	return func(r *AlertingSearchMonitorsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingSearchMonitors) WithErrorTrace() func(*AlertingSearchMonitorsRequest) {
+	return func(r *AlertingSearchMonitorsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingSearchMonitors) WithFilterPath(v ...string) func(*AlertingSearchMonitorsRequest) {
+	return func(r *AlertingSearchMonitorsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingSearchMonitors) WithHeader(h map[string]string) func(*AlertingSearchMonitorsRequest) {
+	return func(r *AlertingSearchMonitorsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingSearchMonitors) WithOpaqueID(s string) func(*AlertingSearchMonitorsRequest) {
+	return func(r *AlertingSearchMonitorsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func newAlertingUpdateDestinationFunc(t Transport) AlertingUpdateDestination {
+	return func(o ...func(*AlertingUpdateDestinationRequest)) (*Response, *AlertingDestinationResp, error) {
+		var r = AlertingUpdateDestinationRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingUpdateDestination updates a destination.
+type AlertingUpdateDestination func(o ...func(*AlertingUpdateDestinationRequest)) (*Response, *AlertingDestinationResp, error)
+
+// AlertingUpdateDestinationRequest configures the Alerting Update Destination API request.
+type AlertingUpdateDestinationRequest struct {
+	DestinationID string
+
+	Body io.Reader
+
+	IfSeqNo       *int64
+	IfPrimaryTerm *int64
+	Refresh       string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingDestinationResp and error.
+func (r AlertingUpdateDestinationRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingDestinationResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingDestinationResp
+	)
+	method := "PUT"
+
+	path.Grow(len("/_plugins/_alerting/destinations") + 1 + len(r.DestinationID))
+	path.WriteString("/_plugins/_alerting/destinations")
+	path.WriteString("/")
+	path.WriteString(r.DestinationID)
+
+	params = make(map[string]string)
+
+	if r.IfSeqNo != nil {
+		params["if_seq_no"] = strconv.FormatInt(*r.IfSeqNo, 10)
+	}
+
+	if r.IfPrimaryTerm != nil {
+		params["if_primary_term"] = strconv.FormatInt(*r.IfPrimaryTerm, 10)
+	}
+
+	if r.Refresh != "" {
+		params["refresh"] = r.Refresh
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingUpdateDestination) WithContext(v context.Context) func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		r.ctx = v
+	}
+}
+
+// WithDestinationID - the ID of the destination.
+func (f AlertingUpdateDestination) WithDestinationID(v string) func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		r.DestinationID = v
+	}
+}
+
+// WithBody - the destination, see AlertingDestination.
+func (f AlertingUpdateDestination) WithBody(v io.Reader) func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		r.Body = v
+	}
+}
+
+// WithIfSeqNo - only updates the document if it has this sequence number.
+func (f AlertingUpdateDestination) WithIfSeqNo(v int64) func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		r.IfSeqNo = &v
+	}
+}
+
+// WithIfPrimaryTerm - only updates the document if it has this primary term.
+func (f AlertingUpdateDestination) WithIfPrimaryTerm(v int64) func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		r.IfPrimaryTerm = &v
+	}
+}
+
+// WithRefresh - refreshes the affected shards to make the change visible to search: true, false or wait_for.
+func (f AlertingUpdateDestination) WithRefresh(v string) func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		r.Refresh = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingUpdateDestination) WithPretty() func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingUpdateDestination) WithHuman() func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingUpdateDestination) WithErrorTrace() func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingUpdateDestination) WithFilterPath(v ...string) func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingUpdateDestination) WithHeader(h map[string]string) func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingUpdateDestination) WithOpaqueID(s string) func(*AlertingUpdateDestinationRequest) {
+	return func(r *AlertingUpdateDestinationRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
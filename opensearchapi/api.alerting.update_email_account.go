@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func newAlertingUpdateEmailAccountFunc(t Transport) AlertingUpdateEmailAccount {
+	return func(o ...func(*AlertingUpdateEmailAccountRequest)) (*Response, *AlertingEmailAccountResp, error) {
+		var r = AlertingUpdateEmailAccountRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingUpdateEmailAccount updates an email account.
+type AlertingUpdateEmailAccount func(o ...func(*AlertingUpdateEmailAccountRequest)) (*Response, *AlertingEmailAccountResp, error)
+
+// AlertingUpdateEmailAccountRequest configures the Alerting Update Email Account API request.
+type AlertingUpdateEmailAccountRequest struct {
+	EmailAccountID string
+
+	Body io.Reader
+
+	IfSeqNo       *int64
+	IfPrimaryTerm *int64
+	Refresh       string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingEmailAccountResp and error.
+func (r AlertingUpdateEmailAccountRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingEmailAccountResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingEmailAccountResp
+	)
+	method := "PUT"
+
+	path.Grow(len("/_plugins/_alerting/destinations/email_accounts") + 1 + len(r.EmailAccountID))
+	path.WriteString("/_plugins/_alerting/destinations/email_accounts")
+	path.WriteString("/")
+	path.WriteString(r.EmailAccountID)
+
+	params = make(map[string]string)
+
+	if r.IfSeqNo != nil {
+		params["if_seq_no"] = strconv.FormatInt(*r.IfSeqNo, 10)
+	}
+
+	if r.IfPrimaryTerm != nil {
+		params["if_primary_term"] = strconv.FormatInt(*r.IfPrimaryTerm, 10)
+	}
+
+	if r.Refresh != "" {
+		params["refresh"] = r.Refresh
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingUpdateEmailAccount) WithContext(v context.Context) func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		r.ctx = v
+	}
+}
+
+// WithEmailAccountID - the ID of the email account.
+func (f AlertingUpdateEmailAccount) WithEmailAccountID(v string) func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		r.EmailAccountID = v
+	}
+}
+
+// WithBody - the email account, see AlertingEmailAccount.
+func (f AlertingUpdateEmailAccount) WithBody(v io.Reader) func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		r.Body = v
+	}
+}
+
+// WithIfSeqNo - only updates the document if it has this sequence number.
+func (f AlertingUpdateEmailAccount) WithIfSeqNo(v int64) func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		r.IfSeqNo = &v
+	}
+}
+
+// WithIfPrimaryTerm - only updates the document if it has this primary term.
+func (f AlertingUpdateEmailAccount) WithIfPrimaryTerm(v int64) func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		r.IfPrimaryTerm = &v
+	}
+}
+
+// WithRefresh - refreshes the affected shards to make the change visible to search: true, false or wait_for.
+func (f AlertingUpdateEmailAccount) WithRefresh(v string) func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		r.Refresh = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingUpdateEmailAccount) WithPretty() func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingUpdateEmailAccount) WithHuman() func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingUpdateEmailAccount) WithErrorTrace() func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingUpdateEmailAccount) WithFilterPath(v ...string) func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingUpdateEmailAccount) WithHeader(h map[string]string) func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingUpdateEmailAccount) WithOpaqueID(s string) func(*AlertingUpdateEmailAccountRequest) {
+	return func(r *AlertingUpdateEmailAccountRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func newAlertingUpdateEmailGroupFunc(t Transport) AlertingUpdateEmailGroup {
+	return func(o ...func(*AlertingUpdateEmailGroupRequest)) (*Response, *AlertingEmailGroupResp, error) {
+		var r = AlertingUpdateEmailGroupRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AlertingUpdateEmailGroup updates an email group.
+type AlertingUpdateEmailGroup func(o ...func(*AlertingUpdateEmailGroupRequest)) (*Response, *AlertingEmailGroupResp, error)
+
+// AlertingUpdateEmailGroupRequest configures the Alerting Update Email Group API request.
+type AlertingUpdateEmailGroupRequest struct {
+	EmailGroupID string
+
+	Body io.Reader
+
+	IfSeqNo       *int64
+	IfPrimaryTerm *int64
+	Refresh       string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AlertingEmailGroupResp and error.
+func (r AlertingUpdateEmailGroupRequest) Do(ctx context.Context, transport Transport) (*Response, *AlertingEmailGroupResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AlertingEmailGroupResp
+	)
+	method := "PUT"
+
+	path.Grow(len("/_plugins/_alerting/destinations/email_groups") + 1 + len(r.EmailGroupID))
+	path.WriteString("/_plugins/_alerting/destinations/email_groups")
+	path.WriteString("/")
+	path.WriteString(r.EmailGroupID)
+
+	params = make(map[string]string)
+
+	if r.IfSeqNo != nil {
+		params["if_seq_no"] = strconv.FormatInt(*r.IfSeqNo, 10)
+	}
+
+	if r.IfPrimaryTerm != nil {
+		params["if_primary_term"] = strconv.FormatInt(*r.IfPrimaryTerm, 10)
+	}
+
+	if r.Refresh != "" {
+		params["refresh"] = r.Refresh
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AlertingUpdateEmailGroup) WithContext(v context.Context) func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		r.ctx = v
+	}
+}
+
+// WithEmailGroupID - the ID of the email group.
+func (f AlertingUpdateEmailGroup) WithEmailGroupID(v string) func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		r.EmailGroupID = v
+	}
+}
+
+// WithBody - the email group, see AlertingEmailGroup.
+func (f AlertingUpdateEmailGroup) WithBody(v io.Reader) func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		r.Body = v
+	}
+}
+
+// WithIfSeqNo - only updates the document if it has this sequence number.
+func (f AlertingUpdateEmailGroup) WithIfSeqNo(v int64) func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		r.IfSeqNo = &v
+	}
+}
+
+// WithIfPrimaryTerm - only updates the document if it has this primary term.
+func (f AlertingUpdateEmailGroup) WithIfPrimaryTerm(v int64) func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		r.IfPrimaryTerm = &v
+	}
+}
+
+// WithRefresh - refreshes the affected shards to make the change visible to search: true, false or wait_for.
+func (f AlertingUpdateEmailGroup) WithRefresh(v string) func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		r.Refresh = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AlertingUpdateEmailGroup) WithPretty() func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AlertingUpdateEmailGroup) WithHuman() func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AlertingUpdateEmailGroup) WithErrorTrace() func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AlertingUpdateEmailGroup) WithFilterPath(v ...string) func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AlertingUpdateEmailGroup) WithHeader(h map[string]string) func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AlertingUpdateEmailGroup) WithOpaqueID(s string) func(*AlertingUpdateEmailGroupRequest) {
+	return func(r *AlertingUpdateEmailGroupRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
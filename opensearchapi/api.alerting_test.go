@@ -0,0 +1,347 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAlerting(t *testing.T) {
+	ctx := context.Background()
+	body := func(s string) io.Reader { return strings.NewReader(s) }
+
+	raw := func(s string) json.RawMessage { return json.RawMessage(s) }
+	int64p := func(v int64) *int64 { return &v }
+
+	monitor := &AlertingMonitorResp{
+		ID: "vd5k2GsBlQ5JUWWFxhsP", Version: 1, SeqNo: 7, PrimaryTerm: 1,
+		Monitor: AlertingMonitor{
+			Type:           "monitor",
+			MonitorType:    AlertingQueryLevelMonitor,
+			Name:           "test-monitor",
+			Enabled:        true,
+			EnabledTime:    int64p(1562703611363),
+			LastUpdateTime: 1562703611363,
+			SchemaVersion:  5,
+			User:           raw(`{"name": "admin", "backend_roles": [], "roles": ["all_access"], "custom_attribute_names": [], "user_requested_tenant": null}`),
+			Schedule:       AlertingSchedule{Period: &AlertingPeriod{Interval: 1, Unit: "MINUTES"}},
+			Inputs: []AlertingInput{{Search: &AlertingSearchInput{
+				Indices: []string{"movies"},
+				Query: raw(`{"size": 0, "query": {"bool": {"filter": [{"range": {"@timestamp": ` +
+					`{"gte": "{{period_end}}||-1h", "lte": "{{period_end}}", "format": "epoch_millis"}}}]}}}`),
+			}}},
+			Triggers: []AlertingTrigger{{QueryLevelTrigger: &AlertingTriggerDefinition{
+				ID:        "ud5k2GsBlQ5JUWWFxRvi",
+				Name:      "test-trigger",
+				Severity:  "1",
+				Condition: AlertingCondition{Script: &AlertingScript{Source: "ctx.results[0].hits.total.value > 0", Lang: "painless"}},
+				Actions: []AlertingAction{{
+					ID:              "ut5k2GsBlQ5JUWWFxRvj",
+					Name:            "test-action",
+					DestinationID:   "ld7912sBlQ5JUWWFThoW",
+					MessageTemplate: AlertingTemplate{Source: "This is my message body.", Lang: "mustache"},
+					SubjectTemplate: &AlertingTemplate{Source: "TheSubject", Lang: "mustache"},
+				}},
+			}}},
+		},
+	}
+	deleted := &AlertingDeleteResp{
+		Index: ".opendistro-alerting-config", ID: "OYAHOmgBl3cmwnqZl_yH", Version: 2, Result: "deleted", ForcedRefresh: true,
+		Shards: ShardsInfo{Total: 2, Successful: 2}, SeqNo: 11, PrimaryTerm: 1,
+	}
+	run := &AlertingRunMonitorResp{
+		MonitorName: "logs",
+		PeriodStart: 1547161872322,
+		PeriodEnd:   1547161932322,
+		InputResults: AlertingInputResults{Results: []json.RawMessage{raw(`{"_shards": {"total": 1, "failed": 0, "successful": 1, "skipped": 0}, ` +
+			`"hits": {"hits": [], "total": {"value": 0, "relation": "eq"}, "max_score": null}, "took": 1, "timed_out": false}`)}},
+		TriggerResults: map[string]AlertingTriggerResult{
+			"test-trigger": {Name: "test-trigger", Triggered: true, ActionResults: map[string]json.RawMessage{}},
+		},
+	}
+	destination := &AlertingDestinationResp{
+		ID: "nO-yFmkB8NzS6aXjJdiI", Version: 1, SeqNo: 3, PrimaryTerm: 1,
+		Destination: AlertingDestination{
+			Type:           "slack",
+			Name:           "my-destination",
+			LastUpdateTime: 1550863967624,
+			Slack:          raw(`{"url": "http://www.example.com"}`),
+			Chime:          raw(`null`),
+			CustomWebhook:  raw(`null`),
+		},
+	}
+	emailAccount := &AlertingEmailAccountResp{
+		ID: "email_account_id", Version: 1, SeqNo: 7, PrimaryTerm: 2,
+		EmailAccount: AlertingEmailAccount{Name: "example_account", Email: "example@email.com", Host: "smtp.email.com", Port: 465, Method: "ssl"},
+	}
+	emailGroup := &AlertingEmailGroupResp{
+		ID: "email_group_id", Version: 1, SeqNo: 9, PrimaryTerm: 2,
+		EmailGroup: AlertingEmailGroup{Name: "example_email_group", Emails: []AlertingEmailRecipient{{Email: "example@email.com"}}},
+	}
+	score := 0.6931472
+
+	testPlugin(t, pluginError{404, "alerting/error.json"}, []pluginTest{
+		{"CreateMonitor", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingCreateMonitorRequest{Body: body(`{"type":"monitor"}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_alerting/monitors", `{"type":"monitor"}`,
+			"alerting/monitor.json", monitor},
+		{"UpdateMonitor", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingUpdateMonitorRequest{MonitorID: "vd5k2GsBlQ5JUWWFxhsP", Body: body(`{"type":"monitor"}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_alerting/monitors/vd5k2GsBlQ5JUWWFxhsP", `{"type":"monitor"}`,
+			"alerting/monitor.json", monitor},
+		{"GetMonitor", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingGetMonitorRequest{MonitorID: "vd5k2GsBlQ5JUWWFxhsP"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_alerting/monitors/vd5k2GsBlQ5JUWWFxhsP", "",
+			"alerting/monitor.json", monitor},
+		{"DeleteMonitor", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingDeleteMonitorRequest{MonitorID: "OYAHOmgBl3cmwnqZl_yH"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_alerting/monitors/OYAHOmgBl3cmwnqZl_yH", "",
+			"alerting/delete.json", deleted},
+		{"SearchMonitors", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingSearchMonitorsRequest{Body: body(`{"query":{"match_all":{}}}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_alerting/monitors/_search", `{"query":{"match_all":{}}}`,
+			"alerting/search_monitors.json", &SearchResponse{
+				Took:   17,
+				Shards: ShardsInfo{Total: 5, Successful: 5},
+				Hits: SearchHits{
+					Total:    &TotalHits{Value: 1, Relation: "eq"},
+					MaxScore: &score,
+					Hits: []SearchHit{{
+						Index: ".opendistro-alerting-config",
+						ID:    "eGQi7GcBRS7-AJEqfAnr",
+						Score: &score,
+						Source: raw(`{"type": "monitor", "name": "my-monitor-name", "enabled": true, "enabled_time": 1545854942426, ` +
+							`"schedule": {"period": {"interval": 1, "unit": "MINUTES"}}, "inputs": [], "triggers": []}`),
+					}},
+				},
+			}},
+		{"RunMonitor", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingRunMonitorRequest{MonitorID: "vd5k2GsBlQ5JUWWFxhsP"}.Do(ctx, tp)
+		}, "POST", "/_plugins/_alerting/monitors/vd5k2GsBlQ5JUWWFxhsP/_execute", "",
+			"alerting/run_monitor.json", run},
+		{"RunMonitor definition", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingRunMonitorRequest{Body: body(`{"type":"monitor"}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_alerting/monitors/_execute", `{"type":"monitor"}`,
+			"alerting/run_monitor.json", run},
+		{"AcknowledgeAlerts", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingAcknowledgeAlertsRequest{MonitorID: "awUMa3gBKo1jAh6qu47E", Body: body(`{"alerts":["eQURa3gBKo1jAh6qUo49"]}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_alerting/monitors/awUMa3gBKo1jAh6qu47E/_acknowledge/alerts", `{"alerts":["eQURa3gBKo1jAh6qUo49"]}`,
+			"alerting/acknowledge.json", &AlertingAcknowledgeResp{Success: []string{"eQURa3gBKo1jAh6qUo49"}, Failed: []json.RawMessage{}}},
+		{"GetAlerts", func(tp Transport) (*Response, interface{}, error) { return AlertingGetAlertsRequest{}.Do(ctx, tp) },
+			"GET", "/_plugins/_alerting/monitors/alerts", "",
+			"alerting/alerts.json", &AlertingAlertsResp{
+				Alerts: []AlertingAlert{{
+					ID:             "eQURa3gBKo1jAh6qUo49",
+					Version:        300,
+					MonitorID:      "awUMa3gBKo1jAh6qu47E",
+					SchemaVersion:  2,
+					MonitorVersion: 2,
+					MonitorName:    "Example_monitor_name",
+					MonitorUser: raw(`{"backend_roles": ["role1"], "roles": ["role1", "role2"], "name": "admin", ` +
+						`"custom_attribute_names": [], "user_requested_tenant": null}`),
+					TriggerID:              "bQUQa3gBKo1jAh6qnY6G",
+					TriggerName:            "Example_trigger_name",
+					State:                  "ACTIVE",
+					AlertHistory:           []json.RawMessage{},
+					Severity:               "1",
+					ActionExecutionResults: []json.RawMessage{raw(`{"action_id": "bgUQa3gBKo1jAh6qnY6G", "last_execution_time": 1617317979908, "throttled_count": 0}`)},
+					StartTime:              1616704000492,
+					LastNotificationTime:   int64p(1617317979908),
+				}},
+				TotalAlerts: 1,
+			}},
+		{"CreateDestination", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingCreateDestinationRequest{Body: body(`{"type":"slack"}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_alerting/destinations", `{"type":"slack"}`,
+			"alerting/destination.json", destination},
+		{"UpdateDestination", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingUpdateDestinationRequest{DestinationID: "nO-yFmkB8NzS6aXjJdiI", Body: body(`{"type":"slack"}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_alerting/destinations/nO-yFmkB8NzS6aXjJdiI", `{"type":"slack"}`,
+			"alerting/destination.json", destination},
+		{"GetDestinations", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingGetDestinationsRequest{}.Do(ctx, tp)
+		}, "GET", "/_plugins/_alerting/destinations", "",
+			"alerting/destinations.json", &AlertingDestinationsResp{
+				TotalDestinations: 1,
+				Destinations: []AlertingDestination{{
+					ID:   "1a2a3a4a5a6a7a",
+					Type: "slack",
+					Name: "sample-destination",
+					User: raw(`{"name": "psantos", "backend_roles": ["human-resources"], "roles": ["alerting_full_access", "hr-role"], ` +
+						`"custom_attribute_names": []}`),
+					SchemaVersion:  3,
+					PrimaryTerm:    6,
+					LastUpdateTime: 1603943261722,
+					Slack:          raw(`{"url": "https://example.com"}`),
+				}},
+			}},
+		{"DeleteDestination", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingDeleteDestinationRequest{DestinationID: "OYAHOmgBl3cmwnqZl_yH"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_alerting/destinations/OYAHOmgBl3cmwnqZl_yH", "",
+			"alerting/delete.json", deleted},
+		{"CreateEmailAccount", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingCreateEmailAccountRequest{Body: body(`{"name":"example_account"}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_alerting/destinations/email_accounts", `{"name":"example_account"}`,
+			"alerting/email_account.json", emailAccount},
+		{"UpdateEmailAccount", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingUpdateEmailAccountRequest{EmailAccountID: "email_account_id", Body: body(`{"name":"example_account"}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_alerting/destinations/email_accounts/email_account_id", `{"name":"example_account"}`,
+			"alerting/email_account.json", emailAccount},
+		{"GetEmailAccount", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingGetEmailAccountRequest{EmailAccountID: "email_account_id"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_alerting/destinations/email_accounts/email_account_id", "",
+			"alerting/email_account.json", emailAccount},
+		{"DeleteEmailAccount", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingDeleteEmailAccountRequest{EmailAccountID: "OYAHOmgBl3cmwnqZl_yH"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_alerting/destinations/email_accounts/OYAHOmgBl3cmwnqZl_yH", "",
+			"alerting/delete.json", deleted},
+		{"SearchEmailAccounts", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingSearchEmailAccountsRequest{Body: body(`{"sort":["name.keyword"]}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_alerting/destinations/email_accounts/_search", `{"sort":["name.keyword"]}`,
+			"alerting/search_email_accounts.json", &SearchResponse{
+				Took:   8,
+				Shards: ShardsInfo{Total: 1, Successful: 1},
+				Hits: SearchHits{
+					Total: &TotalHits{Value: 1, Relation: "eq"},
+					Hits: []SearchHit{{
+						Index:       ".opendistro-alerting-config",
+						ID:          "email_account_id",
+						SeqNo:       int64p(8),
+						PrimaryTerm: int64p(2),
+						Source: raw(`{"schema_version": 2, "name": "example_account", "email": "example@email.com", ` +
+							`"host": "smtp.email.com", "port": 465, "method": "ssl"}`),
+						Sort: []json.RawMessage{raw(`"example_account"`)},
+					}},
+				},
+			}},
+		{"CreateEmailGroup", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingCreateEmailGroupRequest{Body: body(`{"name":"example_email_group"}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_alerting/destinations/email_groups", `{"name":"example_email_group"}`,
+			"alerting/email_group.json", emailGroup},
+		{"UpdateEmailGroup", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingUpdateEmailGroupRequest{EmailGroupID: "email_group_id", Body: body(`{"name":"example_email_group"}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_alerting/destinations/email_groups/email_group_id", `{"name":"example_email_group"}`,
+			"alerting/email_group.json", emailGroup},
+		{"GetEmailGroup", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingGetEmailGroupRequest{EmailGroupID: "email_group_id"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_alerting/destinations/email_groups/email_group_id", "",
+			"alerting/email_group.json", emailGroup},
+		{"DeleteEmailGroup", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingDeleteEmailGroupRequest{EmailGroupID: "OYAHOmgBl3cmwnqZl_yH"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_alerting/destinations/email_groups/OYAHOmgBl3cmwnqZl_yH", "",
+			"alerting/delete.json", deleted},
+		{"SearchEmailGroups", func(tp Transport) (*Response, interface{}, error) {
+			return AlertingSearchEmailGroupsRequest{Body: body(`{"sort":["name.keyword"]}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_alerting/destinations/email_groups/_search", `{"sort":["name.keyword"]}`,
+			"alerting/search_email_groups.json", &SearchResponse{
+				Took:   7,
+				Shards: ShardsInfo{Total: 1, Successful: 1},
+				Hits: SearchHits{
+					Total: &TotalHits{Value: 1, Relation: "eq"},
+					Hits: []SearchHit{{
+						Index:       ".opendistro-alerting-config",
+						ID:          "email_group_id",
+						SeqNo:       int64p(10),
+						PrimaryTerm: int64p(2),
+						Source:      raw(`{"schema_version": 2, "name": "example_email_group", "emails": [{"email": "example@email.com"}]}`),
+						Sort:        []json.RawMessage{raw(`"example_email_group"`)},
+					}},
+				},
+			}},
+	})
+
+	t.Run("Monitor round trip", func(t *testing.T) {
+		monitor := `{
+			"type": "monitor",
+			"monitor_type": "bucket_level_monitor",
+			"name": "errors by host",
+			"enabled": true,
+			"enabled_time": 1700000000000,
+			"last_update_time": 1700000000001,
+			"schema_version": 5,
+			"schedule": {"period": {"interval": 10, "unit": "MINUTES"}},
+			"inputs": [{"search": {"indices": ["logs-*"], "query": {"size": 0, "aggregations": {"hosts": {"composite": {"sources": [{"host": {"terms": {"field": "host"}}}]}}}}}}],
+			"triggers": [{"bucket_level_trigger": {
+				"id": "t1",
+				"name": "too many errors",
+				"severity": "1",
+				"condition": {"script": {"source": "params.count > 100", "lang": "painless"}, "buckets_path": {"count": "_count"}, "parent_bucket_path": "hosts"},
+				"actions": [{
+					"id": "a1",
+					"name": "notify",
+					"destination_id": "d1",
+					"message_template": {"source": "{{ctx.monitor.name}}", "lang": "mustache"},
+					"throttle_enabled": true,
+					"throttle": {"value": 10, "unit": "MINUTES"},
+					"action_execution_policy": {"action_execution_scope": {"per_alert": {"actionable_alerts": ["DEDUPED", "NEW"]}}}
+				}]
+			}}]
+		}`
+		tp := &recordingTransport{body: `{"_id":"m1","_version":2,"_seq_no":5,"_primary_term":1,"monitor":` + monitor + `}`}
+
+		_, data, err := AlertingGetMonitorRequest{MonitorID: "m1"}.Do(ctx, tp)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		trigger := data.Monitor.Triggers[0].BucketLevelTrigger
+		if data.SeqNo != 5 || trigger == nil || trigger.Condition.ParentBucketPath != "hosts" || trigger.Actions[0].Throttle.Value != 10 {
+			t.Fatalf("Unexpected response: %+v", data)
+		}
+
+		b, err := json.Marshal(data.Monitor)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		// Compare the monitors decoded as generic JSON, regardless of the order of the keys.
+		var got, expected interface{}
+		_ = json.Unmarshal(b, &got)
+		_ = json.Unmarshal([]byte(monitor), &expected)
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Unexpected monitor:\n%s\nwant:\n%s", b, monitor)
+		}
+	})
+
+	t.Run("Dry run", func(t *testing.T) {
+		tp := &recordingTransport{body: `{
+			"monitor_name": "errors",
+			"period_start": 1, "period_end": 2, "error": null,
+			"input_results": {"results": [{"hits": {"total": {"value": 3}}}], "error": null},
+			"trigger_results": {"t1": {"name": "too many errors", "triggered": true, "error": null, "action_results": {}}}
+		}`}
+		api := New(tp)
+
+		_, data, err := api.Alerting.RunMonitor(api.Alerting.RunMonitor.WithMonitorID("m1"), api.Alerting.RunMonitor.WithDryRun())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if tp.req.URL.RawQuery != "dryrun=true" || !data.TriggerResults["t1"].Triggered || len(data.InputResults.Results) != 1 {
+			t.Errorf("Unexpected response: %s, %+v", tp.req.URL.RawQuery, data)
+		}
+	})
+
+	t.Run("Alerts query", func(t *testing.T) {
+		tp := &recordingTransport{body: fixture(t, "alerting/alerts.json")}
+
+		if _, _, err := (AlertingGetAlertsRequest{MonitorID: "awUMa3gBKo1jAh6qu47E", AlertState: "ACTIVE"}).Do(ctx, tp); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if q := tp.req.URL.Query(); q.Get("monitorId") != "awUMa3gBKo1jAh6qu47E" || q.Get("alertState") != "ACTIVE" {
+			t.Errorf("Unexpected query: %s", tp.req.URL.RawQuery)
+		}
+	})
+}
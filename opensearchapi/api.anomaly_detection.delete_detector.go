@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newAnomalyDetectionDeleteDetectorFunc(t Transport) AnomalyDetectionDeleteDetector {
+	return func(o ...func(*AnomalyDetectionDeleteDetectorRequest)) (*Response, *AnomalyDetectorDeleteResp, error) {
+		var r = AnomalyDetectionDeleteDetectorRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AnomalyDetectionDeleteDetector deletes a stopped detector.
+type AnomalyDetectionDeleteDetector func(o ...func(*AnomalyDetectionDeleteDetectorRequest)) (*Response, *AnomalyDetectorDeleteResp, error)
+
+// AnomalyDetectionDeleteDetectorRequest configures the Anomaly Detection Delete Detector API request.
+type AnomalyDetectionDeleteDetectorRequest struct {
+	DetectorID string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AnomalyDetectorDeleteResp and error.
+func (r AnomalyDetectionDeleteDetectorRequest) Do(ctx context.Context, transport Transport) (*Response, *AnomalyDetectorDeleteResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AnomalyDetectorDeleteResp
+	)
+	method := "DELETE"
+
+	path.Grow(len("/_plugins/_anomaly_detection/detectors") + 1 + len(r.DetectorID))
+	path.WriteString("/_plugins/_anomaly_detection/detectors")
+	path.WriteString("/")
+	path.WriteString(r.DetectorID)
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AnomalyDetectionDeleteDetector) WithContext(v context.Context) func(*AnomalyDetectionDeleteDetectorRequest) {
+	return func(r *AnomalyDetectionDeleteDetectorRequest) {
+		r.ctx = v
+	}
+}
+
+// WithDetectorID - the ID of the detector.
+func (f AnomalyDetectionDeleteDetector) WithDetectorID(v string) func(*AnomalyDetectionDeleteDetectorRequest) {
+	return func(r *AnomalyDetectionDeleteDetectorRequest) {
+		r.DetectorID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AnomalyDetectionDeleteDetector) WithPretty() func(*AnomalyDetectionDeleteDetectorRequest) {
+	return func(r *AnomalyDetectionDeleteDetectorRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AnomalyDetectionDeleteDetector) WithHuman() func(*AnomalyDetectionDeleteDetectorRequest) {
+	return func(r *AnomalyDetectionDeleteDetectorRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AnomalyDetectionDeleteDetector) WithErrorTrace() func(*AnomalyDetectionDeleteDetectorRequest) {
+	return func(r *AnomalyDetectionDeleteDetectorRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AnomalyDetectionDeleteDetector) WithFilterPath(v ...string) func(*AnomalyDetectionDeleteDetectorRequest) {
+	return func(r *AnomalyDetectionDeleteDetectorRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AnomalyDetectionDeleteDetector) WithHeader(h map[string]string) func(*AnomalyDetectionDeleteDetectorRequest) {
+	return func(r *AnomalyDetectionDeleteDetectorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AnomalyDetectionDeleteDetector) WithOpaqueID(s string) func(*AnomalyDetectionDeleteDetectorRequest) {
+	return func(r *AnomalyDetectionDeleteDetectorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newAnomalyDetectionGetDetectorFunc(t Transport) AnomalyDetectionGetDetector {
+	return func(o ...func(*AnomalyDetectionGetDetectorRequest)) (*Response, *AnomalyDetectorResp, error) {
+		var r = AnomalyDetectionGetDetectorRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AnomalyDetectionGetDetector returns a detector.
+type AnomalyDetectionGetDetector func(o ...func(*AnomalyDetectionGetDetectorRequest)) (*Response, *AnomalyDetectorResp, error)
+
+// AnomalyDetectionGetDetectorRequest configures the Anomaly Detection Get Detector API request.
+type AnomalyDetectionGetDetectorRequest struct {
+	DetectorID string
+
+	Job  bool
+	Task bool
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AnomalyDetectorResp and error.
+func (r AnomalyDetectionGetDetectorRequest) Do(ctx context.Context, transport Transport) (*Response, *AnomalyDetectorResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AnomalyDetectorResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_anomaly_detection/detectors") + 1 + len(r.DetectorID))
+	path.WriteString("/_plugins/_anomaly_detection/detectors")
+	path.WriteString("/")
+	path.WriteString(r.DetectorID)
+
+	params = make(map[string]string)
+
+	if r.Job {
+		params["job"] = "true"
+	}
+
+	if r.Task {
+		params["task"] = "true"
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AnomalyDetectionGetDetector) WithContext(v context.Context) func(*AnomalyDetectionGetDetectorRequest) {
+	return func(r *AnomalyDetectionGetDetectorRequest) {
+		r.ctx = v
+	}
+}
+
+// WithDetectorID - the ID of the detector.
+func (f AnomalyDetectionGetDetector) WithDetectorID(v string) func(*AnomalyDetectionGetDetectorRequest) {
+	return func(r *AnomalyDetectionGetDetectorRequest) {
+		r.DetectorID = v
+	}
+}
+
+// WithJob - returns the job of the detector.
+func (f AnomalyDetectionGetDetector) WithJob() func(*AnomalyDetectionGetDetectorRequest) {
+	return func(r *AnomalyDetectionGetDetectorRequest) {
+		r.Job = true
+	}
+}
+
+// WithTask - returns the real-time and historical tasks of the detector.
+func (f AnomalyDetectionGetDetector) WithTask() func(*AnomalyDetectionGetDetectorRequest) {
+	return func(r *AnomalyDetectionGetDetectorRequest) {
+		r.Task = true
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AnomalyDetectionGetDetector) WithPretty() func(*AnomalyDetectionGetDetectorRequest) {
+	return func(r *AnomalyDetectionGetDetectorRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AnomalyDetectionGetDetector) WithHuman() func(*AnomalyDetectionGetDetectorRequest) {
+	return func(r *AnomalyDetectionGetDetectorRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AnomalyDetectionGetDetector) WithErrorTrace() func(*AnomalyDetectionGetDetectorRequest) {
+	return func(r *AnomalyDetectionGetDetectorRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AnomalyDetectionGetDetector) WithFilterPath(v ...string) func(*AnomalyDetectionGetDetectorRequest) {
+	return func(r *AnomalyDetectionGetDetectorRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AnomalyDetectionGetDetector) WithHeader(h map[string]string) func(*AnomalyDetectionGetDetectorRequest) {
+	return func(r *AnomalyDetectionGetDetectorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AnomalyDetectionGetDetector) WithOpaqueID(s string) func(*AnomalyDetectionGetDetectorRequest) {
+	return func(r *AnomalyDetectionGetDetectorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
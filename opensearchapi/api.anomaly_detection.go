@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The states of the real-time job of a detector, in its profile.
+const (
+	AnomalyDetectorStateDisabled = "DISABLED"
+	AnomalyDetectorStateInit     = "INIT"
+	AnomalyDetectorStateRunning  = "RUNNING"
+)
+
+// AnomalyDetector represents a detector: it aggregates the features of the documents of the indices
+// for each detection interval, and finds the anomalies of the features. The fields set by the plugin
+// are omitted when empty, so that a detector returned by the Get Detector API can be sent back to
+// the Update Detector API.
+type AnomalyDetector struct {
+	Name              string                   `json:"name"`
+	Description       string                   `json:"description,omitempty"`
+	TimeField         string                   `json:"time_field"`
+	Indices           []string                 `json:"indices"`
+	FeatureAttributes []AnomalyDetectorFeature `json:"feature_attributes"`
+	FilterQuery       json.RawMessage          `json:"filter_query,omitempty"`
+	DetectionInterval AnomalyDetectorInterval  `json:"detection_interval"`
+	WindowDelay       *AnomalyDetectorInterval `json:"window_delay,omitempty"`
+	ShingleSize       int                      `json:"shingle_size,omitempty"`
+	CategoryField     []string                 `json:"category_field,omitempty"`
+	ResultIndex       string                   `json:"result_index,omitempty"`
+	SchemaVersion     int                      `json:"schema_version,omitempty"`
+	LastUpdateTime    int64                    `json:"last_update_time,omitempty"`
+	DetectorType      string                   `json:"detector_type,omitempty"`
+	UIMetadata        json.RawMessage          `json:"ui_metadata,omitempty"`
+	User              json.RawMessage          `json:"user,omitempty"`
+}
+
+// AnomalyDetectorFeature represents a feature of a detector, the value of a single metric aggregation,
+// eg. {"total_bytes": {"sum": {"field": "bytes"}}}.
+type AnomalyDetectorFeature struct {
+	FeatureID        string          `json:"feature_id,omitempty"`
+	FeatureName      string          `json:"feature_name"`
+	FeatureEnabled   bool            `json:"feature_enabled"`
+	AggregationQuery json.RawMessage `json:"aggregation_query"`
+}
+
+// NewAnomalyDetectorFeature returns an enabled feature with the aggregation, such as an opensearchdsl.Aggregation.
+func NewAnomalyDetectorFeature(name string, aggregation interface{}) (AnomalyDetectorFeature, error) {
+	query, err := json.Marshal(map[string]interface{}{name: aggregation})
+	if err != nil {
+		return AnomalyDetectorFeature{}, fmt.Errorf("cannot encode aggregation of feature %q: %w", name, err)
+	}
+	return AnomalyDetectorFeature{FeatureName: name, FeatureEnabled: true, AggregationQuery: query}, nil
+}
+
+// AnomalyDetectorInterval represents the detection interval or the window delay of a detector.
+type AnomalyDetectorInterval struct {
+	Period AnomalyDetectorPeriod `json:"period"`
+}
+
+// AnomalyDetectorPeriod represents a period, eg. {Interval: 10, Unit: "Minutes"}.
+type AnomalyDetectorPeriod struct {
+	Interval int    `json:"interval"`
+	Unit     string `json:"unit"`
+}
+
+// AnomalyDetectorResp is the response of the Anomaly Detection Create, Update and Get Detector APIs.
+// The job and the tasks are only returned by the Get Detector API, when requested.
+type AnomalyDetectorResp struct {
+	ID                     string               `json:"_id"`
+	Version                int64                `json:"_version"`
+	SeqNo                  int64                `json:"_seq_no"`
+	PrimaryTerm            int64                `json:"_primary_term"`
+	AnomalyDetector        AnomalyDetector      `json:"anomaly_detector"`
+	AnomalyDetectorJob     json.RawMessage      `json:"anomaly_detector_job,omitempty"`
+	RealtimeDetectionTask  *AnomalyDetectorTask `json:"realtime_detection_task,omitempty"`
+	HistoricalAnalysisTask *AnomalyDetectorTask `json:"historical_analysis_task,omitempty"`
+}
+
+// AnomalyDetectorTask represents a real-time or historical task of a detector.
+type AnomalyDetectorTask struct {
+	TaskID               string  `json:"task_id"`
+	TaskType             string  `json:"task_type"`
+	State                string  `json:"state"`
+	TaskProgress         float64 `json:"task_progress"`
+	InitProgress         float64 `json:"init_progress"`
+	CurrentPiece         int64   `json:"current_piece,omitempty"`
+	ExecutionStartTime   int64   `json:"execution_start_time"`
+	ExecutionEndTime     int64   `json:"execution_end_time,omitempty"`
+	LastUpdateTime       int64   `json:"last_update_time"`
+	Error                string  `json:"error,omitempty"`
+	IsLatest             bool    `json:"is_latest"`
+	DetectorID           string  `json:"detector_id"`
+	CoordinatingNode     string  `json:"coordinating_node,omitempty"`
+	WorkerNode           string  `json:"worker_node,omitempty"`
+	EstimatedMinutesLeft int     `json:"estimated_minutes_left,omitempty"`
+}
+
+// AnomalyDetectorDeleteResp is the response of the Anomaly Detection Delete Detector API.
+type AnomalyDetectorDeleteResp struct {
+	Index       string     `json:"_index"`
+	ID          string     `json:"_id"`
+	Version     int64      `json:"_version"`
+	Result      string     `json:"result"`
+	Shards      ShardsInfo `json:"_shards"`
+	SeqNo       int64      `json:"_seq_no"`
+	PrimaryTerm int64      `json:"_primary_term"`
+}
+
+// AnomalyDetectorDateRange is the body of the Anomaly Detection Start Detector API for a historical analysis,
+// in epoch milliseconds.
+type AnomalyDetectorDateRange struct {
+	StartTime int64 `json:"start_time"`
+	EndTime   int64 `json:"end_time"`
+}
+
+// AnomalyDetectorJobResp is the response of the Anomaly Detection Start and Stop Detector APIs.
+type AnomalyDetectorJobResp struct {
+	ID          string `json:"_id"`
+	Version     int64  `json:"_version"`
+	SeqNo       int64  `json:"_seq_no"`
+	PrimaryTerm int64  `json:"_primary_term"`
+}
+
+// AnomalyDetectorProfileResp is the response of the Anomaly Detection Profile API.
+// Only the requested profile types are returned.
+type AnomalyDetectorProfileResp struct {
+	State            string                        `json:"state"`
+	Error            string                        `json:"error,omitempty"`
+	InitProgress     *AnomalyDetectorInitProgress  `json:"init_progress,omitempty"`
+	Models           []AnomalyDetectorModelProfile `json:"models,omitempty"`
+	TotalSizeInBytes int64                         `json:"total_size_in_bytes,omitempty"`
+	CoordinatingNode string                        `json:"coordinating_node,omitempty"`
+	ShingleSize      int                           `json:"shingle_size,omitempty"`
+	TotalEntities    int64                         `json:"total_entities,omitempty"`
+	ActiveEntities   int64                         `json:"active_entities,omitempty"`
+	ADTask           json.RawMessage               `json:"ad_task,omitempty"`
+}
+
+// AnomalyDetectorInitProgress represents the initialization progress of a detector, eg. "70%".
+type AnomalyDetectorInitProgress struct {
+	Percentage           string `json:"percentage"`
+	EstimatedMinutesLeft int    `json:"estimated_minutes_left"`
+	NeededShingles       int    `json:"needed_shingles"`
+}
+
+// AnomalyDetectorModelProfile represents a model of a detector.
+type AnomalyDetectorModelProfile struct {
+	ModelID          string `json:"model_id"`
+	ModelSizeInBytes int64  `json:"model_size_in_bytes"`
+	NodeID           string `json:"node_id"`
+}
+
+// AnomalyDetectionStatsResp is the response of the Anomaly Detection Stats API.
+// The statistics of the nodes are kept generic, as they vary between versions.
+type AnomalyDetectionStatsResp struct {
+	AnomalyDetectorsIndexStatus    string                            `json:"anomaly_detectors_index_status"`
+	AnomalyDetectionStateStatus    string                            `json:"anomaly_detection_state_status"`
+	AnomalyDetectionJobIndexStatus string                            `json:"anomaly_detection_job_index_status"`
+	AnomalyResultsIndexStatus      string                            `json:"anomaly_results_index_status"`
+	ModelsCheckpointIndexStatus    string                            `json:"models_checkpoint_index_status"`
+	DetectorCount                  int64                             `json:"detector_count"`
+	SingleEntityDetectorCount      int64                             `json:"single_entity_detector_count"`
+	MultiEntityDetectorCount       int64                             `json:"multi_entity_detector_count"`
+	Nodes                          map[string]map[string]interface{} `json:"nodes"`
+}
+
+// AnomalyDetectorPreviewBody is the body of the Anomaly Detection Preview Detector API, in epoch milliseconds.
+// The Detector is previewed when the detector ID is empty.
+type AnomalyDetectorPreviewBody struct {
+	PeriodStart int64            `json:"period_start"`
+	PeriodEnd   int64            `json:"period_end"`
+	DetectorID  string           `json:"detector_id,omitempty"`
+	Detector    *AnomalyDetector `json:"detector,omitempty"`
+}
+
+// AnomalyDetectorPreviewResp is the response of the Anomaly Detection Preview Detector API.
+type AnomalyDetectorPreviewResp struct {
+	AnomalyResult   []AnomalyResult `json:"anomaly_result"`
+	AnomalyDetector AnomalyDetector `json:"anomaly_detector"`
+}
+
+// AnomalyResult represents the result of a detector for an interval, and an entity of a high cardinality detector.
+type AnomalyResult struct {
+	DetectorID         string               `json:"detector_id"`
+	DataStartTime      int64                `json:"data_start_time"`
+	DataEndTime        int64                `json:"data_end_time"`
+	ExecutionStartTime int64                `json:"execution_start_time,omitempty"`
+	ExecutionEndTime   int64                `json:"execution_end_time,omitempty"`
+	AnomalyGrade       float64              `json:"anomaly_grade"`
+	AnomalyScore       float64              `json:"anomaly_score,omitempty"`
+	Confidence         float64              `json:"confidence"`
+	FeatureData        []AnomalyFeatureData `json:"feature_data"`
+	Entity             []AnomalyEntity      `json:"entity,omitempty"`
+	Error              string               `json:"error,omitempty"`
+}
+
+// AnomalyFeatureData represents the value of a feature in an anomaly result.
+type AnomalyFeatureData struct {
+	FeatureID   string  `json:"feature_id"`
+	FeatureName string  `json:"feature_name"`
+	Data        float64 `json:"data"`
+}
+
+// AnomalyEntity represents a value of a category field of a high cardinality detector.
+type AnomalyEntity struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newAnomalyDetectionPreviewDetectorFunc(t Transport) AnomalyDetectionPreviewDetector {
+	return func(o ...func(*AnomalyDetectionPreviewDetectorRequest)) (*Response, *AnomalyDetectorPreviewResp, error) {
+		var r = AnomalyDetectionPreviewDetectorRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AnomalyDetectionPreviewDetector previews the anomalies of a detector, or of the detector of the body when the ID is empty.
+type AnomalyDetectionPreviewDetector func(o ...func(*AnomalyDetectionPreviewDetectorRequest)) (*Response, *AnomalyDetectorPreviewResp, error)
+
+// AnomalyDetectionPreviewDetectorRequest configures the Anomaly Detection Preview Detector API request.
+type AnomalyDetectionPreviewDetectorRequest struct {
+	DetectorID string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AnomalyDetectorPreviewResp and error.
+func (r AnomalyDetectionPreviewDetectorRequest) Do(ctx context.Context, transport Transport) (*Response, *AnomalyDetectorPreviewResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AnomalyDetectorPreviewResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_anomaly_detection/detectors") + 1 + len(r.DetectorID) + len("/_preview"))
+	path.WriteString("/_plugins/_anomaly_detection/detectors")
+	if r.DetectorID != "" {
+		path.WriteString("/")
+		path.WriteString(r.DetectorID)
+	}
+	path.WriteString("/_preview")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AnomalyDetectionPreviewDetector) WithContext(v context.Context) func(*AnomalyDetectionPreviewDetectorRequest) {
+	return func(r *AnomalyDetectionPreviewDetectorRequest) {
+		r.ctx = v
+	}
+}
+
+// WithDetectorID - the ID of the detector.
+func (f AnomalyDetectionPreviewDetector) WithDetectorID(v string) func(*AnomalyDetectionPreviewDetectorRequest) {
+	return func(r *AnomalyDetectionPreviewDetectorRequest) {
+		r.DetectorID = v
+	}
+}
+
+// WithBody - the period to preview, see AnomalyDetectorPreviewBody.
+func (f AnomalyDetectionPreviewDetector) WithBody(v io.Reader) func(*AnomalyDetectionPreviewDetectorRequest) {
+	return func(r *AnomalyDetectionPreviewDetectorRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AnomalyDetectionPreviewDetector) WithPretty() func(*AnomalyDetectionPreviewDetectorRequest) {
+	return func(r *AnomalyDetectionPreviewDetectorRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AnomalyDetectionPreviewDetector) WithHuman() func(*AnomalyDetectionPreviewDetectorRequest) {
+	return func(r *AnomalyDetectionPreviewDetectorRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AnomalyDetectionPreviewDetector) WithErrorTrace() func(*AnomalyDetectionPreviewDetectorRequest) {
+	return func(r *AnomalyDetectionPreviewDetectorRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AnomalyDetectionPreviewDetector) WithFilterPath(v ...string) func(*AnomalyDetectionPreviewDetectorRequest) {
+	return func(r *AnomalyDetectionPreviewDetectorRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AnomalyDetectionPreviewDetector) WithHeader(h map[string]string) func(*AnomalyDetectionPreviewDetectorRequest) {
+	return func(r *AnomalyDetectionPreviewDetectorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AnomalyDetectionPreviewDetector) WithOpaqueID(s string) func(*AnomalyDetectionPreviewDetectorRequest) {
+	return func(r *AnomalyDetectionPreviewDetectorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newAnomalyDetectionProfileFunc(t Transport) AnomalyDetectionProfile {
+	return func(o ...func(*AnomalyDetectionProfileRequest)) (*Response, *AnomalyDetectorProfileResp, error) {
+		var r = AnomalyDetectionProfileRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AnomalyDetectionProfile returns the state, initialization progress and models of a detector.
+type AnomalyDetectionProfile func(o ...func(*AnomalyDetectionProfileRequest)) (*Response, *AnomalyDetectorProfileResp, error)
+
+// AnomalyDetectionProfileRequest configures the Anomaly Detection Profile API request.
+type AnomalyDetectionProfileRequest struct {
+	DetectorID string
+	Type       []string
+
+	All bool
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AnomalyDetectorProfileResp and error.
+func (r AnomalyDetectionProfileRequest) Do(ctx context.Context, transport Transport) (*Response, *AnomalyDetectorProfileResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AnomalyDetectorProfileResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_anomaly_detection/detectors") + 1 + len(r.DetectorID) + len("/_profile") + 1 + len(strings.Join(r.Type, ",")))
+	path.WriteString("/_plugins/_anomaly_detection/detectors")
+	path.WriteString("/")
+	path.WriteString(r.DetectorID)
+	path.WriteString("/_profile")
+	if len(r.Type) > 0 {
+		path.WriteString("/")
+		path.WriteString(strings.Join(r.Type, ","))
+	}
+
+	params = make(map[string]string)
+
+	if r.All {
+		params["_all"] = "true"
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AnomalyDetectionProfile) WithContext(v context.Context) func(*AnomalyDetectionProfileRequest) {
+	return func(r *AnomalyDetectionProfileRequest) {
+		r.ctx = v
+	}
+}
+
+// WithDetectorID - the ID of the detector.
+func (f AnomalyDetectionProfile) WithDetectorID(v string) func(*AnomalyDetectionProfileRequest) {
+	return func(r *AnomalyDetectionProfileRequest) {
+		r.DetectorID = v
+	}
+}
+
+// WithType - the profile types to return, eg. state, error or init_progress.
+func (f AnomalyDetectionProfile) WithType(v ...string) func(*AnomalyDetectionProfileRequest) {
+	return func(r *AnomalyDetectionProfileRequest) {
+		r.Type = v
+	}
+}
+
+// WithAll - returns all the profile types.
+func (f AnomalyDetectionProfile) WithAll() func(*AnomalyDetectionProfileRequest) {
+	return func(r *AnomalyDetectionProfileRequest) {
+		r.All = true
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AnomalyDetectionProfile) WithPretty() func(*AnomalyDetectionProfileRequest) {
+	return func(r *AnomalyDetectionProfileRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AnomalyDetectionProfile) WithHuman() func(*AnomalyDetectionProfileRequest) {
+	return func(r *AnomalyDetectionProfileRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AnomalyDetectionProfile) WithErrorTrace() func(*AnomalyDetectionProfileRequest) {
+	return func(r *AnomalyDetectionProfileRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AnomalyDetectionProfile) WithFilterPath(v ...string) func(*AnomalyDetectionProfileRequest) {
+	return func(r *AnomalyDetectionProfileRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AnomalyDetectionProfile) WithHeader(h map[string]string) func(*AnomalyDetectionProfileRequest) {
+	return func(r *AnomalyDetectionProfileRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AnomalyDetectionProfile) WithOpaqueID(s string) func(*AnomalyDetectionProfileRequest) {
+	return func(r *AnomalyDetectionProfileRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
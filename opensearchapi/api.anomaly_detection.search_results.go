@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newAnomalyDetectionSearchResultsFunc(t Transport) AnomalyDetectionSearchResults {
+	return func(o ...func(*AnomalyDetectionSearchResultsRequest)) (*Response, *SearchResponse, error) {
+		var r = AnomalyDetectionSearchResultsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AnomalyDetectionSearchResults searches the anomaly results.
+type AnomalyDetectionSearchResults func(o ...func(*AnomalyDetectionSearchResultsRequest)) (*Response, *SearchResponse, error)
+
+// AnomalyDetectionSearchResultsRequest configures the Anomaly Detection Search Results API request.
+type AnomalyDetectionSearchResultsRequest struct {
+	ResultIndex string
+
+	Body io.Reader
+
+	OnlyQueryCustomResultIndex bool
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SearchResponse and error.
+func (r AnomalyDetectionSearchResultsRequest) Do(ctx context.Context, transport Transport) (*Response, *SearchResponse, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SearchResponse
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_anomaly_detection/detectors/results/_search") + 1 + len(r.ResultIndex))
+	path.WriteString("/_plugins/_anomaly_detection/detectors/results/_search")
+	if r.ResultIndex != "" {
+		path.WriteString("/")
+		path.WriteString(r.ResultIndex)
+	}
+
+	params = make(map[string]string)
+
+	if r.OnlyQueryCustomResultIndex {
+		params["only_query_custom_result_index"] = "true"
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AnomalyDetectionSearchResults) WithContext(v context.Context) func(*AnomalyDetectionSearchResultsRequest) {
+	return func(r *AnomalyDetectionSearchResultsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithResultIndex - the custom result index to search.
+func (f AnomalyDetectionSearchResults) WithResultIndex(v string) func(*AnomalyDetectionSearchResultsRequest) {
+	return func(r *AnomalyDetectionSearchResultsRequest) {
+		r.ResultIndex = v
+	}
+}
+
+// WithBody - the search request, eg. {"query": {"range": {"anomaly_grade": {"gt": 0}}}}.
+func (f AnomalyDetectionSearchResults) WithBody(v io.Reader) func(*AnomalyDetectionSearchResultsRequest) {
+	return func(r *AnomalyDetectionSearchResultsRequest) {
+		r.Body = v
+	}
+}
+
+// WithOnlyQueryCustomResultIndex - searches the custom result index only, without the default result indices.
+func (f AnomalyDetectionSearchResults) WithOnlyQueryCustomResultIndex() func(*AnomalyDetectionSearchResultsRequest) {
+	return func(r *AnomalyDetectionSearchResultsRequest) {
+		r.OnlyQueryCustomResultIndex = true
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AnomalyDetectionSearchResults) WithPretty() func(*AnomalyDetectionSearchResultsRequest) {
+	return func(r *AnomalyDetectionSearchResultsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AnomalyDetectionSearchResults) WithHuman() func(*AnomalyDetectionSearchResultsRequest) {
+	return func(r *AnomalyDetectionSearchResultsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AnomalyDetectionSearchResults) WithErrorTrace() func(*AnomalyDetectionSearchResultsRequest) {
+	return func(r *AnomalyDetectionSearchResultsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AnomalyDetectionSearchResults) WithFilterPath(v ...string) func(*AnomalyDetectionSearchResultsRequest) {
+	return func(r *AnomalyDetectionSearchResultsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AnomalyDetectionSearchResults) WithHeader(h map[string]string) func(*AnomalyDetectionSearchResultsRequest) {
+	return func(r *AnomalyDetectionSearchResultsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AnomalyDetectionSearchResults) WithOpaqueID(s string) func(*AnomalyDetectionSearchResultsRequest) {
+	return func(r *AnomalyDetectionSearchResultsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newAnomalyDetectionStartDetectorFunc(t Transport) AnomalyDetectionStartDetector {
+	return func(o ...func(*AnomalyDetectionStartDetectorRequest)) (*Response, *AnomalyDetectorJobResp, error) {
+		var r = AnomalyDetectionStartDetectorRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AnomalyDetectionStartDetector starts the real-time job of a detector, or a historical analysis when the body has a date range.
+type AnomalyDetectionStartDetector func(o ...func(*AnomalyDetectionStartDetectorRequest)) (*Response, *AnomalyDetectorJobResp, error)
+
+// AnomalyDetectionStartDetectorRequest configures the Anomaly Detection Start Detector API request.
+type AnomalyDetectionStartDetectorRequest struct {
+	DetectorID string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AnomalyDetectorJobResp and error.
+func (r AnomalyDetectionStartDetectorRequest) Do(ctx context.Context, transport Transport) (*Response, *AnomalyDetectorJobResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AnomalyDetectorJobResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_anomaly_detection/detectors") + 1 + len(r.DetectorID) + len("/_start"))
+	path.WriteString("/_plugins/_anomaly_detection/detectors")
+	path.WriteString("/")
+	path.WriteString(r.DetectorID)
+	path.WriteString("/_start")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AnomalyDetectionStartDetector) WithContext(v context.Context) func(*AnomalyDetectionStartDetectorRequest) {
+	return func(r *AnomalyDetectionStartDetectorRequest) {
+		r.ctx = v
+	}
+}
+
+// WithDetectorID - the ID of the detector.
+func (f AnomalyDetectionStartDetector) WithDetectorID(v string) func(*AnomalyDetectionStartDetectorRequest) {
+	return func(r *AnomalyDetectionStartDetectorRequest) {
+		r.DetectorID = v
+	}
+}
+
+// WithBody - the date range of a historical analysis, see AnomalyDetectorDateRange.
+func (f AnomalyDetectionStartDetector) WithBody(v io.Reader) func(*AnomalyDetectionStartDetectorRequest) {
+	return func(r *AnomalyDetectionStartDetectorRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AnomalyDetectionStartDetector) WithPretty() func(*AnomalyDetectionStartDetectorRequest) {
+	return func(r *AnomalyDetectionStartDetectorRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AnomalyDetectionStartDetector) WithHuman() func(*AnomalyDetectionStartDetectorRequest) {
+	return func(r *AnomalyDetectionStartDetectorRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AnomalyDetectionStartDetector) WithErrorTrace() func(*AnomalyDetectionStartDetectorRequest) {
+	return func(r *AnomalyDetectionStartDetectorRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AnomalyDetectionStartDetector) WithFilterPath(v ...string) func(*AnomalyDetectionStartDetectorRequest) {
+	return func(r *AnomalyDetectionStartDetectorRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AnomalyDetectionStartDetector) WithHeader(h map[string]string) func(*AnomalyDetectionStartDetectorRequest) {
+	return func(r *AnomalyDetectionStartDetectorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AnomalyDetectionStartDetector) WithOpaqueID(s string) func(*AnomalyDetectionStartDetectorRequest) {
+	return func(r *AnomalyDetectionStartDetectorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
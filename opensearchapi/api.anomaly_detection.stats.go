@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newAnomalyDetectionStatsFunc(t Transport) AnomalyDetectionStats {
+	return func(o ...func(*AnomalyDetectionStatsRequest)) (*Response, *AnomalyDetectionStatsResp, error) {
+		var r = AnomalyDetectionStatsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AnomalyDetectionStats returns the statistics of the anomaly detection plugin.
+type AnomalyDetectionStats func(o ...func(*AnomalyDetectionStatsRequest)) (*Response, *AnomalyDetectionStatsResp, error)
+
+// AnomalyDetectionStatsRequest configures the Anomaly Detection Stats API request.
+type AnomalyDetectionStatsRequest struct {
+	NodeID []string
+	Stat   []string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AnomalyDetectionStatsResp and error.
+func (r AnomalyDetectionStatsRequest) Do(ctx context.Context, transport Transport) (*Response, *AnomalyDetectionStatsResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AnomalyDetectionStatsResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_anomaly_detection") + 1 + len(strings.Join(r.NodeID, ",")) + len("/stats") + 1 + len(strings.Join(r.Stat, ",")))
+	path.WriteString("/_plugins/_anomaly_detection")
+	if len(r.NodeID) > 0 {
+		path.WriteString("/")
+		path.WriteString(strings.Join(r.NodeID, ","))
+	}
+	path.WriteString("/stats")
+	if len(r.Stat) > 0 {
+		path.WriteString("/")
+		path.WriteString(strings.Join(r.Stat, ","))
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AnomalyDetectionStats) WithContext(v context.Context) func(*AnomalyDetectionStatsRequest) {
+	return func(r *AnomalyDetectionStatsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithNodeID - the nodes to return the statistics of.
+func (f AnomalyDetectionStats) WithNodeID(v ...string) func(*AnomalyDetectionStatsRequest) {
+	return func(r *AnomalyDetectionStatsRequest) {
+		r.NodeID = v
+	}
+}
+
+// WithStat - the statistics to return.
+func (f AnomalyDetectionStats) WithStat(v ...string) func(*AnomalyDetectionStatsRequest) {
+	return func(r *AnomalyDetectionStatsRequest) {
+		r.Stat = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AnomalyDetectionStats) WithPretty() func(*AnomalyDetectionStatsRequest) {
+	return func(r *AnomalyDetectionStatsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AnomalyDetectionStats) WithHuman() func(*AnomalyDetectionStatsRequest) {
+	return func(r *AnomalyDetectionStatsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AnomalyDetectionStats) WithErrorTrace() func(*AnomalyDetectionStatsRequest) {
+	return func(r *AnomalyDetectionStatsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AnomalyDetectionStats) WithFilterPath(v ...string) func(*AnomalyDetectionStatsRequest) {
+	return func(r *AnomalyDetectionStatsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AnomalyDetectionStats) WithHeader(h map[string]string) func(*AnomalyDetectionStatsRequest) {
+	return func(r *AnomalyDetectionStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AnomalyDetectionStats) WithOpaqueID(s string) func(*AnomalyDetectionStatsRequest) {
+	return func(r *AnomalyDetectionStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
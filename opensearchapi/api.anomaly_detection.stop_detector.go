@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newAnomalyDetectionStopDetectorFunc(t Transport) AnomalyDetectionStopDetector {
+	return func(o ...func(*AnomalyDetectionStopDetectorRequest)) (*Response, *AnomalyDetectorJobResp, error) {
+		var r = AnomalyDetectionStopDetectorRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AnomalyDetectionStopDetector stops the real-time job of a detector, or its historical analysis.
+type AnomalyDetectionStopDetector func(o ...func(*AnomalyDetectionStopDetectorRequest)) (*Response, *AnomalyDetectorJobResp, error)
+
+// AnomalyDetectionStopDetectorRequest configures the Anomaly Detection Stop Detector API request.
+type AnomalyDetectionStopDetectorRequest struct {
+	DetectorID string
+
+	Historical bool
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AnomalyDetectorJobResp and error.
+func (r AnomalyDetectionStopDetectorRequest) Do(ctx context.Context, transport Transport) (*Response, *AnomalyDetectorJobResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AnomalyDetectorJobResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_anomaly_detection/detectors") + 1 + len(r.DetectorID) + len("/_stop"))
+	path.WriteString("/_plugins/_anomaly_detection/detectors")
+	path.WriteString("/")
+	path.WriteString(r.DetectorID)
+	path.WriteString("/_stop")
+
+	params = make(map[string]string)
+
+	if r.Historical {
+		params["historical"] = "true"
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AnomalyDetectionStopDetector) WithContext(v context.Context) func(*AnomalyDetectionStopDetectorRequest) {
+	return func(r *AnomalyDetectionStopDetectorRequest) {
+		r.ctx = v
+	}
+}
+
+// WithDetectorID - the ID of the detector.
+func (f AnomalyDetectionStopDetector) WithDetectorID(v string) func(*AnomalyDetectionStopDetectorRequest) {
+	return func(r *AnomalyDetectionStopDetectorRequest) {
+		r.DetectorID = v
+	}
+}
+
+// WithHistorical - stops the historical analysis instead of the real-time job.
+func (f AnomalyDetectionStopDetector) WithHistorical() func(*AnomalyDetectionStopDetectorRequest) {
+	return func(r *AnomalyDetectionStopDetectorRequest) {
+		r.Historical = true
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AnomalyDetectionStopDetector) WithPretty() func(*AnomalyDetectionStopDetectorRequest) {
+	return func(r *AnomalyDetectionStopDetectorRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AnomalyDetectionStopDetector) WithHuman() func(*AnomalyDetectionStopDetectorRequest) {
+	return func(r *AnomalyDetectionStopDetectorRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AnomalyDetectionStopDetector) WithErrorTrace() func(*AnomalyDetectionStopDetectorRequest) {
+	return func(r *AnomalyDetectionStopDetectorRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AnomalyDetectionStopDetector) WithFilterPath(v ...string) func(*AnomalyDetectionStopDetectorRequest) {
+	return func(r *AnomalyDetectionStopDetectorRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AnomalyDetectionStopDetector) WithHeader(h map[string]string) func(*AnomalyDetectionStopDetectorRequest) {
+	return func(r *AnomalyDetectionStopDetectorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AnomalyDetectionStopDetector) WithOpaqueID(s string) func(*AnomalyDetectionStopDetectorRequest) {
+	return func(r *AnomalyDetectionStopDetectorRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,299 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAnomalyDetection(t *testing.T) {
+	ctx := context.Background()
+	body := func(s string) io.Reader { return strings.NewReader(s) }
+
+	raw := func(s string) json.RawMessage { return json.RawMessage(s) }
+	int64p := func(v int64) *int64 { return &v }
+
+	interval := AnomalyDetectorInterval{Period: AnomalyDetectorPeriod{Interval: 1, Unit: "Minutes"}}
+	testFeature := AnomalyDetectorFeature{
+		FeatureID:        "U0HKTXwBwf_U8gjUXY2m",
+		FeatureName:      "test",
+		FeatureEnabled:   true,
+		AggregationQuery: raw(`{"test": {"sum": {"field": "value"}}}`),
+	}
+	created := &AnomalyDetectorResp{
+		ID: "VEHKTXwBwf_U8gjUXY2s", Version: 1, SeqNo: 5, PrimaryTerm: 1,
+		AnomalyDetector: AnomalyDetector{
+			Name:        "test-detector",
+			Description: "Test detector",
+			TimeField:   "timestamp",
+			Indices:     []string{"server_log*"},
+			FilterQuery: raw(`{"bool": {"filter": [{"range": {"value": {"from": 1, "to": null, "include_lower": false, ` +
+				`"include_upper": true, "boost": 1}}}], "adjust_pure_negative": true, "boost": 1}}`),
+			DetectionInterval: interval,
+			WindowDelay:       &interval,
+			ShingleSize:       8,
+			FeatureAttributes: []AnomalyDetectorFeature{testFeature},
+			CategoryField:     []string{"host"},
+			LastUpdateTime:    1633392680364,
+			DetectorType:      "MULTI_ENTITY",
+		},
+	}
+	score := 1.0
+	searchResponse := func(took int, index, id string, source json.RawMessage) *SearchResponse {
+		return &SearchResponse{
+			Took:   took,
+			Shards: ShardsInfo{Total: 1, Successful: 1},
+			Hits: SearchHits{
+				Total:    &TotalHits{Value: 1, Relation: "eq"},
+				MaxScore: &score,
+				Hits:     []SearchHit{{Index: index, ID: id, Score: &score, Source: source}},
+			},
+		}
+	}
+	detectors := searchResponse(13, ".opendistro-anomaly-detectors", "VEHKTXwBwf_U8gjUXY2s",
+		raw(`{"name": "test-detector", "time_field": "timestamp", "indices": ["server_log*"], `+
+			`"detection_interval": {"period": {"interval": 1, "unit": "Minutes"}}, "feature_attributes": []}`))
+	detectors.Hits.Hits[0].Version = int64p(1)
+	detectors.Hits.Hits[0].SeqNo = int64p(5)
+	detectors.Hits.Hits[0].PrimaryTerm = int64p(1)
+	preview := &AnomalyDetectorPreviewResp{
+		AnomalyResult: []AnomalyResult{
+			{
+				DetectorID:    "VEHKTXwBwf_U8gjUXY2s",
+				DataStartTime: 1633392680364,
+				DataEndTime:   1633392740364,
+				FeatureData:   []AnomalyFeatureData{{FeatureID: "U0HKTXwBwf_U8gjUXY2m", FeatureName: "test"}},
+			},
+			{
+				DetectorID:    "VEHKTXwBwf_U8gjUXY2s",
+				DataStartTime: 1633392740364,
+				DataEndTime:   1633392800364,
+				AnomalyGrade:  0.7,
+				Confidence:    0.9,
+				FeatureData:   []AnomalyFeatureData{{FeatureID: "U0HKTXwBwf_U8gjUXY2m", FeatureName: "test", Data: 120.5}},
+				Entity:        []AnomalyEntity{{Name: "host", Value: "server_2"}},
+			},
+		},
+		AnomalyDetector: AnomalyDetector{
+			Name:              "test-detector",
+			TimeField:         "timestamp",
+			Indices:           []string{"server_log*"},
+			DetectionInterval: interval,
+			FeatureAttributes: []AnomalyDetectorFeature{testFeature},
+			CategoryField:     []string{"host"},
+			DetectorType:      "MULTI_ENTITY",
+		},
+	}
+	results := searchResponse(4, ".opendistro-anomaly-results-history-2021.10.04-1", "686KTXwB6HNrj9Hd0fQ5",
+		raw(`{"detector_id": "VEHKTXwBwf_U8gjUXY2s", "schema_version": 5, "data_start_time": 1633392680364, `+
+			`"data_end_time": 1633392740364, "feature_data": [{"feature_id": "U0HKTXwBwf_U8gjUXY2m", "feature_name": "test", `+
+			`"data": 0.0}], "anomaly_grade": 0.0, "confidence": 0.0}`))
+
+	testPlugin(t, pluginError{404, "anomaly_detection/error.json"}, []pluginTest{
+		{"CreateDetector", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionCreateDetectorRequest{Body: body(`{"name":"test-detector"}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_anomaly_detection/detectors", `{"name":"test-detector"}`,
+			"anomaly_detection/detector.json", created},
+		{"UpdateDetector", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionUpdateDetectorRequest{DetectorID: "VEHKTXwBwf_U8gjUXY2s", Body: body(`{"name":"test-detector"}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_anomaly_detection/detectors/VEHKTXwBwf_U8gjUXY2s", `{"name":"test-detector"}`,
+			"anomaly_detection/detector.json", created},
+		{"GetDetector", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionGetDetectorRequest{DetectorID: "VEHKTXwBwf_U8gjUXY2s", Job: true, Task: true}.Do(ctx, tp)
+		}, "GET", "/_plugins/_anomaly_detection/detectors/VEHKTXwBwf_U8gjUXY2s", "",
+			"anomaly_detection/get_detector.json", &AnomalyDetectorResp{
+				ID: "VEHKTXwBwf_U8gjUXY2s", Version: 1, SeqNo: 3, PrimaryTerm: 1,
+				AnomalyDetector: AnomalyDetector{
+					Name:              "test-detector",
+					Description:       "Test detector",
+					TimeField:         "timestamp",
+					Indices:           []string{"server_log*"},
+					FilterQuery:       raw(`{"match_all": {"boost": 1}}`),
+					DetectionInterval: interval,
+					WindowDelay:       &interval,
+					ShingleSize:       8,
+					FeatureAttributes: []AnomalyDetectorFeature{testFeature},
+					LastUpdateTime:    1633392680364,
+					DetectorType:      "SINGLE_ENTITY",
+				},
+				AnomalyDetectorJob: raw(`{"name": "VEHKTXwBwf_U8gjUXY2s", "schedule": {"interval": {"start_time": 1633393656357, ` +
+					`"period": 1, "unit": "Minutes"}}, "window_delay": {"period": {"interval": 1, "unit": "Minutes"}}, "enabled": true, ` +
+					`"enabled_time": 1633393656357, "last_update_time": 1633393656357, "lock_duration_seconds": 60}`),
+				RealtimeDetectionTask: &AnomalyDetectorTask{
+					TaskID:             "nHJPbHwB2uBTJ9Rs7rYD",
+					TaskType:           "REALTIME_SINGLE_ENTITY",
+					State:              "RUNNING",
+					InitProgress:       1,
+					ExecutionStartTime: 1633393656362,
+					LastUpdateTime:     1633393776375,
+					IsLatest:           true,
+					DetectorID:         "VEHKTXwBwf_U8gjUXY2s",
+					CoordinatingNode:   "SWD7ihu9TaaW1zKwFZNVNg",
+				},
+			}},
+		{"DeleteDetector", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionDeleteDetectorRequest{DetectorID: "70TxTXwBjd8s6RK4j1Pj"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_anomaly_detection/detectors/70TxTXwBjd8s6RK4j1Pj", "",
+			"anomaly_detection/delete_detector.json", &AnomalyDetectorDeleteResp{
+				Index: ".opendistro-anomaly-detectors", ID: "70TxTXwBjd8s6RK4j1Pj", Version: 2, Result: "deleted",
+				Shards: ShardsInfo{Total: 2, Successful: 2}, SeqNo: 9, PrimaryTerm: 1,
+			}},
+		{"SearchDetectors", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionSearchDetectorsRequest{Body: body(`{"query":{"match_all":{}}}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_anomaly_detection/detectors/_search", `{"query":{"match_all":{}}}`,
+			"anomaly_detection/search_detectors.json", detectors},
+		{"StartDetector", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionStartDetectorRequest{DetectorID: "VEHKTXwBwf_U8gjUXY2s"}.Do(ctx, tp)
+		}, "POST", "/_plugins/_anomaly_detection/detectors/VEHKTXwBwf_U8gjUXY2s/_start", "",
+			"anomaly_detection/start_detector.json", &AnomalyDetectorJobResp{ID: "VEHKTXwBwf_U8gjUXY2s", Version: 3, SeqNo: 6, PrimaryTerm: 1}},
+		{"StartDetector historical", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionStartDetectorRequest{DetectorID: "VEHKTXwBwf_U8gjUXY2s", Body: body(`{"start_time":1,"end_time":2}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_anomaly_detection/detectors/VEHKTXwBwf_U8gjUXY2s/_start", `{"start_time":1,"end_time":2}`,
+			"anomaly_detection/start_historical.json", &AnomalyDetectorJobResp{ID: "f9DsTXwB6HNrj9HdQzsF"}},
+		{"StopDetector", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionStopDetectorRequest{DetectorID: "VEHKTXwBwf_U8gjUXY2s"}.Do(ctx, tp)
+		}, "POST", "/_plugins/_anomaly_detection/detectors/VEHKTXwBwf_U8gjUXY2s/_stop", "",
+			"anomaly_detection/stop_detector.json", &AnomalyDetectorJobResp{ID: "VEHKTXwBwf_U8gjUXY2s"}},
+		{"Profile", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionProfileRequest{DetectorID: "VEHKTXwBwf_U8gjUXY2s", Type: []string{"state", "error"}}.Do(ctx, tp)
+		}, "GET", "/_plugins/_anomaly_detection/detectors/VEHKTXwBwf_U8gjUXY2s/_profile/state,error", "",
+			"anomaly_detection/profile.json", &AnomalyDetectorProfileResp{
+				State: "DISABLED", Error: "Stopped detector: AD models memory usage exceeds our limit.",
+			}},
+		{"Stats", func(tp Transport) (*Response, interface{}, error) { return AnomalyDetectionStatsRequest{}.Do(ctx, tp) },
+			"GET", "/_plugins/_anomaly_detection/stats", "",
+			"anomaly_detection/stats.json", &AnomalyDetectionStatsResp{
+				AnomalyDetectorsIndexStatus:    "green",
+				AnomalyDetectionStateStatus:    "green",
+				AnomalyDetectionJobIndexStatus: "green",
+				AnomalyResultsIndexStatus:      "green",
+				ModelsCheckpointIndexStatus:    "green",
+				DetectorCount:                  5,
+				SingleEntityDetectorCount:      2,
+				MultiEntityDetectorCount:       3,
+				Nodes: map[string]map[string]interface{}{"2Z4q22BySEyzakYt_A0A2A": {
+					"ad_execute_request_count": float64(95),
+					"models": []interface{}{map[string]interface{}{
+						"detector_id":          "WTBnlXsBNbSGlZSsmjSi",
+						"model_type":           "entity",
+						"last_used_time":       float64(1630437290651),
+						"model_id":             "WTBnlXsBNbSGlZSsmjSi_entity_app_6",
+						"last_checkpoint_time": float64(1630437200564),
+					}},
+					"ad_canceled_batch_task_count":        float64(0),
+					"ad_hc_execute_request_count":         float64(75),
+					"ad_hc_execute_failure_count":         float64(0),
+					"model_count":                         float64(28),
+					"ad_execute_failure_count":            float64(1),
+					"ad_batch_task_failure_count":         float64(0),
+					"ad_total_batch_task_execution_count": float64(27),
+					"ad_executing_batch_task_count":       float64(3),
+				}},
+			}},
+		{"Stats of node", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionStatsRequest{NodeID: []string{"2Z4q22BySEyzakYt_A0A2A"}, Stat: []string{"detector_count"}}.Do(ctx, tp)
+		}, "GET", "/_plugins/_anomaly_detection/2Z4q22BySEyzakYt_A0A2A/stats/detector_count", "",
+			"anomaly_detection/stats_detector_count.json", &AnomalyDetectionStatsResp{
+				DetectorCount: 5,
+				Nodes:         map[string]map[string]interface{}{"2Z4q22BySEyzakYt_A0A2A": {}},
+			}},
+		{"PreviewDetector", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionPreviewDetectorRequest{DetectorID: "VEHKTXwBwf_U8gjUXY2s", Body: body(`{"period_start":1,"period_end":2}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_anomaly_detection/detectors/VEHKTXwBwf_U8gjUXY2s/_preview", `{"period_start":1,"period_end":2}`,
+			"anomaly_detection/preview.json", preview},
+		{"PreviewDetector definition", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionPreviewDetectorRequest{Body: body(`{"detector":{}}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_anomaly_detection/detectors/_preview", `{"detector":{}}`,
+			"anomaly_detection/preview.json", preview},
+		{"SearchResults", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionSearchResultsRequest{Body: body(`{"size":1}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_anomaly_detection/detectors/results/_search", `{"size":1}`,
+			"anomaly_detection/search_results.json", results},
+		{"SearchResults custom index", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionSearchResultsRequest{ResultIndex: "opensearch-ad-plugin-result-latency", Body: body(`{"size":1}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_anomaly_detection/detectors/results/_search/opensearch-ad-plugin-result-latency", `{"size":1}`,
+			"anomaly_detection/search_results.json", results},
+		{"SearchTasks", func(tp Transport) (*Response, interface{}, error) {
+			return AnomalyDetectionSearchTasksRequest{Body: body(`{"size":1}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_anomaly_detection/detectors/tasks/_search", `{"size":1}`,
+			"anomaly_detection/search_tasks.json", searchResponse(2, ".opendistro-anomaly-detection-state", "nHJPbHwB2uBTJ9Rs7rYD",
+				raw(`{"detector_id": "VEHKTXwBwf_U8gjUXY2s", "state": "RUNNING", "task_type": "REALTIME_SINGLE_ENTITY", "is_latest": true}`))},
+	})
+
+	t.Run("Detector round trip", func(t *testing.T) {
+		detector := `{
+			"name": "latency",
+			"description": "latency of the requests",
+			"time_field": "timestamp",
+			"indices": ["logs-*"],
+			"filter_query": {"bool": {"filter": [{"term": {"status": 200}}]}},
+			"detection_interval": {"period": {"interval": 10, "unit": "Minutes"}},
+			"window_delay": {"period": {"interval": 1, "unit": "Minutes"}},
+			"shingle_size": 8,
+			"schema_version": 0,
+			"feature_attributes": [{"feature_id": "f1", "feature_name": "avg_latency", "feature_enabled": true,
+				"aggregation_query": {"avg_latency": {"avg": {"field": "latency"}}}}],
+			"category_field": ["host"],
+			"last_update_time": 1700000000000,
+			"detector_type": "MULTI_ENTITY"
+		}`
+		tp := &recordingTransport{body: `{"_id":"d1","_version":1,"_seq_no":3,"_primary_term":1,"anomaly_detector":` + detector + `,
+			"realtime_detection_task": {"task_id": "t1", "task_type": "REALTIME_HC_DETECTOR", "state": "RUNNING",
+				"task_progress": 0, "init_progress": 1, "execution_start_time": 1700000000000,
+				"last_update_time": 1700000000000, "is_latest": true, "detector_id": "d1"}}`}
+
+		_, data, err := AnomalyDetectionGetDetectorRequest{DetectorID: "d1", Task: true}.Do(ctx, tp)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if tp.req.URL.RawQuery != "task=true" {
+			t.Errorf("Unexpected query: %s", tp.req.URL.RawQuery)
+		}
+		d := data.AnomalyDetector
+		if data.SeqNo != 3 || d.DetectionInterval.Period.Interval != 10 || d.FeatureAttributes[0].FeatureID != "f1" ||
+			data.RealtimeDetectionTask == nil || data.RealtimeDetectionTask.State != "RUNNING" || data.HistoricalAnalysisTask != nil {
+			t.Fatalf("Unexpected response: %+v", data)
+		}
+
+		b, err := json.Marshal(d)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		// The schema version 0 is omitted, compare with the other fields only.
+		var got, expected map[string]interface{}
+		_ = json.Unmarshal(b, &got)
+		_ = json.Unmarshal([]byte(detector), &expected)
+		delete(expected, "schema_version")
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Unexpected detector:\n%s\nwant:\n%s", b, detector)
+		}
+	})
+
+	t.Run("Feature", func(t *testing.T) {
+		feature, err := NewAnomalyDetectorFeature("total_bytes", map[string]interface{}{"sum": map[string]string{"field": "bytes"}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		b, _ := json.Marshal(feature)
+		expected := `{"feature_name":"total_bytes","feature_enabled":true,"aggregation_query":{"total_bytes":{"sum":{"field":"bytes"}}}}`
+		if string(b) != expected {
+			t.Errorf("Unexpected feature: %s, want: %s", b, expected)
+		}
+	})
+
+}
@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+func newAsyncSearchGetFunc(t Transport) AsyncSearchGet {
+	return func(o ...func(*AsyncSearchGetRequest)) (*Response, *AsyncSearchResp, error) {
+		var r = AsyncSearchGetRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// AsyncSearchGet returns the state and the partial or final results of an asynchronous search.
+type AsyncSearchGet func(o ...func(*AsyncSearchGetRequest)) (*Response, *AsyncSearchResp, error)
+
+// AsyncSearchGetRequest configures the Asynchronous Search Get API request.
+type AsyncSearchGetRequest struct {
+	ID string
+
+	WaitForCompletionTimeout time.Duration
+	KeepAlive                time.Duration
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, AsyncSearchResp and error.
+func (r AsyncSearchGetRequest) Do(ctx context.Context, transport Transport) (*Response, *AsyncSearchResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data AsyncSearchResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_asynchronous_search") + 1 + len(r.ID))
+	path.WriteString("/_plugins/_asynchronous_search")
+	path.WriteString("/")
+	path.WriteString(r.ID)
+
+	params = make(map[string]string)
+
+	if r.WaitForCompletionTimeout != 0 {
+		params["wait_for_completion_timeout"] = formatDuration(r.WaitForCompletionTimeout)
+	}
+
+	if r.KeepAlive != 0 {
+		params["keep_alive"] = formatDuration(r.KeepAlive)
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f AsyncSearchGet) WithContext(v context.Context) func(*AsyncSearchGetRequest) {
+	return func(r *AsyncSearchGetRequest) {
+		r.ctx = v
+	}
+}
+
+// WithID - the ID of the asynchronous search.
+func (f AsyncSearchGet) WithID(v string) func(*AsyncSearchGetRequest) {
+	return func(r *AsyncSearchGetRequest) {
+		r.ID = v
+	}
+}
+
+// WithWaitForCompletionTimeout - how long to wait for the search to complete before returning its partial results.
+func (f AsyncSearchGet) WithWaitForCompletionTimeout(v time.Duration) func(*AsyncSearchGetRequest) {
+	return func(r *AsyncSearchGetRequest) {
+		r.WaitForCompletionTimeout = v
+	}
+}
+
+// WithKeepAlive - how long the search and its results are kept.
+func (f AsyncSearchGet) WithKeepAlive(v time.Duration) func(*AsyncSearchGetRequest) {
+	return func(r *AsyncSearchGetRequest) {
+		r.KeepAlive = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f AsyncSearchGet) WithPretty() func(*AsyncSearchGetRequest) {
+	return func(r *AsyncSearchGetRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f AsyncSearchGet) WithHuman() func(*AsyncSearchGetRequest) {
+	return func(r *AsyncSearchGetRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f AsyncSearchGet) WithErrorTrace() func(*AsyncSearchGetRequest) {
+	return func(r *AsyncSearchGetRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f AsyncSearchGet) WithFilterPath(v ...string) func(*AsyncSearchGetRequest) {
+	return func(r *AsyncSearchGetRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f AsyncSearchGet) WithHeader(h map[string]string) func(*AsyncSearchGetRequest) {
+	return func(r *AsyncSearchGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f AsyncSearchGet) WithOpaqueID(s string) func(*AsyncSearchGetRequest) {
+	return func(r *AsyncSearchGetRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
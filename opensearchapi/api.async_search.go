@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import "encoding/json"
+
+// The states of an asynchronous search.
+const (
+	AsyncSearchStateInit             = "INIT"
+	AsyncSearchStateRunning          = "RUNNING"
+	AsyncSearchStateSucceeded        = "SUCCEEDED"
+	AsyncSearchStateFailed           = "FAILED"
+	AsyncSearchStatePersisting       = "PERSISTING"
+	AsyncSearchStatePersistSucceeded = "PERSIST_SUCCEEDED"
+	AsyncSearchStatePersistFailed    = "PERSIST_FAILED"
+	AsyncSearchStateStoreResident    = "STORE_RESIDENT"
+	AsyncSearchStateClosed           = "CLOSED"
+)
+
+// AsyncSearchResp is the response of the Asynchronous Search Submit and Get APIs.
+// The Response holds the partial results while the search is running, and the final results after.
+type AsyncSearchResp struct {
+	ID                     string          `json:"id"`
+	State                  string          `json:"state"`
+	StartTimeInMillis      int64           `json:"start_time_in_millis"`
+	ExpirationTimeInMillis int64           `json:"expiration_time_in_millis"`
+	Response               *SearchResponse `json:"response,omitempty"`
+	Error                  json.RawMessage `json:"error,omitempty"`
+}
+
+// IsRunning returns true when the search is still running, and its results are partial.
+func (r *AsyncSearchResp) IsRunning() bool {
+	return r.State == AsyncSearchStateInit || r.State == AsyncSearchStateRunning
+}
+
+// AsyncSearchDeleteResp is the response of the Asynchronous Search Delete API.
+type AsyncSearchDeleteResp struct {
+	Acknowledged bool `json:"acknowledged"`
+}
+
+// AsyncSearchStatsResp is the response of the Asynchronous Search Stats API.
+type AsyncSearchStatsResp struct {
+	NodesInfo struct {
+		Total      int `json:"total"`
+		Successful int `json:"successful"`
+		Failed     int `json:"failed"`
+	} `json:"_nodes"`
+	ClusterName string                          `json:"cluster_name"`
+	Nodes       map[string]AsyncSearchNodeStats `json:"nodes"`
+}
+
+// AsyncSearchNodeStats represents the statistics of the asynchronous searches of a node.
+type AsyncSearchNodeStats struct {
+	AsynchronousSearchStats struct {
+		Submitted       int64 `json:"submitted"`
+		Initialized     int64 `json:"initialized"`
+		SearchFailed    int64 `json:"search_failed"`
+		SearchCompleted int64 `json:"search_completed"`
+		Rejected        int64 `json:"rejected"`
+		PersistFailed   int64 `json:"persist_failed"`
+		Cancelled       int64 `json:"cancelled"`
+		RunningCurrent  int64 `json:"running_current"`
+		Persisted       int64 `json:"persisted"`
+	} `json:"asynchronous_search_stats"`
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAsyncSearch(t *testing.T) {
+	ctx := context.Background()
+
+	raw := func(s string) json.RawMessage { return json.RawMessage(s) }
+	id := "FklfVlU4eFdIUTh1Q1hiSkE3djB2OGpIbnRWMW9oAAAAAAAAAAI="
+	score := 1.0
+
+	stats := &AsyncSearchStatsResp{ClusterName: "264071961897:asynchronous-search", Nodes: map[string]AsyncSearchNodeStats{}}
+	stats.NodesInfo.Total, stats.NodesInfo.Successful = 8, 8
+	var node AsyncSearchNodeStats
+	node.AsynchronousSearchStats.Submitted = 18236
+	node.AsynchronousSearchStats.Initialized = 112
+	node.AsynchronousSearchStats.SearchFailed = 56
+	node.AsynchronousSearchStats.SearchCompleted = 56
+	node.AsynchronousSearchStats.Rejected = 18124
+	node.AsynchronousSearchStats.Cancelled = 1
+	node.AsynchronousSearchStats.RunningCurrent = 399
+	node.AsynchronousSearchStats.Persisted = 100
+	stats.Nodes["JKEFl6pdRC-xNkKQauy7Yg"] = node
+
+	testPlugin(t, pluginError{404, "async_search/error.json"}, []pluginTest{
+		{"Submit", func(tp Transport) (*Response, interface{}, error) {
+			return AsyncSearchSubmitRequest{Body: strings.NewReader(`{"size":0}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_asynchronous_search", `{"size":0}`,
+			"async_search/submit.json", &AsyncSearchResp{
+				ID:                     id,
+				State:                  AsyncSearchStateRunning,
+				StartTimeInMillis:      1599833301297,
+				ExpirationTimeInMillis: 1600265301297,
+				Response: &SearchResponse{
+					Took:   15,
+					Shards: ShardsInfo{Total: 21, Successful: 5},
+					Hits:   SearchHits{Total: &TotalHits{Value: 35, Relation: "gte"}, Hits: []SearchHit{}},
+					Aggregations: map[string]json.RawMessage{
+						"country": raw(`{"doc_count_error_upper_bound": 0, "sum_other_doc_count": 0, "buckets": [{"key": "de", "doc_count": 35}]}`),
+					},
+				},
+			}},
+		{"Get", func(tp Transport) (*Response, interface{}, error) {
+			return AsyncSearchGetRequest{ID: id}.Do(ctx, tp)
+		}, "GET", "/_plugins/_asynchronous_search/" + id, "",
+			"async_search/get.json", &AsyncSearchResp{
+				ID:                     id,
+				State:                  AsyncSearchStateStoreResident,
+				StartTimeInMillis:      1599833301297,
+				ExpirationTimeInMillis: 1600265301297,
+				Response: &SearchResponse{
+					Took:   62,
+					Shards: ShardsInfo{Total: 21, Successful: 21},
+					Hits: SearchHits{
+						Total:    &TotalHits{Value: 1, Relation: "eq"},
+						MaxScore: &score,
+						Hits: []SearchHit{{
+							Index:  "bank",
+							ID:     "1",
+							Score:  &score,
+							Source: raw(`{"account_number": 1, "balance": 39225, "firstname": "Amber", "lastname": "Duke"}`),
+						}},
+					},
+				},
+			}},
+		{"Delete", func(tp Transport) (*Response, interface{}, error) {
+			return AsyncSearchDeleteRequest{ID: id}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_asynchronous_search/" + id, "",
+			"async_search/delete.json", &AsyncSearchDeleteResp{Acknowledged: true}},
+		{"Stats", func(tp Transport) (*Response, interface{}, error) {
+			return AsyncSearchStatsRequest{}.Do(ctx, tp)
+		}, "GET", "/_plugins/_asynchronous_search/stats", "",
+			"async_search/stats.json", stats},
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		tp := &recordingTransport{body: `{}`}
+
+		_, _, err := AsyncSearchSubmitRequest{
+			Index:                    []string{"logs-*"},
+			WaitForCompletionTimeout: time.Second,
+			KeepOnCompletion:         true,
+			KeepAlive:                24 * time.Hour,
+		}.Do(ctx, tp)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		q := tp.req.URL.Query()
+		if q.Get("index") != "logs-*" || q.Get("wait_for_completion_timeout") != "1000ms" || q.Get("keep_on_completion") != "true" ||
+			q.Get("keep_alive") != "86400000ms" {
+			t.Errorf("Unexpected query: %s", tp.req.URL.RawQuery)
+		}
+	})
+}
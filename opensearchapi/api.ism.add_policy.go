@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newISMAddPolicyFunc(t Transport) ISMAddPolicy {
+	return func(o ...func(*ISMAddPolicyRequest)) (*Response, *ISMIndicesResp, error) {
+		var r = ISMAddPolicyRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ISMAddPolicy applies a policy to indices.
+type ISMAddPolicy func(o ...func(*ISMAddPolicyRequest)) (*Response, *ISMIndicesResp, error)
+
+// ISMAddPolicyRequest configures the ISM Add Policy API request.
+type ISMAddPolicyRequest struct {
+	Index []string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, ISMIndicesResp and error.
+func (r ISMAddPolicyRequest) Do(ctx context.Context, transport Transport) (*Response, *ISMIndicesResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data ISMIndicesResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_ism/add") + 1 + len(strings.Join(r.Index, ",")))
+	path.WriteString("/_plugins/_ism/add")
+	path.WriteString("/")
+	path.WriteString(strings.Join(r.Index, ","))
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f ISMAddPolicy) WithContext(v context.Context) func(*ISMAddPolicyRequest) {
+	return func(r *ISMAddPolicyRequest) {
+		r.ctx = v
+	}
+}
+
+// WithIndex - the indices; wildcards are supported.
+func (f ISMAddPolicy) WithIndex(v ...string) func(*ISMAddPolicyRequest) {
+	return func(r *ISMAddPolicyRequest) {
+		r.Index = v
+	}
+}
+
+// WithBody - the policy ID, eg. {"policy_id": "logs"}.
+func (f ISMAddPolicy) WithBody(v io.Reader) func(*ISMAddPolicyRequest) {
+	return func(r *ISMAddPolicyRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ISMAddPolicy) WithPretty() func(*ISMAddPolicyRequest) {
+	return func(r *ISMAddPolicyRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ISMAddPolicy) WithHuman() func(*ISMAddPolicyRequest) {
+	return func(r *ISMAddPolicyRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ISMAddPolicy) WithErrorTrace() func(*ISMAddPolicyRequest) {
+	return func(r *ISMAddPolicyRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ISMAddPolicy) WithFilterPath(v ...string) func(*ISMAddPolicyRequest) {
+	return func(r *ISMAddPolicyRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ISMAddPolicy) WithHeader(h map[string]string) func(*ISMAddPolicyRequest) {
+	return func(r *ISMAddPolicyRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ISMAddPolicy) WithOpaqueID(s string) func(*ISMAddPolicyRequest) {
+	return func(r *ISMAddPolicyRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
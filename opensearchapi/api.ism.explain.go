@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newISMExplainFunc(t Transport) ISMExplain {
+	return func(o ...func(*ISMExplainRequest)) (*Response, *ISMExplainResp, error) {
+		var r = ISMExplainRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ISMExplain returns the state of the managed indices.
+type ISMExplain func(o ...func(*ISMExplainRequest)) (*Response, *ISMExplainResp, error)
+
+// ISMExplainRequest configures the ISM Explain API request.
+type ISMExplainRequest struct {
+	Index []string
+
+	ShowPolicy     bool
+	ValidateAction bool
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, ISMExplainResp and error.
+func (r ISMExplainRequest) Do(ctx context.Context, transport Transport) (*Response, *ISMExplainResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data ISMExplainResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_ism/explain") + 1 + len(strings.Join(r.Index, ",")))
+	path.WriteString("/_plugins/_ism/explain")
+	if len(r.Index) > 0 {
+		path.WriteString("/")
+		path.WriteString(strings.Join(r.Index, ","))
+	}
+
+	params = make(map[string]string)
+
+	if r.ShowPolicy {
+		params["show_policy"] = "true"
+	}
+
+	if r.ValidateAction {
+		params["validate_action"] = "true"
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f ISMExplain) WithContext(v context.Context) func(*ISMExplainRequest) {
+	return func(r *ISMExplainRequest) {
+		r.ctx = v
+	}
+}
+
+// WithIndex - the indices; wildcards are supported.
+func (f ISMExplain) WithIndex(v ...string) func(*ISMExplainRequest) {
+	return func(r *ISMExplainRequest) {
+		r.Index = v
+	}
+}
+
+// WithShowPolicy - returns the policy of each index.
+func (f ISMExplain) WithShowPolicy() func(*ISMExplainRequest) {
+	return func(r *ISMExplainRequest) {
+		r.ShowPolicy = true
+	}
+}
+
+// WithValidateAction - returns the validation of the current action.
+func (f ISMExplain) WithValidateAction() func(*ISMExplainRequest) {
+	return func(r *ISMExplainRequest) {
+		r.ValidateAction = true
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ISMExplain) WithPretty() func(*ISMExplainRequest) {
+	return func(r *ISMExplainRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ISMExplain) WithHuman() func(*ISMExplainRequest) {
+	return func(r *ISMExplainRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ISMExplain) WithErrorTrace() func(*ISMExplainRequest) {
+	return func(r *ISMExplainRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ISMExplain) WithFilterPath(v ...string) func(*ISMExplainRequest) {
+	return func(r *ISMExplainRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ISMExplain) WithHeader(h map[string]string) func(*ISMExplainRequest) {
+	return func(r *ISMExplainRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ISMExplain) WithOpaqueID(s string) func(*ISMExplainRequest) {
+	return func(r *ISMExplainRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func newISMGetPoliciesFunc(t Transport) ISMGetPolicies {
+	return func(o ...func(*ISMGetPoliciesRequest)) (*Response, *ISMGetPoliciesResp, error) {
+		var r = ISMGetPoliciesRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ISMGetPolicies returns the policies.
+type ISMGetPolicies func(o ...func(*ISMGetPoliciesRequest)) (*Response, *ISMGetPoliciesResp, error)
+
+// ISMGetPoliciesRequest configures the ISM Get Policies API request.
+type ISMGetPoliciesRequest struct {
+	From        *int
+	Size        *int
+	SortField   string
+	SortOrder   string
+	QueryString string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, ISMGetPoliciesResp and error.
+func (r ISMGetPoliciesRequest) Do(ctx context.Context, transport Transport) (*Response, *ISMGetPoliciesResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data ISMGetPoliciesResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_ism/policies"))
+	path.WriteString("/_plugins/_ism/policies")
+
+	params = make(map[string]string)
+
+	if r.From != nil {
+		params["from"] = strconv.FormatInt(int64(*r.From), 10)
+	}
+
+	if r.Size != nil {
+		params["size"] = strconv.FormatInt(int64(*r.Size), 10)
+	}
+
+	if r.SortField != "" {
+		params["sortField"] = r.SortField
+	}
+
+	if r.SortOrder != "" {
+		params["sortOrder"] = r.SortOrder
+	}
+
+	if r.QueryString != "" {
+		params["queryString"] = r.QueryString
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f ISMGetPolicies) WithContext(v context.Context) func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		r.ctx = v
+	}
+}
+
+// WithFrom - the offset of the first policy.
+func (f ISMGetPolicies) WithFrom(v int) func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		r.From = &v
+	}
+}
+
+// WithSize - the number of policies to return.
+func (f ISMGetPolicies) WithSize(v int) func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		r.Size = &v
+	}
+}
+
+// WithSortField - the field to sort the policies by.
+func (f ISMGetPolicies) WithSortField(v string) func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		r.SortField = v
+	}
+}
+
+// WithSortOrder - the sort order, asc or desc.
+func (f ISMGetPolicies) WithSortOrder(v string) func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		r.SortOrder = v
+	}
+}
+
+// WithQueryString - a query string to filter the policies.
+func (f ISMGetPolicies) WithQueryString(v string) func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		r.QueryString = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ISMGetPolicies) WithPretty() func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ISMGetPolicies) WithHuman() func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ISMGetPolicies) WithErrorTrace() func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ISMGetPolicies) WithFilterPath(v ...string) func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ISMGetPolicies) WithHeader(h map[string]string) func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ISMGetPolicies) WithOpaqueID(s string) func(*ISMGetPoliciesRequest) {
+	return func(r *ISMGetPoliciesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
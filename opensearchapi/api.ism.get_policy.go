@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newISMGetPolicyFunc(t Transport) ISMGetPolicy {
+	return func(o ...func(*ISMGetPolicyRequest)) (*Response, *ISMGetPolicyResp, error) {
+		var r = ISMGetPolicyRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ISMGetPolicy returns a policy.
+type ISMGetPolicy func(o ...func(*ISMGetPolicyRequest)) (*Response, *ISMGetPolicyResp, error)
+
+// ISMGetPolicyRequest configures the ISM Get Policy API request.
+type ISMGetPolicyRequest struct {
+	PolicyID string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, ISMGetPolicyResp and error.
+func (r ISMGetPolicyRequest) Do(ctx context.Context, transport Transport) (*Response, *ISMGetPolicyResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data ISMGetPolicyResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_ism/policies") + 1 + len(r.PolicyID))
+	path.WriteString("/_plugins/_ism/policies")
+	path.WriteString("/")
+	path.WriteString(r.PolicyID)
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f ISMGetPolicy) WithContext(v context.Context) func(*ISMGetPolicyRequest) {
+	return func(r *ISMGetPolicyRequest) {
+		r.ctx = v
+	}
+}
+
+// WithPolicyID - the ID of the policy.
+func (f ISMGetPolicy) WithPolicyID(v string) func(*ISMGetPolicyRequest) {
+	return func(r *ISMGetPolicyRequest) {
+		r.PolicyID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ISMGetPolicy) WithPretty() func(*ISMGetPolicyRequest) {
+	return func(r *ISMGetPolicyRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ISMGetPolicy) WithHuman() func(*ISMGetPolicyRequest) {
+	return func(r *ISMGetPolicyRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ISMGetPolicy) WithErrorTrace() func(*ISMGetPolicyRequest) {
+	return func(r *ISMGetPolicyRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ISMGetPolicy) WithFilterPath(v ...string) func(*ISMGetPolicyRequest) {
+	return func(r *ISMGetPolicyRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ISMGetPolicy) WithHeader(h map[string]string) func(*ISMGetPolicyRequest) {
+	return func(r *ISMGetPolicyRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ISMGetPolicy) WithOpaqueID(s string) func(*ISMGetPolicyRequest) {
+	return func(r *ISMGetPolicyRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
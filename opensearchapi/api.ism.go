@@ -0,0 +1,473 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ISMPolicyBody is the body of the ISM Put Policy API.
+type ISMPolicyBody struct {
+	Policy ISMPolicy `json:"policy"`
+}
+
+// ISMPolicy represents an Index State Management policy: the indices move from the default state
+// to the other states through the transitions, and the actions of each state run in order.
+type ISMPolicy struct {
+	PolicyID          string                `json:"policy_id,omitempty"`
+	Description       string                `json:"description,omitempty"`
+	LastUpdatedTime   int64                 `json:"last_updated_time,omitempty"`
+	SchemaVersion     int                   `json:"schema_version,omitempty"`
+	ErrorNotification *ISMErrorNotification `json:"error_notification,omitempty"`
+	DefaultState      string                `json:"default_state"`
+	States            []ISMState            `json:"states"`
+	ISMTemplate       []ISMTemplate         `json:"ism_template,omitempty"`
+}
+
+// ISMErrorNotification represents the notification sent when an action of a policy fails.
+// Destination is kept raw, Channel is the ID of a Notifications channel.
+type ISMErrorNotification struct {
+	Destination     json.RawMessage     `json:"destination,omitempty"`
+	Channel         *ISMChannel         `json:"channel,omitempty"`
+	MessageTemplate *ISMMessageTemplate `json:"message_template,omitempty"`
+}
+
+// ISMChannel represents a Notifications channel.
+type ISMChannel struct {
+	ID string `json:"id"`
+}
+
+// ISMMessageTemplate represents a mustache template, eg. "{{ctx.index}}".
+type ISMMessageTemplate struct {
+	Source string `json:"source"`
+	Lang   string `json:"lang,omitempty"`
+}
+
+// ISMTemplate applies a policy to the new indices which match the index patterns.
+type ISMTemplate struct {
+	IndexPatterns   []string `json:"index_patterns"`
+	Priority        int      `json:"priority,omitempty"`
+	LastUpdatedTime int64    `json:"last_updated_time,omitempty"`
+}
+
+// ISMState represents a state of a policy.
+type ISMState struct {
+	Name        string          `json:"name"`
+	Actions     []ISMAction     `json:"actions"`
+	Transitions []ISMTransition `json:"transitions"`
+}
+
+// ISMTransition moves an index to the state when the conditions are met;
+// a transition without conditions is taken as soon as the actions are done.
+type ISMTransition struct {
+	StateName  string         `json:"state_name"`
+	Conditions *ISMConditions `json:"conditions,omitempty"`
+}
+
+// ISMConditions represents the conditions of a transition.
+type ISMConditions struct {
+	MinIndexAge    string            `json:"min_index_age,omitempty"`
+	MinRolloverAge string            `json:"min_rollover_age,omitempty"`
+	MinDocCount    *int64            `json:"min_doc_count,omitempty"`
+	MinSize        string            `json:"min_size,omitempty"`
+	Cron           *ISMCronCondition `json:"cron,omitempty"`
+}
+
+// ISMCronCondition is a condition met on a cron schedule.
+type ISMCronCondition struct {
+	Cron ISMCron `json:"cron"`
+}
+
+// ISMCron represents a cron expression, eg. {Expression: "0 0 * * *", Timezone: "UTC"}.
+type ISMCron struct {
+	Expression string `json:"expression"`
+	Timezone   string `json:"timezone"`
+}
+
+// ISMAction represents an action of a state; exactly one of the action fields must be set.
+type ISMAction struct {
+	Timeout string          `json:"timeout,omitempty"`
+	Retry   *ISMActionRetry `json:"retry,omitempty"`
+
+	Rollover      *ISMRolloverAction      `json:"rollover,omitempty"`
+	Delete        *ISMEmptyAction         `json:"delete,omitempty"`
+	ForceMerge    *ISMForceMergeAction    `json:"force_merge,omitempty"`
+	Shrink        *ISMShrinkAction        `json:"shrink,omitempty"`
+	ReplicaCount  *ISMReplicaCountAction  `json:"replica_count,omitempty"`
+	Snapshot      *ISMSnapshotAction      `json:"snapshot,omitempty"`
+	IndexPriority *ISMIndexPriorityAction `json:"index_priority,omitempty"`
+	Allocation    *ISMAllocationAction    `json:"allocation,omitempty"`
+	ReadOnly      *ISMEmptyAction         `json:"read_only,omitempty"`
+	ReadWrite     *ISMEmptyAction         `json:"read_write,omitempty"`
+	Open          *ISMEmptyAction         `json:"open,omitempty"`
+	Close         *ISMEmptyAction         `json:"close,omitempty"`
+	Notification  *ISMNotificationAction  `json:"notification,omitempty"`
+	Rollup        *ISMRollupAction        `json:"rollup,omitempty"`
+	Alias         *ISMAliasAction         `json:"alias,omitempty"`
+}
+
+// ISMActionRetry represents the retries of a failed action.
+type ISMActionRetry struct {
+	Count   int    `json:"count"`
+	Backoff string `json:"backoff,omitempty"`
+	Delay   string `json:"delay,omitempty"`
+}
+
+// ISMEmptyAction is an action without settings, eg. delete or read_only.
+type ISMEmptyAction struct{}
+
+// ISMRolloverAction rolls the index over the alias when one of the conditions is met.
+type ISMRolloverAction struct {
+	MinSize             string `json:"min_size,omitempty"`
+	MinPrimaryShardSize string `json:"min_primary_shard_size,omitempty"`
+	MinDocCount         *int64 `json:"min_doc_count,omitempty"`
+	MinIndexAge         string `json:"min_index_age,omitempty"`
+	CopyAlias           bool   `json:"copy_alias,omitempty"`
+}
+
+// ISMForceMergeAction merges the segments of the index.
+type ISMForceMergeAction struct {
+	MaxNumSegments int `json:"max_num_segments"`
+}
+
+// ISMShrinkAction shrinks the index; exactly one of NumNewShards, MaxShardSize
+// and PercentageOfSourceShards must be set.
+type ISMShrinkAction struct {
+	NumNewShards             int                      `json:"num_new_shards,omitempty"`
+	MaxShardSize             string                   `json:"max_shard_size,omitempty"`
+	PercentageOfSourceShards float64                  `json:"percentage_of_source_shards,omitempty"`
+	TargetIndexNameTemplate  *ISMMessageTemplate      `json:"target_index_name_template,omitempty"`
+	Aliases                  []map[string]interface{} `json:"aliases,omitempty"`
+	SwitchAliases            bool                     `json:"switch_aliases,omitempty"`
+	ForceUnsafe              bool                     `json:"force_unsafe,omitempty"`
+}
+
+// ISMReplicaCountAction sets the number of replicas of the index.
+type ISMReplicaCountAction struct {
+	NumberOfReplicas int `json:"number_of_replicas"`
+}
+
+// ISMSnapshotAction snapshots the index into the repository.
+type ISMSnapshotAction struct {
+	Repository string `json:"repository"`
+	Snapshot   string `json:"snapshot"`
+}
+
+// ISMIndexPriorityAction sets the recovery priority of the index.
+type ISMIndexPriorityAction struct {
+	Priority int `json:"priority"`
+}
+
+// ISMAllocationAction allocates the index to the nodes with the attributes.
+type ISMAllocationAction struct {
+	Require map[string]string `json:"require,omitempty"`
+	Include map[string]string `json:"include,omitempty"`
+	Exclude map[string]string `json:"exclude,omitempty"`
+	WaitFor bool              `json:"wait_for,omitempty"`
+}
+
+// ISMNotificationAction sends the message to the destination or the Notifications channel.
+// Destination is kept raw, as for ISMErrorNotification.
+type ISMNotificationAction struct {
+	Destination     json.RawMessage     `json:"destination,omitempty"`
+	Channel         *ISMChannel         `json:"channel,omitempty"`
+	MessageTemplate *ISMMessageTemplate `json:"message_template"`
+}
+
+// ISMRollupAction rolls the index up into the target index.
+type ISMRollupAction struct {
+	ISMRollup ISMRollup `json:"ism_rollup"`
+}
+
+// ISMRollup represents the rollup of an ISM rollup action; the source index is the managed index.
+type ISMRollup struct {
+	Description string            `json:"description,omitempty"`
+	TargetIndex string            `json:"target_index"`
+	PageSize    int               `json:"page_size"`
+	Dimensions  []RollupDimension `json:"dimensions"`
+	Metrics     []RollupMetric    `json:"metrics"`
+}
+
+// ISMAliasAction adds the index to aliases, or removes it from them, in order.
+type ISMAliasAction struct {
+	Actions []ISMAliasOperation `json:"actions"`
+}
+
+// ISMAliasOperation represents an operation of an alias action; exactly one of Add and Remove must be set.
+type ISMAliasOperation struct {
+	Add    *ISMAlias `json:"add,omitempty"`
+	Remove *ISMAlias `json:"remove,omitempty"`
+}
+
+// ISMAlias represents the aliases of an alias operation; the index is the managed index.
+type ISMAlias struct {
+	Alias         string          `json:"alias,omitempty"`
+	Aliases       []string        `json:"aliases,omitempty"`
+	Filter        json.RawMessage `json:"filter,omitempty"`
+	Routing       string          `json:"routing,omitempty"`
+	IndexRouting  string          `json:"index_routing,omitempty"`
+	SearchRouting string          `json:"search_routing,omitempty"`
+	IsWriteIndex  *bool           `json:"is_write_index,omitempty"`
+}
+
+// Validate checks the policy before it is uploaded: the default state and the transitions must refer
+// to existing states, and each action must have exactly one valid action type.
+func (p ISMPolicy) Validate() error {
+	if p.DefaultState == "" {
+		return fmt.Errorf("ism policy: default state is required")
+	}
+	if len(p.States) == 0 {
+		return fmt.Errorf("ism policy: at least one state is required")
+	}
+
+	states := make(map[string]bool, len(p.States))
+	for _, s := range p.States {
+		if s.Name == "" {
+			return fmt.Errorf("ism policy: state name is required")
+		}
+		if states[s.Name] {
+			return fmt.Errorf("ism policy: duplicate state %q", s.Name)
+		}
+		states[s.Name] = true
+	}
+	if !states[p.DefaultState] {
+		return fmt.Errorf("ism policy: default state %q does not exist", p.DefaultState)
+	}
+
+	for _, s := range p.States {
+		for i, a := range s.Actions {
+			if err := a.validate(); err != nil {
+				return fmt.Errorf("ism policy: state %q: action %d: %w", s.Name, i, err)
+			}
+		}
+		for _, t := range s.Transitions {
+			if !states[t.StateName] {
+				return fmt.Errorf("ism policy: state %q: transition to unknown state %q", s.Name, t.StateName)
+			}
+		}
+	}
+
+	for _, t := range p.ISMTemplate {
+		if len(t.IndexPatterns) == 0 {
+			return fmt.Errorf("ism policy: ism template requires index patterns")
+		}
+	}
+	return nil
+}
+
+func (a ISMAction) validate() error {
+	var types []string
+	set := func(name string, ok bool) {
+		if ok {
+			types = append(types, name)
+		}
+	}
+	set("rollover", a.Rollover != nil)
+	set("delete", a.Delete != nil)
+	set("force_merge", a.ForceMerge != nil)
+	set("shrink", a.Shrink != nil)
+	set("replica_count", a.ReplicaCount != nil)
+	set("snapshot", a.Snapshot != nil)
+	set("index_priority", a.IndexPriority != nil)
+	set("allocation", a.Allocation != nil)
+	set("read_only", a.ReadOnly != nil)
+	set("read_write", a.ReadWrite != nil)
+	set("open", a.Open != nil)
+	set("close", a.Close != nil)
+	set("notification", a.Notification != nil)
+	set("rollup", a.Rollup != nil)
+	set("alias", a.Alias != nil)
+
+	switch {
+	case len(types) == 0:
+		return fmt.Errorf("no action type")
+	case len(types) > 1:
+		return fmt.Errorf("more than one action type: %v", types)
+	}
+
+	switch {
+	case a.ForceMerge != nil && a.ForceMerge.MaxNumSegments < 1:
+		return fmt.Errorf("force_merge: max_num_segments must be positive")
+	case a.ReplicaCount != nil && a.ReplicaCount.NumberOfReplicas < 0:
+		return fmt.Errorf("replica_count: number_of_replicas cannot be negative")
+	case a.Snapshot != nil && (a.Snapshot.Repository == "" || a.Snapshot.Snapshot == ""):
+		return fmt.Errorf("snapshot: repository and snapshot are required")
+	case a.Notification != nil && len(a.Notification.Destination) == 0 && a.Notification.Channel == nil:
+		return fmt.Errorf("notification: destination or channel is required")
+	case a.Notification != nil && a.Notification.MessageTemplate == nil:
+		return fmt.Errorf("notification: message_template is required")
+	case a.Rollup != nil && a.Rollup.ISMRollup.TargetIndex == "":
+		return fmt.Errorf("rollup: target_index is required")
+	case a.Alias != nil:
+		if len(a.Alias.Actions) == 0 {
+			return fmt.Errorf("alias: at least one action is required")
+		}
+		for _, op := range a.Alias.Actions {
+			if (op.Add == nil) == (op.Remove == nil) {
+				return fmt.Errorf("alias: exactly one of add and remove is required")
+			}
+		}
+	case a.Shrink != nil:
+		var n int
+		if a.Shrink.NumNewShards > 0 {
+			n++
+		}
+		if a.Shrink.MaxShardSize != "" {
+			n++
+		}
+		if a.Shrink.PercentageOfSourceShards > 0 {
+			n++
+		}
+		if n != 1 {
+			return fmt.Errorf("shrink: exactly one of num_new_shards, max_shard_size and percentage_of_source_shards is required")
+		}
+	}
+	return nil
+}
+
+// ISMPutPolicyResp is the response of the ISM Put Policy API.
+type ISMPutPolicyResp struct {
+	ID          string        `json:"_id"`
+	Version     int64         `json:"_version"`
+	PrimaryTerm int64         `json:"_primary_term"`
+	SeqNo       int64         `json:"_seq_no"`
+	Policy      ISMPolicyBody `json:"policy"`
+}
+
+// ISMGetPolicyResp is the response of the ISM Get Policy API.
+// The SeqNo and PrimaryTerm are used for optimistic concurrency control of the updates.
+type ISMGetPolicyResp struct {
+	ID          string    `json:"_id"`
+	Version     int64     `json:"_version"`
+	PrimaryTerm int64     `json:"_primary_term"`
+	SeqNo       int64     `json:"_seq_no"`
+	Policy      ISMPolicy `json:"policy"`
+}
+
+// ISMGetPoliciesResp is the response of the ISM Get Policies API.
+type ISMGetPoliciesResp struct {
+	Policies      []ISMGetPolicyResp `json:"policies"`
+	TotalPolicies int                `json:"total_policies"`
+}
+
+// ISMDeletePolicyResp is the response of the ISM Delete Policy API.
+type ISMDeletePolicyResp struct {
+	Index       string     `json:"_index"`
+	ID          string     `json:"_id"`
+	Version     int64      `json:"_version"`
+	Result      string     `json:"result"`
+	Shards      ShardsInfo `json:"_shards"`
+	SeqNo       int64      `json:"_seq_no"`
+	PrimaryTerm int64      `json:"_primary_term"`
+}
+
+// ISMChangePolicyBody is the body of the ISM Change Policy API; Include filters the indices by their current state.
+type ISMChangePolicyBody struct {
+	PolicyID string                   `json:"policy_id"`
+	State    string                   `json:"state,omitempty"`
+	Include  []ISMChangePolicyInclude `json:"include,omitempty"`
+}
+
+// ISMChangePolicyInclude selects the indices in the state.
+type ISMChangePolicyInclude struct {
+	State string `json:"state"`
+}
+
+// ISMIndicesResp is the response of the ISM APIs which manage the policy of indices.
+type ISMIndicesResp struct {
+	UpdatedIndices int              `json:"updated_indices"`
+	Failures       bool             `json:"failures"`
+	FailedIndices  []ISMFailedIndex `json:"failed_indices"`
+}
+
+// ISMFailedIndex represents an index which could not be updated.
+type ISMFailedIndex struct {
+	IndexName string `json:"index_name"`
+	IndexUUID string `json:"index_uuid"`
+	Reason    string `json:"reason"`
+}
+
+// ISMExplainResp is the response of the ISM Explain API.
+type ISMExplainResp struct {
+	Indices             map[string]ISMExplainIndex
+	TotalManagedIndices int
+}
+
+// UnmarshalJSON decodes the indices, which are mixed with the total in the response.
+func (r *ISMExplainResp) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	r.Indices = make(map[string]ISMExplainIndex, len(raw))
+	for k, v := range raw {
+		if k == "total_managed_indices" {
+			if err := json.Unmarshal(v, &r.TotalManagedIndices); err != nil {
+				return err
+			}
+			continue
+		}
+		var idx ISMExplainIndex
+		if err := json.Unmarshal(v, &idx); err != nil {
+			return fmt.Errorf("cannot decode index %q: %w", k, err)
+		}
+		r.Indices[k] = idx
+	}
+	return nil
+}
+
+// ISMExplainIndex represents the state of a managed index; PolicyID is nil for an unmanaged index.
+type ISMExplainIndex struct {
+	PolicyID          *string                `json:"index.plugins.index_state_management.policy_id"`
+	Index             string                 `json:"index,omitempty"`
+	IndexUUID         string                 `json:"index_uuid,omitempty"`
+	Enabled           *bool                  `json:"enabled,omitempty"`
+	PolicySeqNo       int64                  `json:"policy_seq_no,omitempty"`
+	PolicyPrimaryTerm int64                  `json:"policy_primary_term,omitempty"`
+	RolledOver        bool                   `json:"rolled_over,omitempty"`
+	IndexCreationDate int64                  `json:"index_creation_date,omitempty"`
+	State             *ISMExplainState       `json:"state,omitempty"`
+	Action            *ISMExplainAction      `json:"action,omitempty"`
+	Step              *ISMExplainStep        `json:"step,omitempty"`
+	RetryInfo         *ISMExplainRetryInfo   `json:"retry_info,omitempty"`
+	Info              map[string]interface{} `json:"info,omitempty"`
+	Policy            *ISMPolicy             `json:"policy,omitempty"`
+}
+
+// ISMExplainState represents the current state of a managed index.
+type ISMExplainState struct {
+	Name      string `json:"name"`
+	StartTime int64  `json:"start_time"`
+}
+
+// ISMExplainAction represents the current action of a managed index.
+type ISMExplainAction struct {
+	Name            string `json:"name"`
+	StartTime       int64  `json:"start_time"`
+	Index           int    `json:"index"`
+	Failed          bool   `json:"failed"`
+	ConsumedRetries int    `json:"consumed_retries"`
+	LastRetryTime   int64  `json:"last_retry_time"`
+}
+
+// ISMExplainStep represents the current step of the action of a managed index.
+type ISMExplainStep struct {
+	Name       string `json:"name"`
+	StartTime  int64  `json:"start_time"`
+	StepStatus string `json:"step_status"`
+}
+
+// ISMExplainRetryInfo represents the retries of the current action of a managed index.
+type ISMExplainRetryInfo struct {
+	Failed          bool `json:"failed"`
+	ConsumedRetries int  `json:"consumed_retries"`
+}
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func newISMPutPolicyFunc(t Transport) ISMPutPolicy {
+	return func(o ...func(*ISMPutPolicyRequest)) (*Response, *ISMPutPolicyResp, error) {
+		var r = ISMPutPolicyRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ISMPutPolicy creates or updates a policy.
+type ISMPutPolicy func(o ...func(*ISMPutPolicyRequest)) (*Response, *ISMPutPolicyResp, error)
+
+// ISMPutPolicyRequest configures the ISM Put Policy API request.
+type ISMPutPolicyRequest struct {
+	PolicyID string
+
+	Body io.Reader
+
+	IfSeqNo       *int64
+	IfPrimaryTerm *int64
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, ISMPutPolicyResp and error.
+func (r ISMPutPolicyRequest) Do(ctx context.Context, transport Transport) (*Response, *ISMPutPolicyResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data ISMPutPolicyResp
+	)
+	method := "PUT"
+
+	path.Grow(len("/_plugins/_ism/policies") + 1 + len(r.PolicyID))
+	path.WriteString("/_plugins/_ism/policies")
+	path.WriteString("/")
+	path.WriteString(r.PolicyID)
+
+	params = make(map[string]string)
+
+	if r.IfSeqNo != nil {
+		params["if_seq_no"] = strconv.FormatInt(*r.IfSeqNo, 10)
+	}
+
+	if r.IfPrimaryTerm != nil {
+		params["if_primary_term"] = strconv.FormatInt(*r.IfPrimaryTerm, 10)
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f ISMPutPolicy) WithContext(v context.Context) func(*ISMPutPolicyRequest) {
+	return func(r *ISMPutPolicyRequest) {
+		r.ctx = v
+	}
+}
+
+// WithPolicyID - the ID of the policy.
+func (f ISMPutPolicy) WithPolicyID(v string) func(*ISMPutPolicyRequest) {
+	return func(r *ISMPutPolicyRequest) {
+		r.PolicyID = v
+	}
+}
+
+// WithBody - the policy, see ISMPolicyBody.
+func (f ISMPutPolicy) WithBody(v io.Reader) func(*ISMPutPolicyRequest) {
+	return func(r *ISMPutPolicyRequest) {
+		r.Body = v
+	}
+}
+
+// WithIfSeqNo - only updates the policy if it has this sequence number.
+func (f ISMPutPolicy) WithIfSeqNo(v int64) func(*ISMPutPolicyRequest) {
+	return func(r *ISMPutPolicyRequest) {
+		r.IfSeqNo = &v
+	}
+}
+
+// WithIfPrimaryTerm - only updates the policy if it has this primary term.
+func (f ISMPutPolicy) WithIfPrimaryTerm(v int64) func(*ISMPutPolicyRequest) {
+	return func(r *ISMPutPolicyRequest) {
+		r.IfPrimaryTerm = &v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ISMPutPolicy) WithPretty() func(*ISMPutPolicyRequest) {
+	return func(r *ISMPutPolicyRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ISMPutPolicy) WithHuman() func(*ISMPutPolicyRequest) {
+	return func(r *ISMPutPolicyRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ISMPutPolicy) WithErrorTrace() func(*ISMPutPolicyRequest) {
+	return func(r *ISMPutPolicyRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ISMPutPolicy) WithFilterPath(v ...string) func(*ISMPutPolicyRequest) {
+	return func(r *ISMPutPolicyRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ISMPutPolicy) WithHeader(h map[string]string) func(*ISMPutPolicyRequest) {
+	return func(r *ISMPutPolicyRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ISMPutPolicy) WithOpaqueID(s string) func(*ISMPutPolicyRequest) {
+	return func(r *ISMPutPolicyRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
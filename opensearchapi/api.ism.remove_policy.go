@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newISMRemovePolicyFunc(t Transport) ISMRemovePolicy {
+	return func(o ...func(*ISMRemovePolicyRequest)) (*Response, *ISMIndicesResp, error) {
+		var r = ISMRemovePolicyRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ISMRemovePolicy removes the policy from indices.
+type ISMRemovePolicy func(o ...func(*ISMRemovePolicyRequest)) (*Response, *ISMIndicesResp, error)
+
+// ISMRemovePolicyRequest configures the ISM Remove Policy API request.
+type ISMRemovePolicyRequest struct {
+	Index []string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, ISMIndicesResp and error.
+func (r ISMRemovePolicyRequest) Do(ctx context.Context, transport Transport) (*Response, *ISMIndicesResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data ISMIndicesResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_ism/remove") + 1 + len(strings.Join(r.Index, ",")))
+	path.WriteString("/_plugins/_ism/remove")
+	path.WriteString("/")
+	path.WriteString(strings.Join(r.Index, ","))
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f ISMRemovePolicy) WithContext(v context.Context) func(*ISMRemovePolicyRequest) {
+	return func(r *ISMRemovePolicyRequest) {
+		r.ctx = v
+	}
+}
+
+// WithIndex - the indices; wildcards are supported.
+func (f ISMRemovePolicy) WithIndex(v ...string) func(*ISMRemovePolicyRequest) {
+	return func(r *ISMRemovePolicyRequest) {
+		r.Index = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ISMRemovePolicy) WithPretty() func(*ISMRemovePolicyRequest) {
+	return func(r *ISMRemovePolicyRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ISMRemovePolicy) WithHuman() func(*ISMRemovePolicyRequest) {
+	return func(r *ISMRemovePolicyRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ISMRemovePolicy) WithErrorTrace() func(*ISMRemovePolicyRequest) {
+	return func(r *ISMRemovePolicyRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ISMRemovePolicy) WithFilterPath(v ...string) func(*ISMRemovePolicyRequest) {
+	return func(r *ISMRemovePolicyRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ISMRemovePolicy) WithHeader(h map[string]string) func(*ISMRemovePolicyRequest) {
+	return func(r *ISMRemovePolicyRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ISMRemovePolicy) WithOpaqueID(s string) func(*ISMRemovePolicyRequest) {
+	return func(r *ISMRemovePolicyRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,319 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestISM(t *testing.T) {
+	ctx := context.Background()
+	body := func(s string) io.Reader { return strings.NewReader(s) }
+
+	retry := &ISMActionRetry{Count: 3, Backoff: "exponential", Delay: "1m"}
+	minDocCount := int64(5)
+	policy := func(lastUpdated int64, template []ISMTemplate) ISMPolicy {
+		return ISMPolicy{
+			PolicyID:        "policy_1",
+			Description:     "ingesting logs",
+			LastUpdatedTime: lastUpdated,
+			SchemaVersion:   1,
+			DefaultState:    "ingest",
+			States: []ISMState{
+				{
+					Name:        "ingest",
+					Actions:     []ISMAction{{Retry: retry, Rollover: &ISMRolloverAction{MinDocCount: &minDocCount}}},
+					Transitions: []ISMTransition{{StateName: "search"}},
+				},
+				{
+					Name:        "search",
+					Actions:     []ISMAction{},
+					Transitions: []ISMTransition{{StateName: "delete", Conditions: &ISMConditions{MinIndexAge: "5m"}}},
+				},
+				{
+					Name:        "delete",
+					Actions:     []ISMAction{{Retry: retry, Delete: &ISMEmptyAction{}}},
+					Transitions: []ISMTransition{},
+				},
+			},
+			ISMTemplate: template,
+		}
+	}
+	updated := &ISMIndicesResp{UpdatedIndices: 2, FailedIndices: []ISMFailedIndex{}}
+	enabled := true
+	policyID := "policy_1"
+
+	testPlugin(t, pluginError{404, "ism/error.json"}, []pluginTest{
+		{"PutPolicy", func(tp Transport) (*Response, interface{}, error) {
+			return ISMPutPolicyRequest{PolicyID: "policy_1", Body: body(`{"policy":{}}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_ism/policies/policy_1", `{"policy":{}}`,
+			"ism/put_policy.json", &ISMPutPolicyResp{
+				ID: "policy_1", Version: 1, PrimaryTerm: 1, SeqNo: 7,
+				Policy: ISMPolicyBody{Policy: policy(1577990761311, nil)},
+			}},
+		{"GetPolicy", func(tp Transport) (*Response, interface{}, error) {
+			return ISMGetPolicyRequest{PolicyID: "policy_1"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_ism/policies/policy_1", "",
+			"ism/get_policy.json", &ISMGetPolicyResp{
+				ID: "policy_1", Version: 2, SeqNo: 10, PrimaryTerm: 1,
+				Policy: policy(1577990934044, []ISMTemplate{{IndexPatterns: []string{"logs-*"}, Priority: 100, LastUpdatedTime: 1577990934044}}),
+			}},
+		{"GetPolicies", func(tp Transport) (*Response, interface{}, error) { return ISMGetPoliciesRequest{}.Do(ctx, tp) },
+			"GET", "/_plugins/_ism/policies", "",
+			"ism/get_policies.json", &ISMGetPoliciesResp{
+				Policies: []ISMGetPolicyResp{{
+					ID: "hot-delete", PrimaryTerm: 1,
+					Policy: ISMPolicy{
+						PolicyID:        "hot-delete",
+						Description:     "Deletes the indices after a day",
+						LastUpdatedTime: 1662540064126,
+						SchemaVersion:   15,
+						DefaultState:    "hot",
+						States: []ISMState{
+							{
+								Name:        "hot",
+								Actions:     []ISMAction{},
+								Transitions: []ISMTransition{{StateName: "delete", Conditions: &ISMConditions{MinIndexAge: "1d"}}},
+							},
+							{
+								Name:        "delete",
+								Actions:     []ISMAction{{Retry: retry, Delete: &ISMEmptyAction{}}},
+								Transitions: []ISMTransition{},
+							},
+						},
+					},
+				}},
+				TotalPolicies: 1,
+			}},
+		{"DeletePolicy", func(tp Transport) (*Response, interface{}, error) {
+			return ISMDeletePolicyRequest{PolicyID: "policy_1"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_ism/policies/policy_1", "",
+			"ism/delete_policy.json", &ISMDeletePolicyResp{
+				Index: ".opendistro-ism-config", ID: "policy_1", Version: 3, Result: "deleted",
+				Shards: ShardsInfo{Total: 2, Successful: 2}, SeqNo: 15, PrimaryTerm: 1,
+			}},
+		{"AddPolicy", func(tp Transport) (*Response, interface{}, error) {
+			return ISMAddPolicyRequest{Index: []string{"logs-1", "logs-2"}, Body: body(`{"policy_id":"policy_1"}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_ism/add/logs-1,logs-2", `{"policy_id":"policy_1"}`,
+			"ism/failed_indices.json", &ISMIndicesResp{
+				UpdatedIndices: 1,
+				Failures:       true,
+				FailedIndices: []ISMFailedIndex{{
+					IndexName: "logs-2",
+					IndexUUID: "gCFlS_zcTdih8xyxf3jQ-A",
+					Reason:    "This index already has a policy, use the update policy API to update index policies",
+				}},
+			}},
+		{"RemovePolicy", func(tp Transport) (*Response, interface{}, error) {
+			return ISMRemovePolicyRequest{Index: []string{"logs-*"}}.Do(ctx, tp)
+		}, "POST", "/_plugins/_ism/remove/logs-*", "",
+			"ism/updated_indices.json", updated},
+		{"ChangePolicy", func(tp Transport) (*Response, interface{}, error) {
+			return ISMChangePolicyRequest{Index: []string{"logs-*"}, Body: body(`{"policy_id":"policy_2"}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_ism/change_policy/logs-*", `{"policy_id":"policy_2"}`,
+			"ism/updated_indices.json", updated},
+		{"Retry", func(tp Transport) (*Response, interface{}, error) {
+			return ISMRetryRequest{Index: []string{"logs-1"}, Body: body(`{"state":"delete"}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_ism/retry/logs-1", `{"state":"delete"}`,
+			"ism/updated_indices.json", updated},
+		{"Explain", func(tp Transport) (*Response, interface{}, error) {
+			return ISMExplainRequest{Index: []string{"index_1", "index_2"}}.Do(ctx, tp)
+		}, "GET", "/_plugins/_ism/explain/index_1,index_2", "",
+			"ism/explain.json", &ISMExplainResp{
+				Indices: map[string]ISMExplainIndex{
+					"index_1": {
+						PolicyID:          &policyID,
+						Index:             "index_1",
+						IndexUUID:         "gCFlS_zcTdih8xyxf3jQ-A",
+						Enabled:           &enabled,
+						PolicyPrimaryTerm: 1,
+						IndexCreationDate: 1662540116123,
+						State:             &ISMExplainState{Name: "ingest", StartTime: 1662540116338},
+						Action:            &ISMExplainAction{Name: "rollover", StartTime: 1662540116338},
+						Step:              &ISMExplainStep{Name: "attempt_rollover", StartTime: 1662540116338, StepStatus: "condition_not_met"},
+						RetryInfo:         &ISMExplainRetryInfo{},
+						Info:              map[string]interface{}{"message": "Pending rollover of index [index=index_1]"},
+					},
+					"index_2": {},
+				},
+				TotalManagedIndices: 1,
+			}},
+	})
+
+	t.Run("Optimistic concurrency", func(t *testing.T) {
+		tp := &recordingTransport{}
+		api := New(tp)
+
+		_, _, err := api.ISM.PutPolicy(
+			api.ISM.PutPolicy.WithPolicyID("logs"),
+			api.ISM.PutPolicy.WithBody(strings.NewReader(`{"policy":{}}`)),
+			api.ISM.PutPolicy.WithIfSeqNo(7),
+			api.ISM.PutPolicy.WithIfPrimaryTerm(1),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if q := tp.req.URL.Query(); q.Get("if_seq_no") != "7" || q.Get("if_primary_term") != "1" {
+			t.Errorf("Unexpected query: %s", tp.req.URL.RawQuery)
+		}
+	})
+
+	t.Run("Policy", func(t *testing.T) {
+		policy := ISMPolicy{
+			Description:  "Rolls over and deletes the logs",
+			DefaultState: "hot",
+			States: []ISMState{
+				{
+					Name:        "hot",
+					Actions:     []ISMAction{{Rollover: &ISMRolloverAction{MinSize: "50gb"}}},
+					Transitions: []ISMTransition{{StateName: "delete", Conditions: &ISMConditions{MinIndexAge: "30d"}}},
+				},
+				{
+					Name:        "delete",
+					Actions:     []ISMAction{{Retry: &ISMActionRetry{Count: 3}, Delete: &ISMEmptyAction{}}},
+					Transitions: []ISMTransition{},
+				},
+			},
+			ISMTemplate: []ISMTemplate{{IndexPatterns: []string{"logs-*"}, Priority: 100}},
+		}
+		if err := policy.Validate(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		b, err := json.Marshal(ISMPolicyBody{Policy: policy})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expected := `{"policy":{"description":"Rolls over and deletes the logs","default_state":"hot","states":[` +
+			`{"name":"hot","actions":[{"rollover":{"min_size":"50gb"}}],"transitions":[{"state_name":"delete","conditions":{"min_index_age":"30d"}}]},` +
+			`{"name":"delete","actions":[{"retry":{"count":3},"delete":{}}],"transitions":[]}],` +
+			`"ism_template":[{"index_patterns":["logs-*"],"priority":100}]}}`
+		if string(b) != expected {
+			t.Errorf("Unexpected body:\n%s\nwant:\n%s", b, expected)
+		}
+
+		var decoded ISMPolicyBody
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(decoded.Policy, policy) {
+			t.Errorf("Unexpected policy: %+v", decoded.Policy)
+		}
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		policy := `{"policy_id":"logs","description":"Notifies, rolls up and aliases the logs",` +
+			`"last_updated_time":1700000000000,"schema_version":17,"default_state":"hot","states":[` +
+			`{"name":"hot","actions":[{"retry":{"count":3,"backoff":"exponential","delay":"1m"},` +
+			`"notification":{"channel":{"id":"ops"},"message_template":{"source":"{{ctx.index}} is hot","lang":"mustache"}}},` +
+			`{"notification":{"destination":{"slack":{"url":"https://hooks.slack.com/services/x"}},"message_template":{"source":"hot"}}}],` +
+			`"transitions":[{"state_name":"warm","conditions":{"min_index_age":"1d"}}]},` +
+			`{"name":"warm","actions":[{"rollup":{"ism_rollup":{"description":"Hourly","target_index":"logs-rollup","page_size":1000,` +
+			`"dimensions":[{"date_histogram":{"source_field":"timestamp","fixed_interval":"1h"}},{"terms":{"source_field":"host"}}],` +
+			`"metrics":[{"source_field":"bytes","metrics":[{"sum":{}},{"max":{}}]}]}}},` +
+			`{"alias":{"actions":[{"add":{"aliases":["logs-warm"]}},{"remove":{"alias":"logs-hot"}}]}}],"transitions":[]}],` +
+			`"ism_template":[{"index_patterns":["logs-*"],"priority":100,"last_updated_time":1700000000000}]}`
+
+		tp := &recordingTransport{body: `{"_id":"logs","_version":2,"_seq_no":5,"_primary_term":1,"policy":` + policy + `}`}
+		_, data, err := ISMGetPolicyRequest{PolicyID: "logs"}.Do(ctx, tp)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := data.Policy.Validate(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if n := data.Policy.States[0].Actions[0].Notification; n == nil || n.Channel.ID != "ops" {
+			t.Errorf("Unexpected notification action: %+v", n)
+		}
+
+		b, err := json.Marshal(ISMPolicyBody{Policy: data.Policy})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		var got, want interface{}
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := json.Unmarshal([]byte(`{"policy":`+policy+`}`), &want); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Unexpected body:\n%s\nwant:\n{\"policy\":%s}", b, policy)
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		state := func(name string, a ISMAction, to ...string) ISMState {
+			s := ISMState{Name: name, Actions: []ISMAction{a}}
+			for _, n := range to {
+				s.Transitions = append(s.Transitions, ISMTransition{StateName: n})
+			}
+			return s
+		}
+		readOnly := ISMAction{ReadOnly: &ISMEmptyAction{}}
+
+		tests := []struct {
+			name   string
+			policy ISMPolicy
+			err    string
+		}{
+			{"no default state", ISMPolicy{States: []ISMState{state("hot", readOnly)}}, "default state is required"},
+			{"unknown default state", ISMPolicy{DefaultState: "warm", States: []ISMState{state("hot", readOnly)}},
+				`default state "warm" does not exist`},
+			{"duplicate state", ISMPolicy{DefaultState: "hot", States: []ISMState{state("hot", readOnly), state("hot", readOnly)}},
+				`duplicate state "hot"`},
+			{"unknown transition", ISMPolicy{DefaultState: "hot", States: []ISMState{state("hot", readOnly, "cold")}},
+				`state "hot": transition to unknown state "cold"`},
+			{"no action type", ISMPolicy{DefaultState: "hot", States: []ISMState{state("hot", ISMAction{Timeout: "1h"})}},
+				`state "hot": action 0: no action type`},
+			{"two action types", ISMPolicy{DefaultState: "hot", States: []ISMState{
+				state("hot", ISMAction{ReadOnly: &ISMEmptyAction{}, Delete: &ISMEmptyAction{}})}},
+				"more than one action type: [delete read_only]"},
+			{"force merge", ISMPolicy{DefaultState: "hot", States: []ISMState{
+				state("hot", ISMAction{ForceMerge: &ISMForceMergeAction{}})}},
+				"max_num_segments must be positive"},
+			{"shrink", ISMPolicy{DefaultState: "hot", States: []ISMState{
+				state("hot", ISMAction{Shrink: &ISMShrinkAction{NumNewShards: 1, MaxShardSize: "10gb"}})}},
+				"shrink: exactly one of"},
+			{"snapshot", ISMPolicy{DefaultState: "hot", States: []ISMState{
+				state("hot", ISMAction{Snapshot: &ISMSnapshotAction{Repository: "backups"}})}},
+				"repository and snapshot are required"},
+			{"notification", ISMPolicy{DefaultState: "hot", States: []ISMState{
+				state("hot", ISMAction{Notification: &ISMNotificationAction{MessageTemplate: &ISMMessageTemplate{Source: "hot"}}})}},
+				"notification: destination or channel is required"},
+			{"rollup", ISMPolicy{DefaultState: "hot", States: []ISMState{
+				state("hot", ISMAction{Rollup: &ISMRollupAction{}})}},
+				"rollup: target_index is required"},
+			{"alias", ISMPolicy{DefaultState: "hot", States: []ISMState{
+				state("hot", ISMAction{Alias: &ISMAliasAction{Actions: []ISMAliasOperation{{}}}})}},
+				"alias: exactly one of add and remove is required"},
+			{"template", ISMPolicy{DefaultState: "hot", States: []ISMState{state("hot", readOnly)}, ISMTemplate: []ISMTemplate{{}}},
+				"ism template requires index patterns"},
+		}
+
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				err := tt.policy.Validate()
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Errorf("Unexpected error: %v, want: %q", err, tt.err)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newKNNGetModelFunc(t Transport) KNNGetModel {
+	return func(o ...func(*KNNGetModelRequest)) (*Response, *KNNModel, error) {
+		var r = KNNGetModelRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// KNNGetModel returns a model.
+type KNNGetModel func(o ...func(*KNNGetModelRequest)) (*Response, *KNNModel, error)
+
+// KNNGetModelRequest configures the k-NN Get Model API request.
+type KNNGetModelRequest struct {
+	ModelID string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, KNNModel and error.
+func (r KNNGetModelRequest) Do(ctx context.Context, transport Transport) (*Response, *KNNModel, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data KNNModel
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_knn/models") + 1 + len(r.ModelID))
+	path.WriteString("/_plugins/_knn/models")
+	path.WriteString("/")
+	path.WriteString(r.ModelID)
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f KNNGetModel) WithContext(v context.Context) func(*KNNGetModelRequest) {
+	return func(r *KNNGetModelRequest) {
+		r.ctx = v
+	}
+}
+
+// WithModelID - the ID of the model.
+func (f KNNGetModel) WithModelID(v string) func(*KNNGetModelRequest) {
+	return func(r *KNNGetModelRequest) {
+		r.ModelID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f KNNGetModel) WithPretty() func(*KNNGetModelRequest) {
+	return func(r *KNNGetModelRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f KNNGetModel) WithHuman() func(*KNNGetModelRequest) {
+	return func(r *KNNGetModelRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f KNNGetModel) WithErrorTrace() func(*KNNGetModelRequest) {
+	return func(r *KNNGetModelRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f KNNGetModel) WithFilterPath(v ...string) func(*KNNGetModelRequest) {
+	return func(r *KNNGetModelRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f KNNGetModel) WithHeader(h map[string]string) func(*KNNGetModelRequest) {
+	return func(r *KNNGetModelRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f KNNGetModel) WithOpaqueID(s string) func(*KNNGetModelRequest) {
+	return func(r *KNNGetModelRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
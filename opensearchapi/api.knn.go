@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import "fmt"
+
+// The engines of the k-NN methods.
+const (
+	KNNEngineNmslib = "nmslib"
+	KNNEngineFaiss  = "faiss"
+	KNNEngineLucene = "lucene"
+)
+
+// The space types of the k-NN methods, which define the distance between vectors.
+const (
+	KNNSpaceL2           = "l2"
+	KNNSpaceL1           = "l1"
+	KNNSpaceLinf         = "linf"
+	KNNSpaceCosineSimil  = "cosinesimil"
+	KNNSpaceInnerProduct = "innerproduct"
+	KNNSpaceHamming      = "hammingbit"
+)
+
+// KNNIndexBody is the body of the Indices Create API for an index with knn_vector fields, eg.:
+//
+//	KNNIndexBody{
+//		Settings: KNNIndexSettings{KNN: true},
+//		Mappings: KNNIndexMappings{Properties: map[string]interface{}{
+//			"embedding": NewKNNVectorMapping(768, NewKNNHNSWMethod(KNNEngineFaiss, KNNSpaceL2, 16, 128)),
+//		}},
+//	}
+type KNNIndexBody struct {
+	Settings KNNIndexSettings `json:"settings"`
+	Mappings KNNIndexMappings `json:"mappings"`
+}
+
+// KNNIndexSettings represents the settings of an index with knn_vector fields;
+// KNN must be true for the approximate search.
+type KNNIndexSettings struct {
+	KNN              bool `json:"index.knn"`
+	EfSearch         int  `json:"index.knn.algo_param.ef_search,omitempty"`
+	NumberOfShards   int  `json:"index.number_of_shards,omitempty"`
+	NumberOfReplicas *int `json:"index.number_of_replicas,omitempty"`
+}
+
+// KNNIndexMappings represents the mappings of an index with knn_vector fields, by field name.
+type KNNIndexMappings struct {
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// KNNVectorMapping represents the mapping of a knn_vector field,
+// either with a method or with the ID of a trained model.
+type KNNVectorMapping struct {
+	Type      string     `json:"type"`
+	Dimension int        `json:"dimension,omitempty"`
+	DataType  string     `json:"data_type,omitempty"`
+	Method    *KNNMethod `json:"method,omitempty"`
+	ModelID   string     `json:"model_id,omitempty"`
+}
+
+// NewKNNVectorMapping returns the mapping of a knn_vector field with the dimension and the method.
+func NewKNNVectorMapping(dimension int, method KNNMethod) KNNVectorMapping {
+	return KNNVectorMapping{Type: "knn_vector", Dimension: dimension, Method: &method}
+}
+
+// NewKNNVectorModelMapping returns the mapping of a knn_vector field with a trained model.
+func NewKNNVectorModelMapping(modelID string) KNNVectorMapping {
+	return KNNVectorMapping{Type: "knn_vector", ModelID: modelID}
+}
+
+// KNNMethod represents the method of a knn_vector field or of a model, eg. "hnsw" or "ivf".
+type KNNMethod struct {
+	Name       string                 `json:"name"`
+	Engine     string                 `json:"engine,omitempty"`
+	SpaceType  string                 `json:"space_type,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// NewKNNHNSWMethod returns the hnsw method; m and efConstruction are omitted when zero.
+func NewKNNHNSWMethod(engine, spaceType string, m, efConstruction int) KNNMethod {
+	method := KNNMethod{Name: "hnsw", Engine: engine, SpaceType: spaceType}
+	if m > 0 || efConstruction > 0 {
+		method.Parameters = make(map[string]interface{})
+		if m > 0 {
+			method.Parameters["m"] = m
+		}
+		if efConstruction > 0 {
+			method.Parameters["ef_construction"] = efConstruction
+		}
+	}
+	return method
+}
+
+// KNNScoreToDistance converts the score of a k-NN hit to the distance between the vectors in the space,
+// eg. the squared euclidean distance for l2, or 1 - cos for cosinesimil. The inner product space returns
+// the negated inner product. Use an empty engine for the scores of the exact search with the knn_score script.
+func KNNScoreToDistance(engine, spaceType string, score float64) (float64, error) {
+	if score <= 0 {
+		return 0, fmt.Errorf("invalid k-NN score: %v", score)
+	}
+
+	switch spaceType {
+	case KNNSpaceL2, KNNSpaceL1, KNNSpaceLinf, KNNSpaceHamming:
+		return 1/score - 1, nil
+	case KNNSpaceCosineSimil:
+		if engine == KNNEngineLucene {
+			return 2 - 2*score, nil
+		}
+		return 2 - score, nil
+	case KNNSpaceInnerProduct:
+		if score >= 1 {
+			return 1 - score, nil
+		}
+		return 1/score - 1, nil
+	default:
+		return 0, fmt.Errorf("unknown k-NN space type: %q", spaceType)
+	}
+}
+
+// KNNStatsResp is the response of the k-NN Stats API.
+type KNNStatsResp struct {
+	NodesInfo struct {
+		Total      int `json:"total"`
+		Successful int `json:"successful"`
+		Failed     int `json:"failed"`
+	} `json:"_nodes"`
+	ClusterName             string                  `json:"cluster_name"`
+	CircuitBreakerTriggered bool                    `json:"circuit_breaker_triggered"`
+	ModelIndexStatus        *string                 `json:"model_index_status"`
+	Nodes                   map[string]KNNNodeStats `json:"nodes"`
+}
+
+// KNNNodeStats represents the k-NN statistics of a node.
+type KNNNodeStats struct {
+	GraphMemoryUsage           int64                         `json:"graph_memory_usage"`
+	GraphMemoryUsagePercentage float64                       `json:"graph_memory_usage_percentage"`
+	GraphQueryRequests         int64                         `json:"graph_query_requests"`
+	GraphQueryErrors           int64                         `json:"graph_query_errors"`
+	GraphIndexRequests         int64                         `json:"graph_index_requests"`
+	GraphIndexErrors           int64                         `json:"graph_index_errors"`
+	KNNQueryRequests           int64                         `json:"knn_query_requests"`
+	ScriptQueryRequests        int64                         `json:"script_query_requests"`
+	ScriptQueryErrors          int64                         `json:"script_query_errors"`
+	CacheCapacityReached       bool                          `json:"cache_capacity_reached"`
+	HitCount                   int64                         `json:"hit_count"`
+	MissCount                  int64                         `json:"miss_count"`
+	EvictionCount              int64                         `json:"eviction_count"`
+	TotalLoadTime              int64                         `json:"total_load_time"`
+	LoadSuccessCount           int64                         `json:"load_success_count"`
+	LoadExceptionCount         int64                         `json:"load_exception_count"`
+	TrainingRequests           int64                         `json:"training_requests"`
+	TrainingErrors             int64                         `json:"training_errors"`
+	TrainingMemoryUsage        int64                         `json:"training_memory_usage"`
+	IndicesInCache             map[string]KNNIndexCacheStats `json:"indices_in_cache"`
+}
+
+// KNNIndexCacheStats represents the native library memory used by an index.
+type KNNIndexCacheStats struct {
+	GraphMemoryUsage           int64   `json:"graph_memory_usage"`
+	GraphMemoryUsagePercentage float64 `json:"graph_memory_usage_percentage"`
+	GraphCount                 int     `json:"graph_count"`
+}
+
+// KNNWarmupResp is the response of the k-NN Warmup API.
+type KNNWarmupResp struct {
+	Shards ShardsInfo `json:"_shards"`
+}
+
+// KNNTrainModelBody is the body of the k-NN Train Model API.
+type KNNTrainModelBody struct {
+	TrainingIndex          string    `json:"training_index"`
+	TrainingField          string    `json:"training_field"`
+	Dimension              int       `json:"dimension"`
+	MaxTrainingVectorCount int       `json:"max_training_vector_count,omitempty"`
+	SearchSize             int       `json:"search_size,omitempty"`
+	Description            string    `json:"description,omitempty"`
+	Method                 KNNMethod `json:"method"`
+}
+
+// KNNTrainModelResp is the response of the k-NN Train Model API; the model is trained in the background.
+type KNNTrainModelResp struct {
+	ModelID string `json:"model_id"`
+}
+
+// KNNModel is the response of the k-NN Get Model API; State is "training", "created" or "failed".
+type KNNModel struct {
+	ModelID                string `json:"model_id"`
+	ModelBlob              string `json:"model_blob,omitempty"`
+	State                  string `json:"state"`
+	Timestamp              string `json:"timestamp"`
+	Description            string `json:"description"`
+	Error                  string `json:"error"`
+	SpaceType              string `json:"space_type"`
+	Dimension              int    `json:"dimension"`
+	Engine                 string `json:"engine"`
+	TrainingNodeAssignment string `json:"training_node_assignment,omitempty"`
+}
+
+// KNNDeleteModelResp is the response of the k-NN Delete Model API.
+type KNNDeleteModelResp struct {
+	ModelID string `json:"model_id"`
+	Result  string `json:"result"`
+}
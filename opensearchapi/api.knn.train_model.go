@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newKNNTrainModelFunc(t Transport) KNNTrainModel {
+	return func(o ...func(*KNNTrainModelRequest)) (*Response, *KNNTrainModelResp, error) {
+		var r = KNNTrainModelRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// KNNTrainModel trains a model for the k-NN methods which require training, such as IVF.
+type KNNTrainModel func(o ...func(*KNNTrainModelRequest)) (*Response, *KNNTrainModelResp, error)
+
+// KNNTrainModelRequest configures the k-NN Train Model API request.
+type KNNTrainModelRequest struct {
+	ModelID string
+
+	Body io.Reader
+
+	Preference string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, KNNTrainModelResp and error.
+func (r KNNTrainModelRequest) Do(ctx context.Context, transport Transport) (*Response, *KNNTrainModelResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data KNNTrainModelResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_knn/models") + 1 + len(r.ModelID) + len("/_train"))
+	path.WriteString("/_plugins/_knn/models")
+	if r.ModelID != "" {
+		path.WriteString("/")
+		path.WriteString(r.ModelID)
+	}
+	path.WriteString("/_train")
+
+	params = make(map[string]string)
+
+	if r.Preference != "" {
+		params["preference"] = r.Preference
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f KNNTrainModel) WithContext(v context.Context) func(*KNNTrainModelRequest) {
+	return func(r *KNNTrainModelRequest) {
+		r.ctx = v
+	}
+}
+
+// WithModelID - the ID of the model; it is generated when empty.
+func (f KNNTrainModel) WithModelID(v string) func(*KNNTrainModelRequest) {
+	return func(r *KNNTrainModelRequest) {
+		r.ModelID = v
+	}
+}
+
+// WithBody - the training parameters, see KNNTrainModelBody.
+func (f KNNTrainModel) WithBody(v io.Reader) func(*KNNTrainModelRequest) {
+	return func(r *KNNTrainModelRequest) {
+		r.Body = v
+	}
+}
+
+// WithPreference - the node to train the model on.
+func (f KNNTrainModel) WithPreference(v string) func(*KNNTrainModelRequest) {
+	return func(r *KNNTrainModelRequest) {
+		r.Preference = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f KNNTrainModel) WithPretty() func(*KNNTrainModelRequest) {
+	return func(r *KNNTrainModelRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f KNNTrainModel) WithHuman() func(*KNNTrainModelRequest) {
+	return func(r *KNNTrainModelRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f KNNTrainModel) WithErrorTrace() func(*KNNTrainModelRequest) {
+	return func(r *KNNTrainModelRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f KNNTrainModel) WithFilterPath(v ...string) func(*KNNTrainModelRequest) {
+	return func(r *KNNTrainModelRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f KNNTrainModel) WithHeader(h map[string]string) func(*KNNTrainModelRequest) {
+	return func(r *KNNTrainModelRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f KNNTrainModel) WithOpaqueID(s string) func(*KNNTrainModelRequest) {
+	return func(r *KNNTrainModelRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newKNNWarmupFunc(t Transport) KNNWarmup {
+	return func(o ...func(*KNNWarmupRequest)) (*Response, *KNNWarmupResp, error) {
+		var r = KNNWarmupRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// KNNWarmup loads the native library indices of the indices into memory.
+type KNNWarmup func(o ...func(*KNNWarmupRequest)) (*Response, *KNNWarmupResp, error)
+
+// KNNWarmupRequest configures the k-NN Warmup API request.
+type KNNWarmupRequest struct {
+	Index []string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, KNNWarmupResp and error.
+func (r KNNWarmupRequest) Do(ctx context.Context, transport Transport) (*Response, *KNNWarmupResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data KNNWarmupResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_knn/warmup") + 1 + len(strings.Join(r.Index, ",")))
+	path.WriteString("/_plugins/_knn/warmup")
+	path.WriteString("/")
+	path.WriteString(strings.Join(r.Index, ","))
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f KNNWarmup) WithContext(v context.Context) func(*KNNWarmupRequest) {
+	return func(r *KNNWarmupRequest) {
+		r.ctx = v
+	}
+}
+
+// WithIndex - the indices to warm up.
+func (f KNNWarmup) WithIndex(v ...string) func(*KNNWarmupRequest) {
+	return func(r *KNNWarmupRequest) {
+		r.Index = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f KNNWarmup) WithPretty() func(*KNNWarmupRequest) {
+	return func(r *KNNWarmupRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f KNNWarmup) WithHuman() func(*KNNWarmupRequest) {
+	return func(r *KNNWarmupRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f KNNWarmup) WithErrorTrace() func(*KNNWarmupRequest) {
+	return func(r *KNNWarmupRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f KNNWarmup) WithFilterPath(v ...string) func(*KNNWarmupRequest) {
+	return func(r *KNNWarmupRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f KNNWarmup) WithHeader(h map[string]string) func(*KNNWarmupRequest) {
+	return func(r *KNNWarmupRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f KNNWarmup) WithOpaqueID(s string) func(*KNNWarmupRequest) {
+	return func(r *KNNWarmupRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestKNN(t *testing.T) {
+	ctx := context.Background()
+	body := func(s string) io.Reader { return strings.NewReader(s) }
+
+	yellow := "YELLOW"
+	stats := &KNNStatsResp{
+		ClusterName:      "my-cluster",
+		ModelIndexStatus: &yellow,
+		Nodes: map[string]KNNNodeStats{"JdfxIkOS1-43UxqNz98nw": {
+			GraphMemoryUsage:           2,
+			GraphMemoryUsagePercentage: 3.68,
+			GraphQueryRequests:         1420920,
+			GraphIndexRequests:         656,
+			KNNQueryRequests:           147092,
+			HitCount:                   1420775,
+			MissCount:                  179,
+			TotalLoadTime:              2436679306,
+			LoadSuccessCount:           179,
+			TrainingRequests:           17,
+			IndicesInCache: map[string]KNNIndexCacheStats{
+				"myindex": {GraphMemoryUsage: 2, GraphMemoryUsagePercentage: 3.68, GraphCount: 2},
+			},
+		}},
+	}
+	stats.NodesInfo.Total, stats.NodesInfo.Successful = 1, 1
+
+	hitCount := &KNNStatsResp{
+		ClusterName: "my-cluster",
+		Nodes:       map[string]KNNNodeStats{"n1": {HitCount: 1420775}, "n2": {}},
+	}
+	hitCount.NodesInfo.Total, hitCount.NodesInfo.Successful = 2, 2
+
+	score := 1.0
+
+	testPlugin(t, pluginError{404, "knn/error.json"}, []pluginTest{
+		{"Stats", func(tp Transport) (*Response, interface{}, error) { return KNNStatsRequest{}.Do(ctx, tp) },
+			"GET", "/_plugins/_knn/stats", "",
+			"knn/stats.json", stats},
+		{"Stats of nodes", func(tp Transport) (*Response, interface{}, error) {
+			return KNNStatsRequest{NodeID: []string{"n1", "n2"}, Stat: []string{"hit_count"}}.Do(ctx, tp)
+		}, "GET", "/_plugins/_knn/n1,n2/stats/hit_count", "",
+			"knn/stats_hit_count.json", hitCount},
+		{"Warmup", func(tp Transport) (*Response, interface{}, error) {
+			return KNNWarmupRequest{Index: []string{"myindex"}}.Do(ctx, tp)
+		}, "GET", "/_plugins/_knn/warmup/myindex", "",
+			"knn/warmup.json", &KNNWarmupResp{Shards: ShardsInfo{Total: 6, Successful: 6}}},
+		{"TrainModel", func(tp Transport) (*Response, interface{}, error) {
+			return KNNTrainModelRequest{ModelID: "my-model", Body: body(`{"dimension":2}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_knn/models/my-model/_train", `{"dimension":2}`,
+			"knn/train_model.json", &KNNTrainModelResp{ModelID: "my-model"}},
+		{"TrainModel without ID", func(tp Transport) (*Response, interface{}, error) {
+			return KNNTrainModelRequest{Body: body(`{"dimension":2}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_knn/models/_train", `{"dimension":2}`,
+			"knn/train_model.json", &KNNTrainModelResp{ModelID: "my-model"}},
+		{"GetModel", func(tp Transport) (*Response, interface{}, error) {
+			return KNNGetModelRequest{ModelID: "my-model"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_knn/models/my-model", "",
+			"knn/get_model.json", &KNNModel{
+				ModelID:     "my-model",
+				ModelBlob:   "SXdGbIAAAAAAAAAAAA",
+				State:       "created",
+				Timestamp:   "2021-11-15T18:45:07.505369036Z",
+				Description: "Default",
+				SpaceType:   KNNSpaceL2,
+				Dimension:   128,
+				Engine:      KNNEngineFaiss,
+			}},
+		{"DeleteModel", func(tp Transport) (*Response, interface{}, error) {
+			return KNNDeleteModelRequest{ModelID: "my-model"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_knn/models/my-model", "",
+			"knn/delete_model.json", &KNNDeleteModelResp{ModelID: "my-model", Result: "deleted"}},
+		{"SearchModels", func(tp Transport) (*Response, interface{}, error) {
+			return KNNSearchModelsRequest{Body: body(`{"query":{"match_all":{}}}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_knn/models/_search", `{"query":{"match_all":{}}}`,
+			"knn/search_models.json", &SearchResponse{
+				Shards: ShardsInfo{Total: 1, Successful: 1},
+				Hits: SearchHits{
+					Total:    &TotalHits{Value: 1, Relation: "eq"},
+					MaxScore: &score,
+					Hits: []SearchHit{{
+						Index: ".opensearch-knn-models",
+						ID:    "my-model",
+						Score: &score,
+						Source: json.RawMessage(`{"engine": "faiss", "space_type": "l2", "description": "Default", "model_blob": "", ` +
+							`"error": "", "dimension": 128, "state": "created", "timestamp": "2021-11-15T18:45:07.505369036Z"}`),
+					}},
+				},
+			}},
+	})
+
+	t.Run("Index body", func(t *testing.T) {
+		b, err := json.Marshal(KNNIndexBody{
+			Settings: KNNIndexSettings{KNN: true, EfSearch: 100},
+			Mappings: KNNIndexMappings{Properties: map[string]interface{}{
+				"embedding": NewKNNVectorMapping(3, NewKNNHNSWMethod(KNNEngineFaiss, KNNSpaceL2, 16, 0)),
+				"trained":   NewKNNVectorModelMapping("ivf"),
+			}},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expected := `{"settings":{"index.knn":true,"index.knn.algo_param.ef_search":100},"mappings":{"properties":{` +
+			`"embedding":{"type":"knn_vector","dimension":3,"method":{"name":"hnsw","engine":"faiss","space_type":"l2","parameters":{"m":16}}},` +
+			`"trained":{"type":"knn_vector","model_id":"ivf"}}}}`
+		if string(b) != expected {
+			t.Errorf("Unexpected body:\n%s\nwant:\n%s", b, expected)
+		}
+	})
+
+	t.Run("Score to distance", func(t *testing.T) {
+		tests := []struct {
+			engine, space string
+			score, want   float64
+		}{
+			{KNNEngineFaiss, KNNSpaceL2, 0.2, 4},
+			{KNNEngineNmslib, KNNSpaceL1, 1, 0},
+			{KNNEngineNmslib, KNNSpaceCosineSimil, 1.5, 0.5},
+			{KNNEngineLucene, KNNSpaceCosineSimil, 0.75, 0.5},
+			{"", KNNSpaceCosineSimil, 1.5, 0.5},
+			{KNNEngineFaiss, KNNSpaceInnerProduct, 3, -2},
+			{KNNEngineFaiss, KNNSpaceInnerProduct, 0.25, 3},
+		}
+		for _, tt := range tests {
+			d, err := KNNScoreToDistance(tt.engine, tt.space, tt.score)
+			if err != nil || math.Abs(d-tt.want) > 1e-9 {
+				t.Errorf("%s %s %v: unexpected distance: %v, %v, want: %v", tt.engine, tt.space, tt.score, d, err, tt.want)
+			}
+		}
+
+		if _, err := KNNScoreToDistance(KNNEngineFaiss, "dot", 1); err == nil {
+			t.Errorf("Expected error for unknown space type")
+		}
+		if _, err := KNNScoreToDistance(KNNEngineFaiss, KNNSpaceL2, 0); err == nil {
+			t.Errorf("Expected error for invalid score")
+		}
+	})
+}
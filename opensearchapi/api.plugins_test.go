@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// recordingTransport records the request and returns a response with the status and body,
+// or the error when set.
+type recordingTransport struct {
+	status int
+	body   string
+	err    error
+
+	req     *http.Request
+	reqBody string
+}
+
+func (t *recordingTransport) Perform(req *http.Request) (*http.Response, error) {
+	t.req = req
+	t.reqBody = ""
+	if req.Body != nil {
+		b, _ := io.ReadAll(req.Body)
+		t.reqBody = string(b)
+	}
+	if t.err != nil {
+		return nil, t.err
+	}
+
+	status := t.status
+	if status == 0 {
+		status = 200
+	}
+	body := t.body
+	if body == "" {
+		body = "{}"
+	}
+	return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+// fixture returns the content of the file in testdata, eg. a response of a plugin.
+func fixture(t *testing.T, name string) string {
+	t.Helper()
+
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return string(b)
+}
+
+// pluginTest is a request of a plugin API with a typed response.
+type pluginTest struct {
+	name   string
+	do     func(Transport) (*Response, interface{}, error)
+	method string
+	path   string
+	body   string
+
+	// response is the fixture with the response of the plugin, and want its typed response.
+	response string
+	want     interface{}
+}
+
+// pluginError is an error response of a plugin.
+type pluginError struct {
+	status   int
+	response string
+}
+
+// testPlugin runs the requests against a recordingTransport, and checks their method, path and body,
+// and the typed response decoded from the fixture.
+// The requests are run as well with the error response, an invalid body and a transport error,
+// which must return an error and no typed response.
+func testPlugin(t *testing.T, errResp pluginError, tests []pluginTest) {
+	t.Helper()
+
+	isNil := func(v interface{}) bool { return v == nil || reflect.ValueOf(v).IsNil() }
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tp := &recordingTransport{body: fixture(t, tt.response)}
+			_, data, err := tt.do(tp)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if tp.req.Method != tt.method || tp.req.URL.Path != tt.path {
+				t.Errorf("Unexpected request: %s %s, want: %s %s", tp.req.Method, tp.req.URL.Path, tt.method, tt.path)
+			}
+			if tp.reqBody != tt.body {
+				t.Errorf("Unexpected body: %s, want: %s", tp.reqBody, tt.body)
+			}
+			if tt.body != "" && tp.req.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("Unexpected content type: %q", tp.req.Header.Get("Content-Type"))
+			}
+			if !reflect.DeepEqual(data, tt.want) {
+				t.Errorf("Unexpected response:\n%#v\nwant:\n%#v", data, tt.want)
+			}
+
+			t.Run("Error", func(t *testing.T) {
+				tp := &recordingTransport{status: errResp.status, body: fixture(t, errResp.response)}
+				res, data, err := tt.do(tp)
+				if ErrorStatus(err) != errResp.status || res == nil || res.StatusCode != errResp.status || !isNil(data) {
+					t.Errorf("Unexpected result: %v, %v", data, err)
+				}
+			})
+
+			t.Run("Invalid response", func(t *testing.T) {
+				res, data, err := tt.do(&recordingTransport{body: `{"`})
+				if err == nil || res == nil || !isNil(data) {
+					t.Errorf("Unexpected result: %v, %v", data, err)
+				}
+			})
+
+			t.Run("Transport error", func(t *testing.T) {
+				mockErr := errors.New("MOCK ERROR")
+				res, data, err := tt.do(&recordingTransport{err: mockErr})
+				if !errors.Is(err, mockErr) || res != nil || !isNil(data) {
+					t.Errorf("Unexpected result: %v, %v", data, err)
+				}
+			})
+		})
+	}
+}
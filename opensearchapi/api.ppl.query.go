@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newPPLQueryFunc(t Transport) PPLQuery {
+	return func(o ...func(*PPLQueryRequest)) (*Response, error) {
+		var r = PPLQueryRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// PPLQuery runs a PPL query.
+type PPLQuery func(o ...func(*PPLQueryRequest)) (*Response, error)
+
+// PPLQueryRequest configures the PPL Query API request.
+type PPLQueryRequest struct {
+	Body io.Reader
+
+	Format string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response or error.
+func (r PPLQueryRequest) Do(ctx context.Context, transport Transport) (*Response, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_ppl"))
+	path.WriteString("/_plugins/_ppl")
+
+	params = make(map[string]string)
+
+	if r.Format != "" {
+		params["format"] = r.Format
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	return &response, nil
+}
+
+// WithContext sets the request context.
+func (f PPLQuery) WithContext(v context.Context) func(*PPLQueryRequest) {
+	return func(r *PPLQueryRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - the query, see SQLQueryBody.
+func (f PPLQuery) WithBody(v io.Reader) func(*PPLQueryRequest) {
+	return func(r *PPLQueryRequest) {
+		r.Body = v
+	}
+}
+
+// WithFormat - the response format: jdbc (default), csv, raw or json.
+func (f PPLQuery) WithFormat(v string) func(*PPLQueryRequest) {
+	return func(r *PPLQueryRequest) {
+		r.Format = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f PPLQuery) WithPretty() func(*PPLQueryRequest) {
+	return func(r *PPLQueryRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f PPLQuery) WithHuman() func(*PPLQueryRequest) {
+	return func(r *PPLQueryRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f PPLQuery) WithErrorTrace() func(*PPLQueryRequest) {
+	return func(r *PPLQueryRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f PPLQuery) WithFilterPath(v ...string) func(*PPLQueryRequest) {
+	return func(r *PPLQueryRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f PPLQuery) WithHeader(h map[string]string) func(*PPLQueryRequest) {
+	return func(r *PPLQueryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f PPLQuery) WithOpaqueID(s string) func(*PPLQueryRequest) {
+	return func(r *PPLQueryRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
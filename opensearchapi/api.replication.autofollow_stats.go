@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newReplicationAutofollowStatsFunc(t Transport) ReplicationAutofollowStats {
+	return func(o ...func(*ReplicationAutofollowStatsRequest)) (*Response, *ReplicationAutofollowStatsResp, error) {
+		var r = ReplicationAutofollowStatsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ReplicationAutofollowStats returns the statistics of the auto-follow rules.
+type ReplicationAutofollowStats func(o ...func(*ReplicationAutofollowStatsRequest)) (*Response, *ReplicationAutofollowStatsResp, error)
+
+// ReplicationAutofollowStatsRequest configures the Replication Autofollow Stats API request.
+type ReplicationAutofollowStatsRequest struct {
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, ReplicationAutofollowStatsResp and error.
+func (r ReplicationAutofollowStatsRequest) Do(ctx context.Context, transport Transport) (*Response, *ReplicationAutofollowStatsResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data ReplicationAutofollowStatsResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_replication/autofollow_stats"))
+	path.WriteString("/_plugins/_replication/autofollow_stats")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f ReplicationAutofollowStats) WithContext(v context.Context) func(*ReplicationAutofollowStatsRequest) {
+	return func(r *ReplicationAutofollowStatsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ReplicationAutofollowStats) WithPretty() func(*ReplicationAutofollowStatsRequest) {
+	return func(r *ReplicationAutofollowStatsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ReplicationAutofollowStats) WithHuman() func(*ReplicationAutofollowStatsRequest) {
+	return func(r *ReplicationAutofollowStatsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ReplicationAutofollowStats) WithErrorTrace() func(*ReplicationAutofollowStatsRequest) {
+	return func(r *ReplicationAutofollowStatsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ReplicationAutofollowStats) WithFilterPath(v ...string) func(*ReplicationAutofollowStatsRequest) {
+	return func(r *ReplicationAutofollowStatsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ReplicationAutofollowStats) WithHeader(h map[string]string) func(*ReplicationAutofollowStatsRequest) {
+	return func(r *ReplicationAutofollowStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ReplicationAutofollowStats) WithOpaqueID(s string) func(*ReplicationAutofollowStatsRequest) {
+	return func(r *ReplicationAutofollowStatsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
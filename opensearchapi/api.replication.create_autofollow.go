@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newReplicationCreateAutofollowFunc(t Transport) ReplicationCreateAutofollow {
+	return func(o ...func(*ReplicationCreateAutofollowRequest)) (*Response, *ReplicationAcknowledgedResp, error) {
+		var r = ReplicationCreateAutofollowRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ReplicationCreateAutofollow creates an auto-follow rule, which replicates the new leader indices matching its pattern.
+type ReplicationCreateAutofollow func(o ...func(*ReplicationCreateAutofollowRequest)) (*Response, *ReplicationAcknowledgedResp, error)
+
+// ReplicationCreateAutofollowRequest configures the Replication Create Autofollow API request.
+type ReplicationCreateAutofollowRequest struct {
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, ReplicationAcknowledgedResp and error.
+func (r ReplicationCreateAutofollowRequest) Do(ctx context.Context, transport Transport) (*Response, *ReplicationAcknowledgedResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data ReplicationAcknowledgedResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_replication/_autofollow"))
+	path.WriteString("/_plugins/_replication/_autofollow")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f ReplicationCreateAutofollow) WithContext(v context.Context) func(*ReplicationCreateAutofollowRequest) {
+	return func(r *ReplicationCreateAutofollowRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - the rule, see ReplicationAutofollowBody.
+func (f ReplicationCreateAutofollow) WithBody(v io.Reader) func(*ReplicationCreateAutofollowRequest) {
+	return func(r *ReplicationCreateAutofollowRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ReplicationCreateAutofollow) WithPretty() func(*ReplicationCreateAutofollowRequest) {
+	return func(r *ReplicationCreateAutofollowRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ReplicationCreateAutofollow) WithHuman() func(*ReplicationCreateAutofollowRequest) {
+	return func(r *ReplicationCreateAutofollowRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ReplicationCreateAutofollow) WithErrorTrace() func(*ReplicationCreateAutofollowRequest) {
+	return func(r *ReplicationCreateAutofollowRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ReplicationCreateAutofollow) WithFilterPath(v ...string) func(*ReplicationCreateAutofollowRequest) {
+	return func(r *ReplicationCreateAutofollowRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ReplicationCreateAutofollow) WithHeader(h map[string]string) func(*ReplicationCreateAutofollowRequest) {
+	return func(r *ReplicationCreateAutofollowRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ReplicationCreateAutofollow) WithOpaqueID(s string) func(*ReplicationCreateAutofollowRequest) {
+	return func(r *ReplicationCreateAutofollowRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
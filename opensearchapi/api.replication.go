@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import "encoding/json"
+
+// The statuses of the replication of a follower index.
+const (
+	ReplicationStatusBootstrapping = "BOOTSTRAPPING"
+	ReplicationStatusSyncing       = "SYNCING"
+	ReplicationStatusPaused        = "PAUSED"
+	ReplicationStatusFailed        = "FAILED"
+	ReplicationStatusNotInProgress = "REPLICATION NOT IN PROGRESS"
+)
+
+// ReplicationStartBody is the body of the Replication Start API.
+// The LeaderAlias is the name of the leader cluster in the remote cluster settings of the follower cluster.
+type ReplicationStartBody struct {
+	LeaderAlias string            `json:"leader_alias"`
+	LeaderIndex string            `json:"leader_index"`
+	UseRoles    *ReplicationRoles `json:"use_roles,omitempty"`
+}
+
+// ReplicationRoles represents the roles used to replicate, when the security plugin is enabled.
+type ReplicationRoles struct {
+	LeaderClusterRole   string `json:"leader_cluster_role"`
+	FollowerClusterRole string `json:"follower_cluster_role"`
+}
+
+// ReplicationAutofollowBody is the body of the Replication Create and Delete Autofollow APIs;
+// the Pattern and the UseRoles are only used on creation.
+type ReplicationAutofollowBody struct {
+	LeaderAlias string            `json:"leader_alias"`
+	Name        string            `json:"name"`
+	Pattern     string            `json:"pattern,omitempty"`
+	UseRoles    *ReplicationRoles `json:"use_roles,omitempty"`
+}
+
+// ReplicationAcknowledgedResp is the response of the Replication Start, Stop, Pause, Resume, Update Settings,
+// and Create and Delete Autofollow APIs.
+type ReplicationAcknowledgedResp struct {
+	Acknowledged bool `json:"acknowledged"`
+}
+
+// ReplicationStatusResp is the response of the Replication Status API.
+// The SyncingDetails are only returned while the index is syncing.
+type ReplicationStatusResp struct {
+	Status                  string                     `json:"status"`
+	Reason                  string                     `json:"reason,omitempty"`
+	LeaderAlias             string                     `json:"leader_alias,omitempty"`
+	LeaderIndex             string                     `json:"leader_index,omitempty"`
+	FollowerIndex           string                     `json:"follower_index,omitempty"`
+	SyncingDetails          *ReplicationSyncingDetails `json:"syncing_details,omitempty"`
+	ShardReplicationDetails json.RawMessage            `json:"shard_replication_details,omitempty"`
+}
+
+// ReplicationSyncingDetails represents the checkpoints of a syncing follower index.
+type ReplicationSyncingDetails struct {
+	LeaderCheckpoint   int64 `json:"leader_checkpoint"`
+	FollowerCheckpoint int64 `json:"follower_checkpoint"`
+	SeqNo              int64 `json:"seq_no"`
+}
+
+// Lag returns the number of operations of the leader index not yet replicated to the follower index,
+// or 0 when the index isn't syncing.
+func (r *ReplicationStatusResp) Lag() int64 {
+	if r.SyncingDetails == nil || r.SyncingDetails.LeaderCheckpoint < r.SyncingDetails.FollowerCheckpoint {
+		return 0
+	}
+	return r.SyncingDetails.LeaderCheckpoint - r.SyncingDetails.FollowerCheckpoint
+}
+
+// ReplicationLeaderStatsResp is the response of the Replication Leader Stats API.
+type ReplicationLeaderStatsResp struct {
+	NumReplicatedIndices int `json:"num_replicated_indices"`
+	ReplicationLeaderIndexStats
+	IndexStats map[string]ReplicationLeaderIndexStats `json:"index_stats"`
+}
+
+// ReplicationLeaderIndexStats represents the replication statistics of a leader index, or of all of them.
+type ReplicationLeaderIndexStats struct {
+	OperationsRead              int64 `json:"operations_read"`
+	TranslogSizeBytes           int64 `json:"translog_size_bytes"`
+	OperationsReadLucene        int64 `json:"operations_read_lucene"`
+	OperationsReadTranslog      int64 `json:"operations_read_translog"`
+	TotalReadTimeLuceneMillis   int64 `json:"total_read_time_lucene_millis"`
+	TotalReadTimeTranslogMillis int64 `json:"total_read_time_translog_millis"`
+	BytesRead                   int64 `json:"bytes_read"`
+}
+
+// ReplicationFollowerStatsResp is the response of the Replication Follower Stats API.
+type ReplicationFollowerStatsResp struct {
+	NumSyncingIndices       int `json:"num_syncing_indices"`
+	NumBootstrappingIndices int `json:"num_bootstrapping_indices"`
+	NumPausedIndices        int `json:"num_paused_indices"`
+	NumFailedIndices        int `json:"num_failed_indices"`
+	NumShardTasks           int `json:"num_shard_tasks"`
+	NumIndexTasks           int `json:"num_index_tasks"`
+	ReplicationFollowerIndexStats
+	IndexStats map[string]ReplicationFollowerIndexStats `json:"index_stats"`
+}
+
+// ReplicationFollowerIndexStats represents the replication statistics of a follower index, or of all of them.
+type ReplicationFollowerIndexStats struct {
+	OperationsWritten      int64 `json:"operations_written"`
+	OperationsRead         int64 `json:"operations_read"`
+	FailedReadRequests     int64 `json:"failed_read_requests"`
+	ThrottledReadRequests  int64 `json:"throttled_read_requests"`
+	FailedWriteRequests    int64 `json:"failed_write_requests"`
+	ThrottledWriteRequests int64 `json:"throttled_write_requests"`
+	FollowerCheckpoint     int64 `json:"follower_checkpoint"`
+	LeaderCheckpoint       int64 `json:"leader_checkpoint"`
+	TotalWriteTimeMillis   int64 `json:"total_write_time_millis"`
+}
+
+// ReplicationAutofollowStatsResp is the response of the Replication Autofollow Stats API.
+type ReplicationAutofollowStatsResp struct {
+	ReplicationAutofollowRuleStats
+	AutofollowStats []ReplicationAutofollowRuleStats `json:"autofollow_stats"`
+}
+
+// ReplicationAutofollowRuleStats represents the statistics of an auto-follow rule, or of all of them.
+// The Name and the Pattern are empty for all of them.
+type ReplicationAutofollowRuleStats struct {
+	Name                       string   `json:"name,omitempty"`
+	Pattern                    string   `json:"pattern,omitempty"`
+	NumSuccessStartReplication int64    `json:"num_success_start_replication"`
+	NumFailedStartReplication  int64    `json:"num_failed_start_replication"`
+	NumFailedLeaderCalls       int64    `json:"num_failed_leader_calls"`
+	FailedIndices              []string `json:"failed_indices"`
+}
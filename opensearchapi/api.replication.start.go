@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newReplicationStartFunc(t Transport) ReplicationStart {
+	return func(o ...func(*ReplicationStartRequest)) (*Response, *ReplicationAcknowledgedResp, error) {
+		var r = ReplicationStartRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ReplicationStart starts replicating a leader index to the follower index.
+type ReplicationStart func(o ...func(*ReplicationStartRequest)) (*Response, *ReplicationAcknowledgedResp, error)
+
+// ReplicationStartRequest configures the Replication Start API request.
+type ReplicationStartRequest struct {
+	Index string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, ReplicationAcknowledgedResp and error.
+func (r ReplicationStartRequest) Do(ctx context.Context, transport Transport) (*Response, *ReplicationAcknowledgedResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data ReplicationAcknowledgedResp
+	)
+	method := "PUT"
+
+	path.Grow(len("/_plugins/_replication") + 1 + len(r.Index) + len("/_start"))
+	path.WriteString("/_plugins/_replication")
+	path.WriteString("/")
+	path.WriteString(r.Index)
+	path.WriteString("/_start")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f ReplicationStart) WithContext(v context.Context) func(*ReplicationStartRequest) {
+	return func(r *ReplicationStartRequest) {
+		r.ctx = v
+	}
+}
+
+// WithIndex - the follower index.
+func (f ReplicationStart) WithIndex(v string) func(*ReplicationStartRequest) {
+	return func(r *ReplicationStartRequest) {
+		r.Index = v
+	}
+}
+
+// WithBody - the leader, see ReplicationStartBody.
+func (f ReplicationStart) WithBody(v io.Reader) func(*ReplicationStartRequest) {
+	return func(r *ReplicationStartRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ReplicationStart) WithPretty() func(*ReplicationStartRequest) {
+	return func(r *ReplicationStartRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ReplicationStart) WithHuman() func(*ReplicationStartRequest) {
+	return func(r *ReplicationStartRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ReplicationStart) WithErrorTrace() func(*ReplicationStartRequest) {
+	return func(r *ReplicationStartRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ReplicationStart) WithFilterPath(v ...string) func(*ReplicationStartRequest) {
+	return func(r *ReplicationStartRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ReplicationStart) WithHeader(h map[string]string) func(*ReplicationStartRequest) {
+	return func(r *ReplicationStartRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ReplicationStart) WithOpaqueID(s string) func(*ReplicationStartRequest) {
+	return func(r *ReplicationStartRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newReplicationStatusFunc(t Transport) ReplicationStatus {
+	return func(o ...func(*ReplicationStatusRequest)) (*Response, *ReplicationStatusResp, error) {
+		var r = ReplicationStatusRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ReplicationStatus returns the status of the replication of the follower index.
+type ReplicationStatus func(o ...func(*ReplicationStatusRequest)) (*Response, *ReplicationStatusResp, error)
+
+// ReplicationStatusRequest configures the Replication Status API request.
+type ReplicationStatusRequest struct {
+	Index string
+
+	Verbose bool
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, ReplicationStatusResp and error.
+func (r ReplicationStatusRequest) Do(ctx context.Context, transport Transport) (*Response, *ReplicationStatusResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data ReplicationStatusResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_replication") + 1 + len(r.Index) + len("/_status"))
+	path.WriteString("/_plugins/_replication")
+	path.WriteString("/")
+	path.WriteString(r.Index)
+	path.WriteString("/_status")
+
+	params = make(map[string]string)
+
+	if r.Verbose {
+		params["verbose"] = "true"
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f ReplicationStatus) WithContext(v context.Context) func(*ReplicationStatusRequest) {
+	return func(r *ReplicationStatusRequest) {
+		r.ctx = v
+	}
+}
+
+// WithIndex - the follower index.
+func (f ReplicationStatus) WithIndex(v string) func(*ReplicationStatusRequest) {
+	return func(r *ReplicationStatusRequest) {
+		r.Index = v
+	}
+}
+
+// WithVerbose - returns the status of each shard.
+func (f ReplicationStatus) WithVerbose() func(*ReplicationStatusRequest) {
+	return func(r *ReplicationStatusRequest) {
+		r.Verbose = true
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ReplicationStatus) WithPretty() func(*ReplicationStatusRequest) {
+	return func(r *ReplicationStatusRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ReplicationStatus) WithHuman() func(*ReplicationStatusRequest) {
+	return func(r *ReplicationStatusRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ReplicationStatus) WithErrorTrace() func(*ReplicationStatusRequest) {
+	return func(r *ReplicationStatusRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ReplicationStatus) WithFilterPath(v ...string) func(*ReplicationStatusRequest) {
+	return func(r *ReplicationStatusRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ReplicationStatus) WithHeader(h map[string]string) func(*ReplicationStatusRequest) {
+	return func(r *ReplicationStatusRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ReplicationStatus) WithOpaqueID(s string) func(*ReplicationStatusRequest) {
+	return func(r *ReplicationStatusRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
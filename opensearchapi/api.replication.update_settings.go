@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newReplicationUpdateSettingsFunc(t Transport) ReplicationUpdateSettings {
+	return func(o ...func(*ReplicationUpdateSettingsRequest)) (*Response, *ReplicationAcknowledgedResp, error) {
+		var r = ReplicationUpdateSettingsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// ReplicationUpdateSettings updates the settings of the follower index, which override the replicated settings of the leader index.
+type ReplicationUpdateSettings func(o ...func(*ReplicationUpdateSettingsRequest)) (*Response, *ReplicationAcknowledgedResp, error)
+
+// ReplicationUpdateSettingsRequest configures the Replication Update Settings API request.
+type ReplicationUpdateSettingsRequest struct {
+	Index string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, ReplicationAcknowledgedResp and error.
+func (r ReplicationUpdateSettingsRequest) Do(ctx context.Context, transport Transport) (*Response, *ReplicationAcknowledgedResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data ReplicationAcknowledgedResp
+	)
+	method := "PUT"
+
+	path.Grow(len("/_plugins/_replication") + 1 + len(r.Index) + len("/_update"))
+	path.WriteString("/_plugins/_replication")
+	path.WriteString("/")
+	path.WriteString(r.Index)
+	path.WriteString("/_update")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f ReplicationUpdateSettings) WithContext(v context.Context) func(*ReplicationUpdateSettingsRequest) {
+	return func(r *ReplicationUpdateSettingsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithIndex - the follower index.
+func (f ReplicationUpdateSettings) WithIndex(v string) func(*ReplicationUpdateSettingsRequest) {
+	return func(r *ReplicationUpdateSettingsRequest) {
+		r.Index = v
+	}
+}
+
+// WithBody - the settings, eg. {"settings": {"index.number_of_replicas": 1}}.
+func (f ReplicationUpdateSettings) WithBody(v io.Reader) func(*ReplicationUpdateSettingsRequest) {
+	return func(r *ReplicationUpdateSettingsRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f ReplicationUpdateSettings) WithPretty() func(*ReplicationUpdateSettingsRequest) {
+	return func(r *ReplicationUpdateSettingsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f ReplicationUpdateSettings) WithHuman() func(*ReplicationUpdateSettingsRequest) {
+	return func(r *ReplicationUpdateSettingsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f ReplicationUpdateSettings) WithErrorTrace() func(*ReplicationUpdateSettingsRequest) {
+	return func(r *ReplicationUpdateSettingsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f ReplicationUpdateSettings) WithFilterPath(v ...string) func(*ReplicationUpdateSettingsRequest) {
+	return func(r *ReplicationUpdateSettingsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f ReplicationUpdateSettings) WithHeader(h map[string]string) func(*ReplicationUpdateSettingsRequest) {
+	return func(r *ReplicationUpdateSettingsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f ReplicationUpdateSettings) WithOpaqueID(s string) func(*ReplicationUpdateSettingsRequest) {
+	return func(r *ReplicationUpdateSettingsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
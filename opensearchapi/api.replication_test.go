@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestReplication(t *testing.T) {
+	ctx := context.Background()
+	body := func(s string) io.Reader { return strings.NewReader(s) }
+
+	acknowledged := &ReplicationAcknowledgedResp{Acknowledged: true}
+	status := &ReplicationStatusResp{
+		Status:         ReplicationStatusSyncing,
+		Reason:         "User initiated",
+		LeaderAlias:    "my-connection-alias",
+		LeaderIndex:    "leader-01",
+		FollowerIndex:  "follower-01",
+		SyncingDetails: &ReplicationSyncingDetails{LeaderCheckpoint: 19, FollowerCheckpoint: 17, SeqNo: 18},
+	}
+	notInProgress := &ReplicationStatusResp{Status: ReplicationStatusNotInProgress}
+	leaderStats := &ReplicationLeaderStatsResp{
+		NumReplicatedIndices: 2,
+		ReplicationLeaderIndexStats: ReplicationLeaderIndexStats{
+			OperationsRead: 15, TranslogSizeBytes: 1355, OperationsReadTranslog: 15, TotalReadTimeTranslogMillis: 659, BytesRead: 1000,
+		},
+		IndexStats: map[string]ReplicationLeaderIndexStats{
+			"leader-index-1": {OperationsRead: 7, TranslogSizeBytes: 639, OperationsReadTranslog: 7, TotalReadTimeTranslogMillis: 353, BytesRead: 466},
+			"leader-index-2": {OperationsRead: 8, TranslogSizeBytes: 716, OperationsReadTranslog: 8, TotalReadTimeTranslogMillis: 306, BytesRead: 534},
+		},
+	}
+	followerStats := &ReplicationFollowerStatsResp{
+		NumSyncingIndices: 2,
+		NumShardTasks:     2,
+		NumIndexTasks:     2,
+		ReplicationFollowerIndexStats: ReplicationFollowerIndexStats{
+			OperationsWritten: 3, OperationsRead: 3, FollowerCheckpoint: 1, LeaderCheckpoint: 1, TotalWriteTimeMillis: 2290,
+		},
+		IndexStats: map[string]ReplicationFollowerIndexStats{
+			"follower-index-1": {OperationsWritten: 2, OperationsRead: 2, FollowerCheckpoint: 1, LeaderCheckpoint: 1, TotalWriteTimeMillis: 1355},
+			"follower-index-2": {OperationsWritten: 1, OperationsRead: 1, TotalWriteTimeMillis: 935},
+		},
+	}
+	autofollowStats := &ReplicationAutofollowStatsResp{
+		ReplicationAutofollowRuleStats: ReplicationAutofollowRuleStats{
+			NumSuccessStartReplication: 2, NumFailedStartReplication: 1, FailedIndices: []string{"movies-old"},
+		},
+		AutofollowStats: []ReplicationAutofollowRuleStats{{
+			Name: "my-replication-rule", Pattern: "movies*",
+			NumSuccessStartReplication: 2, NumFailedStartReplication: 1, FailedIndices: []string{"movies-old"},
+		}},
+	}
+
+	testPlugin(t, pluginError{404, "replication/error.json"}, []pluginTest{
+		{"Start", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationStartRequest{
+				Index: "follower-01",
+				Body:  body(`{"leader_alias":"my-connection-alias","leader_index":"leader-01"}`),
+			}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_replication/follower-01/_start", `{"leader_alias":"my-connection-alias","leader_index":"leader-01"}`,
+			"replication/acknowledged.json", acknowledged},
+		{"Stop", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationStopRequest{Index: "follower-01", Body: body(`{}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_replication/follower-01/_stop", `{}`,
+			"replication/acknowledged.json", acknowledged},
+		{"Pause", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationPauseRequest{Index: "follower-01", Body: body(`{}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_replication/follower-01/_pause", `{}`,
+			"replication/acknowledged.json", acknowledged},
+		{"Resume", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationResumeRequest{Index: "follower-01", Body: body(`{}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_replication/follower-01/_resume", `{}`,
+			"replication/acknowledged.json", acknowledged},
+		{"Status", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationStatusRequest{Index: "follower-01"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_replication/follower-01/_status", "",
+			"replication/status.json", status},
+		{"Status not in progress", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationStatusRequest{Index: "other"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_replication/other/_status", "",
+			"replication/status_not_in_progress.json", notInProgress},
+		{"UpdateSettings", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationUpdateSettingsRequest{Index: "follower-01", Body: body(`{"settings":{}}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_replication/follower-01/_update", `{"settings":{}}`,
+			"replication/acknowledged.json", acknowledged},
+		{"CreateAutofollow", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationCreateAutofollowRequest{Body: body(`{"name":"my-replication-rule"}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_replication/_autofollow", `{"name":"my-replication-rule"}`,
+			"replication/acknowledged.json", acknowledged},
+		{"DeleteAutofollow", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationDeleteAutofollowRequest{Body: body(`{"name":"my-replication-rule"}`)}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_replication/_autofollow", `{"name":"my-replication-rule"}`,
+			"replication/acknowledged.json", acknowledged},
+		{"LeaderStats", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationLeaderStatsRequest{}.Do(ctx, tp)
+		}, "GET", "/_plugins/_replication/leader_stats", "",
+			"replication/leader_stats.json", leaderStats},
+		{"FollowerStats", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationFollowerStatsRequest{}.Do(ctx, tp)
+		}, "GET", "/_plugins/_replication/follower_stats", "",
+			"replication/follower_stats.json", followerStats},
+		{"AutofollowStats", func(tp Transport) (*Response, interface{}, error) {
+			return ReplicationAutofollowStatsRequest{}.Do(ctx, tp)
+		}, "GET", "/_plugins/_replication/autofollow_stats", "",
+			"replication/autofollow_stats.json", autofollowStats},
+	})
+
+	t.Run("Lag", func(t *testing.T) {
+		if lag := status.Lag(); lag != 2 {
+			t.Errorf("Unexpected lag: %d, want: 2", lag)
+		}
+		if lag := notInProgress.Lag(); lag != 0 {
+			t.Errorf("Unexpected lag: %d, want: 0", lag)
+		}
+	})
+}
@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newRollupExplainFunc(t Transport) RollupExplain {
+	return func(o ...func(*RollupExplainRequest)) (*Response, *RollupExplainResp, error) {
+		var r = RollupExplainRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// RollupExplain returns the status of rollup jobs.
+type RollupExplain func(o ...func(*RollupExplainRequest)) (*Response, *RollupExplainResp, error)
+
+// RollupExplainRequest configures the Rollup Explain API request.
+type RollupExplainRequest struct {
+	RollupID []string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, RollupExplainResp and error.
+func (r RollupExplainRequest) Do(ctx context.Context, transport Transport) (*Response, *RollupExplainResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data RollupExplainResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_rollup/jobs") + 1 + len(strings.Join(r.RollupID, ",")) + len("/_explain"))
+	path.WriteString("/_plugins/_rollup/jobs")
+	path.WriteString("/")
+	path.WriteString(strings.Join(r.RollupID, ","))
+	path.WriteString("/_explain")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f RollupExplain) WithContext(v context.Context) func(*RollupExplainRequest) {
+	return func(r *RollupExplainRequest) {
+		r.ctx = v
+	}
+}
+
+// WithRollupID - the IDs of the rollup jobs.
+func (f RollupExplain) WithRollupID(v ...string) func(*RollupExplainRequest) {
+	return func(r *RollupExplainRequest) {
+		r.RollupID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f RollupExplain) WithPretty() func(*RollupExplainRequest) {
+	return func(r *RollupExplainRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f RollupExplain) WithHuman() func(*RollupExplainRequest) {
+	return func(r *RollupExplainRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f RollupExplain) WithErrorTrace() func(*RollupExplainRequest) {
+	return func(r *RollupExplainRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f RollupExplain) WithFilterPath(v ...string) func(*RollupExplainRequest) {
+	return func(r *RollupExplainRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f RollupExplain) WithHeader(h map[string]string) func(*RollupExplainRequest) {
+	return func(r *RollupExplainRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f RollupExplain) WithOpaqueID(s string) func(*RollupExplainRequest) {
+	return func(r *RollupExplainRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
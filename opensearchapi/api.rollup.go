@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import "encoding/json"
+
+// The statuses of a rollup or transform job, in its metadata.
+const (
+	JobStatusInit     = "init"
+	JobStatusStarted  = "started"
+	JobStatusStopped  = "stopped"
+	JobStatusFinished = "finished"
+	JobStatusFailed   = "failed"
+)
+
+// JobSchedule represents the schedule of a rollup or transform job; either Interval or Cron must be set.
+type JobSchedule struct {
+	Interval *JobInterval `json:"interval,omitempty"`
+	Cron     *ISMCron     `json:"cron,omitempty"`
+}
+
+// JobInterval represents an interval schedule, eg. {Period: 1, Unit: "Days"}.
+// The StartTime, in epoch milliseconds, is set by the plugin when empty.
+type JobInterval struct {
+	StartTime int64  `json:"start_time,omitempty"`
+	Period    int    `json:"period"`
+	Unit      string `json:"unit"`
+}
+
+// RollupBody is the body of the Rollup Put API.
+type RollupBody struct {
+	Rollup RollupJob `json:"rollup"`
+}
+
+// RollupJob represents a rollup job: it aggregates the documents of the source index by the dimensions,
+// and indexes the metrics of each bucket in the target index. The fields set by the plugin are omitted
+// when empty, so that a job returned by the Rollup Get API can be sent back to the Rollup Put API.
+type RollupJob struct {
+	RollupID          string            `json:"rollup_id,omitempty"`
+	SchemaVersion     int               `json:"schema_version,omitempty"`
+	Enabled           bool              `json:"enabled"`
+	EnabledTime       int64             `json:"enabled_time,omitempty"`
+	LastUpdatedTime   int64             `json:"last_updated_time,omitempty"`
+	Schedule          JobSchedule       `json:"schedule"`
+	Description       string            `json:"description,omitempty"`
+	SourceIndex       string            `json:"source_index"`
+	TargetIndex       string            `json:"target_index"`
+	MetadataID        string            `json:"metadata_id,omitempty"`
+	PageSize          int               `json:"page_size"`
+	Delay             *int64            `json:"delay,omitempty"`
+	Continuous        bool              `json:"continuous"`
+	Dimensions        []RollupDimension `json:"dimensions"`
+	Metrics           []RollupMetric    `json:"metrics"`
+	ErrorNotification json.RawMessage   `json:"error_notification,omitempty"`
+	User              json.RawMessage   `json:"user,omitempty"`
+}
+
+// RollupDimension represents a dimension of a rollup job; exactly one of the fields must be set.
+// The first dimension must be a date histogram.
+type RollupDimension struct {
+	DateHistogram *RollupDateHistogram `json:"date_histogram,omitempty"`
+	Terms         *RollupTerms         `json:"terms,omitempty"`
+	Histogram     *RollupHistogram     `json:"histogram,omitempty"`
+}
+
+// RollupDateHistogram buckets the documents by a date field, eg. {SourceField: "timestamp", FixedInterval: "1h"}.
+type RollupDateHistogram struct {
+	SourceField      string `json:"source_field"`
+	FixedInterval    string `json:"fixed_interval,omitempty"`
+	CalendarInterval string `json:"calendar_interval,omitempty"`
+	Timezone         string `json:"timezone,omitempty"`
+}
+
+// RollupTerms buckets the documents by the values of a keyword or numeric field.
+type RollupTerms struct {
+	SourceField string `json:"source_field"`
+}
+
+// RollupHistogram buckets the documents by a numeric field.
+type RollupHistogram struct {
+	SourceField string  `json:"source_field"`
+	Interval    float64 `json:"interval"`
+}
+
+// RollupMetric represents the metrics of a numeric field.
+type RollupMetric struct {
+	SourceField string                    `json:"source_field"`
+	Metrics     []RollupMetricAggregation `json:"metrics"`
+}
+
+// RollupMetricAggregation represents a metric; exactly one of the fields must be set,
+// eg. {Avg: &RollupEmptyMetric{}}.
+type RollupMetricAggregation struct {
+	Avg        *RollupEmptyMetric `json:"avg,omitempty"`
+	Sum        *RollupEmptyMetric `json:"sum,omitempty"`
+	Max        *RollupEmptyMetric `json:"max,omitempty"`
+	Min        *RollupEmptyMetric `json:"min,omitempty"`
+	ValueCount *RollupEmptyMetric `json:"value_count,omitempty"`
+}
+
+// RollupEmptyMetric is a metric without settings.
+type RollupEmptyMetric struct{}
+
+// RollupResp is the response of the Rollup Put and Get APIs.
+type RollupResp struct {
+	ID          string    `json:"_id"`
+	Version     int64     `json:"_version"`
+	SeqNo       int64     `json:"_seq_no"`
+	PrimaryTerm int64     `json:"_primary_term"`
+	Rollup      RollupJob `json:"rollup"`
+}
+
+// RollupDeleteResp is the response of the Rollup Delete API.
+type RollupDeleteResp struct {
+	Index       string     `json:"_index"`
+	ID          string     `json:"_id"`
+	Version     int64      `json:"_version"`
+	Result      string     `json:"result"`
+	Shards      ShardsInfo `json:"_shards"`
+	SeqNo       int64      `json:"_seq_no"`
+	PrimaryTerm int64      `json:"_primary_term"`
+}
+
+// RollupAcknowledgedResp is the response of the Rollup Start and Stop APIs.
+type RollupAcknowledgedResp struct {
+	Acknowledged bool `json:"acknowledged"`
+}
+
+// RollupExplainResp is the response of the Rollup Explain API, by rollup job ID.
+type RollupExplainResp map[string]RollupJobExplain
+
+// RollupJobExplain represents the status of a rollup job; the metadata is nil until the job runs.
+type RollupJobExplain struct {
+	MetadataID     string          `json:"metadata_id"`
+	RollupMetadata *RollupMetadata `json:"rollup_metadata"`
+}
+
+// RollupMetadata represents the metadata of a rollup job.
+type RollupMetadata struct {
+	RollupID        string            `json:"rollup_id"`
+	LastUpdatedTime int64             `json:"last_updated_time"`
+	Status          string            `json:"status"`
+	FailureReason   string            `json:"failure_reason"`
+	Continuous      *RollupContinuous `json:"continuous,omitempty"`
+	Stats           RollupStats       `json:"stats"`
+}
+
+// RollupContinuous represents the next window of a continuous rollup job, in epoch milliseconds.
+type RollupContinuous struct {
+	NextWindowStartTime int64 `json:"next_window_start_time"`
+	NextWindowEndTime   int64 `json:"next_window_end_time"`
+}
+
+// RollupStats represents the statistics of a rollup job.
+type RollupStats struct {
+	PagesProcessed     int64 `json:"pages_processed"`
+	DocumentsProcessed int64 `json:"documents_processed"`
+	RollupsIndexed     int64 `json:"rollups_indexed"`
+	IndexTimeInMillis  int64 `json:"index_time_in_millis"`
+	SearchTimeInMillis int64 `json:"search_time_in_millis"`
+}
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func newRollupPutFunc(t Transport) RollupPut {
+	return func(o ...func(*RollupPutRequest)) (*Response, *RollupResp, error) {
+		var r = RollupPutRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// RollupPut creates or updates a rollup job.
+type RollupPut func(o ...func(*RollupPutRequest)) (*Response, *RollupResp, error)
+
+// RollupPutRequest configures the Rollup Put API request.
+type RollupPutRequest struct {
+	RollupID string
+
+	Body io.Reader
+
+	IfSeqNo       *int64
+	IfPrimaryTerm *int64
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, RollupResp and error.
+func (r RollupPutRequest) Do(ctx context.Context, transport Transport) (*Response, *RollupResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data RollupResp
+	)
+	method := "PUT"
+
+	path.Grow(len("/_plugins/_rollup/jobs") + 1 + len(r.RollupID))
+	path.WriteString("/_plugins/_rollup/jobs")
+	path.WriteString("/")
+	path.WriteString(r.RollupID)
+
+	params = make(map[string]string)
+
+	if r.IfSeqNo != nil {
+		params["if_seq_no"] = strconv.FormatInt(*r.IfSeqNo, 10)
+	}
+
+	if r.IfPrimaryTerm != nil {
+		params["if_primary_term"] = strconv.FormatInt(*r.IfPrimaryTerm, 10)
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f RollupPut) WithContext(v context.Context) func(*RollupPutRequest) {
+	return func(r *RollupPutRequest) {
+		r.ctx = v
+	}
+}
+
+// WithRollupID - the ID of the rollup job.
+func (f RollupPut) WithRollupID(v string) func(*RollupPutRequest) {
+	return func(r *RollupPutRequest) {
+		r.RollupID = v
+	}
+}
+
+// WithBody - the rollup job, see RollupBody.
+func (f RollupPut) WithBody(v io.Reader) func(*RollupPutRequest) {
+	return func(r *RollupPutRequest) {
+		r.Body = v
+	}
+}
+
+// WithIfSeqNo - only updates the rollup job if it has this sequence number.
+func (f RollupPut) WithIfSeqNo(v int64) func(*RollupPutRequest) {
+	return func(r *RollupPutRequest) {
+		r.IfSeqNo = &v
+	}
+}
+
+// WithIfPrimaryTerm - only updates the rollup job if it has this primary term.
+func (f RollupPut) WithIfPrimaryTerm(v int64) func(*RollupPutRequest) {
+	return func(r *RollupPutRequest) {
+		r.IfPrimaryTerm = &v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f RollupPut) WithPretty() func(*RollupPutRequest) {
+	return func(r *RollupPutRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f RollupPut) WithHuman() func(*RollupPutRequest) {
+	return func(r *RollupPutRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f RollupPut) WithErrorTrace() func(*RollupPutRequest) {
+	return func(r *RollupPutRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f RollupPut) WithFilterPath(v ...string) func(*RollupPutRequest) {
+	return func(r *RollupPutRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f RollupPut) WithHeader(h map[string]string) func(*RollupPutRequest) {
+	return func(r *RollupPutRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f RollupPut) WithOpaqueID(s string) func(*RollupPutRequest) {
+	return func(r *RollupPutRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newRollupStartFunc(t Transport) RollupStart {
+	return func(o ...func(*RollupStartRequest)) (*Response, *RollupAcknowledgedResp, error) {
+		var r = RollupStartRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// RollupStart starts a rollup job.
+type RollupStart func(o ...func(*RollupStartRequest)) (*Response, *RollupAcknowledgedResp, error)
+
+// RollupStartRequest configures the Rollup Start API request.
+type RollupStartRequest struct {
+	RollupID string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, RollupAcknowledgedResp and error.
+func (r RollupStartRequest) Do(ctx context.Context, transport Transport) (*Response, *RollupAcknowledgedResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data RollupAcknowledgedResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_rollup/jobs") + 1 + len(r.RollupID) + len("/_start"))
+	path.WriteString("/_plugins/_rollup/jobs")
+	path.WriteString("/")
+	path.WriteString(r.RollupID)
+	path.WriteString("/_start")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f RollupStart) WithContext(v context.Context) func(*RollupStartRequest) {
+	return func(r *RollupStartRequest) {
+		r.ctx = v
+	}
+}
+
+// WithRollupID - the ID of the rollup job.
+func (f RollupStart) WithRollupID(v string) func(*RollupStartRequest) {
+	return func(r *RollupStartRequest) {
+		r.RollupID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f RollupStart) WithPretty() func(*RollupStartRequest) {
+	return func(r *RollupStartRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f RollupStart) WithHuman() func(*RollupStartRequest) {
+	return func(r *RollupStartRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f RollupStart) WithErrorTrace() func(*RollupStartRequest) {
+	return func(r *RollupStartRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f RollupStart) WithFilterPath(v ...string) func(*RollupStartRequest) {
+	return func(r *RollupStartRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f RollupStart) WithHeader(h map[string]string) func(*RollupStartRequest) {
+	return func(r *RollupStartRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f RollupStart) WithOpaqueID(s string) func(*RollupStartRequest) {
+	return func(r *RollupStartRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRollup(t *testing.T) {
+	ctx := context.Background()
+	body := func(s string) io.Reader { return strings.NewReader(s) }
+
+	int64p := func(v int64) *int64 { return &v }
+
+	rollup := &RollupResp{
+		ID: "example", Version: 3, SeqNo: 1, PrimaryTerm: 1,
+		Rollup: RollupJob{
+			RollupID:        "example",
+			SchemaVersion:   17,
+			Enabled:         true,
+			EnabledTime:     1680159934649,
+			LastUpdatedTime: 1680159934649,
+			Schedule:        JobSchedule{Interval: &JobInterval{StartTime: 1680159934649, Period: 1, Unit: "Days"}},
+			Description:     "Example rollup job",
+			SourceIndex:     "nyc-taxi-data",
+			TargetIndex:     "rollup-nyc-taxi-data",
+			PageSize:        200,
+			Delay:           int64p(0),
+			Dimensions: []RollupDimension{
+				{DateHistogram: &RollupDateHistogram{SourceField: "tpep_pickup_datetime", FixedInterval: "1h", Timezone: "America/Los_Angeles"}},
+				{Terms: &RollupTerms{SourceField: "PULocationID"}},
+			},
+			Metrics: []RollupMetric{{SourceField: "passenger_count", Metrics: []RollupMetricAggregation{
+				{Avg: &RollupEmptyMetric{}},
+				{Sum: &RollupEmptyMetric{}},
+				{Max: &RollupEmptyMetric{}},
+				{Min: &RollupEmptyMetric{}},
+				{ValueCount: &RollupEmptyMetric{}},
+			}}},
+		},
+	}
+	acknowledged := &RollupAcknowledgedResp{Acknowledged: true}
+
+	testPlugin(t, pluginError{404, "rollup/error.json"}, []pluginTest{
+		{"Put", func(tp Transport) (*Response, interface{}, error) {
+			return RollupPutRequest{RollupID: "example", Body: body(`{"rollup":{}}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_rollup/jobs/example", `{"rollup":{}}`,
+			"rollup/rollup.json", rollup},
+		{"Get", func(tp Transport) (*Response, interface{}, error) {
+			return RollupGetRequest{RollupID: "example"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_rollup/jobs/example", "",
+			"rollup/rollup.json", rollup},
+		{"Delete", func(tp Transport) (*Response, interface{}, error) {
+			return RollupDeleteRequest{RollupID: "example"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_rollup/jobs/example", "",
+			"rollup/delete.json", &RollupDeleteResp{
+				Index: ".opendistro-ism-config", ID: "example", Version: 4, Result: "deleted",
+				Shards: ShardsInfo{Total: 2, Successful: 2}, SeqNo: 5, PrimaryTerm: 1,
+			}},
+		{"Start", func(tp Transport) (*Response, interface{}, error) {
+			return RollupStartRequest{RollupID: "example"}.Do(ctx, tp)
+		}, "POST", "/_plugins/_rollup/jobs/example/_start", "",
+			"rollup/acknowledged.json", acknowledged},
+		{"Stop", func(tp Transport) (*Response, interface{}, error) {
+			return RollupStopRequest{RollupID: "example"}.Do(ctx, tp)
+		}, "POST", "/_plugins/_rollup/jobs/example/_stop", "",
+			"rollup/acknowledged.json", acknowledged},
+		{"Explain", func(tp Transport) (*Response, interface{}, error) {
+			return RollupExplainRequest{RollupID: []string{"example", "not_started"}}.Do(ctx, tp)
+		}, "GET", "/_plugins/_rollup/jobs/example,not_started/_explain", "",
+			"rollup/explain.json", &RollupExplainResp{
+				"example": {MetadataID: "GYQ9OXgBpm3DIqPKr9mG", RollupMetadata: &RollupMetadata{
+					RollupID:        "example",
+					LastUpdatedTime: 1602014281,
+					Status:          JobStatusStarted,
+					Continuous:      &RollupContinuous{NextWindowStartTime: 1602055591, NextWindowEndTime: 1602075591},
+					Stats: RollupStats{
+						PagesProcessed: 342, DocumentsProcessed: 489359, RollupsIndexed: 3420, IndexTimeInMillis: 30495, SearchTimeInMillis: 584922,
+					},
+				}},
+				"not_started": {},
+			}},
+	})
+
+	t.Run("Concurrency", func(t *testing.T) {
+		tp := &recordingTransport{body: `{"_id":"r1","_version":2,"_seq_no":4,"_primary_term":1,"rollup":{}}`}
+		api := New(tp)
+
+		_, data, err := api.Rollup.Put(
+			api.Rollup.Put.WithRollupID("r1"),
+			api.Rollup.Put.WithBody(body(`{"rollup":{}}`)),
+			api.Rollup.Put.WithIfSeqNo(3),
+			api.Rollup.Put.WithIfPrimaryTerm(1),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if q := tp.req.URL.Query(); q.Get("if_seq_no") != "3" || q.Get("if_primary_term") != "1" || data.SeqNo != 4 {
+			t.Errorf("Unexpected request or response: %s, %+v", tp.req.URL.RawQuery, data)
+		}
+	})
+
+	t.Run("Job round trip", func(t *testing.T) {
+		job := `{
+			"rollup_id": "r1",
+			"schema_version": 11,
+			"enabled": true,
+			"enabled_time": 1700000000000,
+			"last_updated_time": 1700000000000,
+			"schedule": {"interval": {"start_time": 1700000000000, "period": 1, "unit": "Days"}},
+			"description": "nightly metrics",
+			"source_index": "metrics-*",
+			"target_index": "metrics-rollup",
+			"metadata_id": "m1",
+			"page_size": 1000,
+			"delay": 0,
+			"continuous": false,
+			"dimensions": [
+				{"date_histogram": {"source_field": "timestamp", "fixed_interval": "1h", "timezone": "UTC"}},
+				{"terms": {"source_field": "host"}},
+				{"histogram": {"source_field": "cpu", "interval": 10}}
+			],
+			"metrics": [{"source_field": "latency", "metrics": [{"avg": {}}, {"max": {}}, {"value_count": {}}]}]
+		}`
+		tp := &recordingTransport{body: `{"_id":"r1","_version":1,"_seq_no":0,"_primary_term":1,"rollup":` + job + `}`}
+
+		_, data, err := RollupGetRequest{RollupID: "r1"}.Do(ctx, tp)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		r := data.Rollup
+		if r.Schedule.Interval == nil || r.Schedule.Interval.Unit != "Days" || r.Dimensions[0].DateHistogram == nil ||
+			r.Dimensions[2].Histogram.Interval != 10 || r.Metrics[0].Metrics[2].ValueCount == nil || r.Delay == nil {
+			t.Fatalf("Unexpected response: %+v", data)
+		}
+
+		b, err := json.Marshal(RollupBody{Rollup: r})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		var got, expected interface{}
+		_ = json.Unmarshal(b, &got)
+		_ = json.Unmarshal([]byte(`{"rollup":`+job+`}`), &expected)
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Unexpected job:\n%s\nwant:\n%s", b, job)
+		}
+	})
+
+}
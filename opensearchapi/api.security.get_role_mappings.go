@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newSecurityGetRoleMappingsFunc(t Transport) SecurityGetRoleMappings {
+	return func(o ...func(*SecurityGetRoleMappingsRequest)) (*Response, *SecurityRoleMappingsResp, error) {
+		var r = SecurityGetRoleMappingsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityGetRoleMappings returns the role mappings, or a single role mapping by name.
+type SecurityGetRoleMappings func(o ...func(*SecurityGetRoleMappingsRequest)) (*Response, *SecurityRoleMappingsResp, error)
+
+// SecurityGetRoleMappingsRequest configures the Security Get Role Mappings API request.
+type SecurityGetRoleMappingsRequest struct {
+	Role string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SecurityRoleMappingsResp and error.
+func (r SecurityGetRoleMappingsRequest) Do(ctx context.Context, transport Transport) (*Response, *SecurityRoleMappingsResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SecurityRoleMappingsResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_security/api/rolesmapping") + 1 + len(r.Role))
+	path.WriteString("/_plugins/_security/api/rolesmapping")
+	if r.Role != "" {
+		path.WriteString("/")
+		path.WriteString(r.Role)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityGetRoleMappings) WithContext(v context.Context) func(*SecurityGetRoleMappingsRequest) {
+	return func(r *SecurityGetRoleMappingsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithRole - the name of the mapped role.
+func (f SecurityGetRoleMappings) WithRole(v string) func(*SecurityGetRoleMappingsRequest) {
+	return func(r *SecurityGetRoleMappingsRequest) {
+		r.Role = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityGetRoleMappings) WithPretty() func(*SecurityGetRoleMappingsRequest) {
+	return func(r *SecurityGetRoleMappingsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityGetRoleMappings) WithHuman() func(*SecurityGetRoleMappingsRequest) {
+	return func(r *SecurityGetRoleMappingsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityGetRoleMappings) WithErrorTrace() func(*SecurityGetRoleMappingsRequest) {
+	return func(r *SecurityGetRoleMappingsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityGetRoleMappings) WithFilterPath(v ...string) func(*SecurityGetRoleMappingsRequest) {
+	return func(r *SecurityGetRoleMappingsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityGetRoleMappings) WithHeader(h map[string]string) func(*SecurityGetRoleMappingsRequest) {
+	return func(r *SecurityGetRoleMappingsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityGetRoleMappings) WithOpaqueID(s string) func(*SecurityGetRoleMappingsRequest) {
+	return func(r *SecurityGetRoleMappingsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newSecurityGetRolesFunc(t Transport) SecurityGetRoles {
+	return func(o ...func(*SecurityGetRolesRequest)) (*Response, *SecurityRolesResp, error) {
+		var r = SecurityGetRolesRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityGetRoles returns the roles, or a single role by name.
+type SecurityGetRoles func(o ...func(*SecurityGetRolesRequest)) (*Response, *SecurityRolesResp, error)
+
+// SecurityGetRolesRequest configures the Security Get Roles API request.
+type SecurityGetRolesRequest struct {
+	Role string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SecurityRolesResp and error.
+func (r SecurityGetRolesRequest) Do(ctx context.Context, transport Transport) (*Response, *SecurityRolesResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SecurityRolesResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_security/api/roles") + 1 + len(r.Role))
+	path.WriteString("/_plugins/_security/api/roles")
+	if r.Role != "" {
+		path.WriteString("/")
+		path.WriteString(r.Role)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityGetRoles) WithContext(v context.Context) func(*SecurityGetRolesRequest) {
+	return func(r *SecurityGetRolesRequest) {
+		r.ctx = v
+	}
+}
+
+// WithRole - the name of the role.
+func (f SecurityGetRoles) WithRole(v string) func(*SecurityGetRolesRequest) {
+	return func(r *SecurityGetRolesRequest) {
+		r.Role = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityGetRoles) WithPretty() func(*SecurityGetRolesRequest) {
+	return func(r *SecurityGetRolesRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityGetRoles) WithHuman() func(*SecurityGetRolesRequest) {
+	return func(r *SecurityGetRolesRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityGetRoles) WithErrorTrace() func(*SecurityGetRolesRequest) {
+	return func(r *SecurityGetRolesRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityGetRoles) WithFilterPath(v ...string) func(*SecurityGetRolesRequest) {
+	return func(r *SecurityGetRolesRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityGetRoles) WithHeader(h map[string]string) func(*SecurityGetRolesRequest) {
+	return func(r *SecurityGetRolesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityGetRoles) WithOpaqueID(s string) func(*SecurityGetRolesRequest) {
+	return func(r *SecurityGetRolesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
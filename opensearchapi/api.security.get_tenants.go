@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newSecurityGetTenantsFunc(t Transport) SecurityGetTenants {
+	return func(o ...func(*SecurityGetTenantsRequest)) (*Response, *SecurityTenantsResp, error) {
+		var r = SecurityGetTenantsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityGetTenants returns the tenants, or a single tenant by name.
+type SecurityGetTenants func(o ...func(*SecurityGetTenantsRequest)) (*Response, *SecurityTenantsResp, error)
+
+// SecurityGetTenantsRequest configures the Security Get Tenants API request.
+type SecurityGetTenantsRequest struct {
+	Tenant string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SecurityTenantsResp and error.
+func (r SecurityGetTenantsRequest) Do(ctx context.Context, transport Transport) (*Response, *SecurityTenantsResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SecurityTenantsResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_security/api/tenants") + 1 + len(r.Tenant))
+	path.WriteString("/_plugins/_security/api/tenants")
+	if r.Tenant != "" {
+		path.WriteString("/")
+		path.WriteString(r.Tenant)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityGetTenants) WithContext(v context.Context) func(*SecurityGetTenantsRequest) {
+	return func(r *SecurityGetTenantsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithTenant - the name of the tenant.
+func (f SecurityGetTenants) WithTenant(v string) func(*SecurityGetTenantsRequest) {
+	return func(r *SecurityGetTenantsRequest) {
+		r.Tenant = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityGetTenants) WithPretty() func(*SecurityGetTenantsRequest) {
+	return func(r *SecurityGetTenantsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityGetTenants) WithHuman() func(*SecurityGetTenantsRequest) {
+	return func(r *SecurityGetTenantsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityGetTenants) WithErrorTrace() func(*SecurityGetTenantsRequest) {
+	return func(r *SecurityGetTenantsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityGetTenants) WithFilterPath(v ...string) func(*SecurityGetTenantsRequest) {
+	return func(r *SecurityGetTenantsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityGetTenants) WithHeader(h map[string]string) func(*SecurityGetTenantsRequest) {
+	return func(r *SecurityGetTenantsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityGetTenants) WithOpaqueID(s string) func(*SecurityGetTenantsRequest) {
+	return func(r *SecurityGetTenantsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newSecurityGetUsersFunc(t Transport) SecurityGetUsers {
+	return func(o ...func(*SecurityGetUsersRequest)) (*Response, *SecurityUsersResp, error) {
+		var r = SecurityGetUsersRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityGetUsers returns the users, or a single user by name.
+type SecurityGetUsers func(o ...func(*SecurityGetUsersRequest)) (*Response, *SecurityUsersResp, error)
+
+// SecurityGetUsersRequest configures the Security Get Users API request.
+type SecurityGetUsersRequest struct {
+	Username string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SecurityUsersResp and error.
+func (r SecurityGetUsersRequest) Do(ctx context.Context, transport Transport) (*Response, *SecurityUsersResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SecurityUsersResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_security/api/internalusers") + 1 + len(r.Username))
+	path.WriteString("/_plugins/_security/api/internalusers")
+	if r.Username != "" {
+		path.WriteString("/")
+		path.WriteString(r.Username)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityGetUsers) WithContext(v context.Context) func(*SecurityGetUsersRequest) {
+	return func(r *SecurityGetUsersRequest) {
+		r.ctx = v
+	}
+}
+
+// WithUsername - the name of the internal user.
+func (f SecurityGetUsers) WithUsername(v string) func(*SecurityGetUsersRequest) {
+	return func(r *SecurityGetUsersRequest) {
+		r.Username = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityGetUsers) WithPretty() func(*SecurityGetUsersRequest) {
+	return func(r *SecurityGetUsersRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityGetUsers) WithHuman() func(*SecurityGetUsersRequest) {
+	return func(r *SecurityGetUsersRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityGetUsers) WithErrorTrace() func(*SecurityGetUsersRequest) {
+	return func(r *SecurityGetUsersRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityGetUsers) WithFilterPath(v ...string) func(*SecurityGetUsersRequest) {
+	return func(r *SecurityGetUsersRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityGetUsers) WithHeader(h map[string]string) func(*SecurityGetUsersRequest) {
+	return func(r *SecurityGetUsersRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityGetUsers) WithOpaqueID(s string) func(*SecurityGetUsersRequest) {
+	return func(r *SecurityGetUsersRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import "encoding/json"
+
+// SecurityStatusResp is the response of the Security APIs which change the configuration.
+type SecurityStatusResp struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// SecurityPatchOperation represents a JSON patch operation of the Security Patch APIs,
+// eg. {Op: "add", Path: "/new-user", Value: SecurityUser{...}}.
+type SecurityPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// SecurityUser represents an internal user.
+// The Password is only sent to create or replace the user; the Hash is returned instead.
+type SecurityUser struct {
+	Password                string            `json:"password,omitempty"`
+	Hash                    string            `json:"hash,omitempty"`
+	OpendistroSecurityRoles []string          `json:"opendistro_security_roles,omitempty"`
+	BackendRoles            []string          `json:"backend_roles,omitempty"`
+	Attributes              map[string]string `json:"attributes,omitempty"`
+	Description             string            `json:"description,omitempty"`
+	Reserved                bool              `json:"reserved,omitempty"`
+	Hidden                  bool              `json:"hidden,omitempty"`
+	Static                  bool              `json:"static,omitempty"`
+}
+
+// SecurityUsersResp is the response of the Security Get Users API, by user name.
+type SecurityUsersResp map[string]SecurityUser
+
+// SecurityRole represents a role.
+type SecurityRole struct {
+	Description        string                     `json:"description,omitempty"`
+	ClusterPermissions []string                   `json:"cluster_permissions,omitempty"`
+	IndexPermissions   []SecurityIndexPermission  `json:"index_permissions,omitempty"`
+	TenantPermissions  []SecurityTenantPermission `json:"tenant_permissions,omitempty"`
+	Reserved           bool                       `json:"reserved,omitempty"`
+	Hidden             bool                       `json:"hidden,omitempty"`
+	Static             bool                       `json:"static,omitempty"`
+}
+
+// SecurityIndexPermission represents the permissions of a role on indices,
+// with document level security (DLS), field level security (FLS) and masked fields.
+type SecurityIndexPermission struct {
+	IndexPatterns  []string `json:"index_patterns"`
+	DLS            string   `json:"dls,omitempty"`
+	FLS            []string `json:"fls,omitempty"`
+	MaskedFields   []string `json:"masked_fields,omitempty"`
+	AllowedActions []string `json:"allowed_actions"`
+}
+
+// SecurityTenantPermission represents the permissions of a role on tenants.
+type SecurityTenantPermission struct {
+	TenantPatterns []string `json:"tenant_patterns"`
+	AllowedActions []string `json:"allowed_actions"`
+}
+
+// SecurityRolesResp is the response of the Security Get Roles API, by role name.
+type SecurityRolesResp map[string]SecurityRole
+
+// SecurityRoleMapping represents the users, backend roles and hosts mapped to a role.
+type SecurityRoleMapping struct {
+	BackendRoles    []string `json:"backend_roles,omitempty"`
+	AndBackendRoles []string `json:"and_backend_roles,omitempty"`
+	Hosts           []string `json:"hosts,omitempty"`
+	Users           []string `json:"users,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	Reserved        bool     `json:"reserved,omitempty"`
+	Hidden          bool     `json:"hidden,omitempty"`
+}
+
+// SecurityRoleMappingsResp is the response of the Security Get Role Mappings API, by role name.
+type SecurityRoleMappingsResp map[string]SecurityRoleMapping
+
+// SecurityActionGroup represents a named group of permissions.
+type SecurityActionGroup struct {
+	AllowedActions []string `json:"allowed_actions"`
+	Type           string   `json:"type,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	Reserved       bool     `json:"reserved,omitempty"`
+	Hidden         bool     `json:"hidden,omitempty"`
+	Static         bool     `json:"static,omitempty"`
+}
+
+// SecurityActionGroupsResp is the response of the Security Get Action Groups API, by action group name.
+type SecurityActionGroupsResp map[string]SecurityActionGroup
+
+// SecurityTenant represents a tenant.
+type SecurityTenant struct {
+	Description string `json:"description,omitempty"`
+	Reserved    bool   `json:"reserved,omitempty"`
+	Hidden      bool   `json:"hidden,omitempty"`
+	Static      bool   `json:"static,omitempty"`
+}
+
+// SecurityTenantsResp is the response of the Security Get Tenants API, by tenant name.
+type SecurityTenantsResp map[string]SecurityTenant
+
+// SecurityAccountResp is the response of the Security Get Account API.
+type SecurityAccountResp struct {
+	UserName             string          `json:"user_name"`
+	IsReserved           bool            `json:"is_reserved"`
+	IsHidden             bool            `json:"is_hidden"`
+	IsInternalUser       bool            `json:"is_internal_user"`
+	UserRequestedTenant  *string         `json:"user_requested_tenant"`
+	BackendRoles         []string        `json:"backend_roles"`
+	CustomAttributeNames []string        `json:"custom_attribute_names"`
+	Tenants              map[string]bool `json:"tenants"`
+	Roles                []string        `json:"roles"`
+}
+
+// SecurityChangePasswordBody is the body of the Security Change Password API.
+type SecurityChangePasswordBody struct {
+	CurrentPassword string `json:"current_password"`
+	Password        string `json:"password"`
+}
+
+// SecurityAuthInfoResp is the response of the Security Auth Info API.
+type SecurityAuthInfoResp struct {
+	User                 string          `json:"user"`
+	UserName             string          `json:"user_name"`
+	UserRequestedTenant  *string         `json:"user_requested_tenant"`
+	RemoteAddress        string          `json:"remote_address"`
+	BackendRoles         []string        `json:"backend_roles"`
+	CustomAttributeNames []string        `json:"custom_attribute_names"`
+	Roles                []string        `json:"roles"`
+	Tenants              map[string]bool `json:"tenants"`
+	Principal            *string         `json:"principal"`
+	PeerCertificates     string          `json:"peer_certificates"`
+	SSOLogoutURL         *string         `json:"sso_logout_url"`
+}
+
+// SecurityHealthResp is the response of the Security Health API.
+type SecurityHealthResp struct {
+	Message *string `json:"message"`
+	Mode    string  `json:"mode"`
+	Status  string  `json:"status"`
+}
+
+// SecurityConfigResp is the response of the Security Get Config API.
+type SecurityConfigResp struct {
+	Config SecurityConfig `json:"config"`
+}
+
+// SecurityConfig represents the security configuration, the body of the Security Put Config API.
+type SecurityConfig struct {
+	Dynamic SecurityDynamicConfig `json:"dynamic"`
+}
+
+// SecurityDynamicConfig represents the dynamic part of the security configuration.
+// The sections which are rarely changed are kept raw.
+type SecurityDynamicConfig struct {
+	FilteredAliasMode            string                        `json:"filtered_alias_mode,omitempty"`
+	DisableRestAuth              bool                          `json:"disable_rest_auth"`
+	DisableIntertransportAuth    bool                          `json:"disable_intertransport_auth"`
+	RespectRequestIndicesOptions bool                          `json:"respect_request_indices_options"`
+	DoNotFailOnForbidden         bool                          `json:"do_not_fail_on_forbidden"`
+	DoNotFailOnForbiddenEmpty    bool                          `json:"do_not_fail_on_forbidden_empty"`
+	MultiRolespanEnabled         bool                          `json:"multi_rolespan_enabled"`
+	HostsResolverMode            string                        `json:"hosts_resolver_mode,omitempty"`
+	Kibana                       json.RawMessage               `json:"kibana,omitempty"`
+	HTTP                         json.RawMessage               `json:"http,omitempty"`
+	Authc                        map[string]SecurityAuthDomain `json:"authc,omitempty"`
+	Authz                        map[string]SecurityAuthDomain `json:"authz,omitempty"`
+	AuthFailureListeners         json.RawMessage               `json:"auth_failure_listeners,omitempty"`
+}
+
+// SecurityAuthDomain represents an authentication or authorization domain of the security configuration.
+type SecurityAuthDomain struct {
+	Description           string                 `json:"description,omitempty"`
+	HTTPEnabled           bool                   `json:"http_enabled"`
+	TransportEnabled      bool                   `json:"transport_enabled"`
+	Order                 int                    `json:"order"`
+	HTTPAuthenticator     *SecurityAuthenticator `json:"http_authenticator,omitempty"`
+	AuthenticationBackend *SecurityAuthBackend   `json:"authentication_backend,omitempty"`
+	AuthorizationBackend  *SecurityAuthBackend   `json:"authorization_backend,omitempty"`
+}
+
+// SecurityAuthenticator represents the HTTP authenticator of an authentication domain, eg. "basic" or "jwt".
+type SecurityAuthenticator struct {
+	Type      string                 `json:"type"`
+	Challenge bool                   `json:"challenge"`
+	Config    map[string]interface{} `json:"config,omitempty"`
+}
+
+// SecurityAuthBackend represents the backend of an authentication or authorization domain, eg. "internal" or "ldap".
+type SecurityAuthBackend struct {
+	Type   string                 `json:"type"`
+	Config map[string]interface{} `json:"config,omitempty"`
+}
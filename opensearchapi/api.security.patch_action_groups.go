@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newSecurityPatchActionGroupsFunc(t Transport) SecurityPatchActionGroups {
+	return func(o ...func(*SecurityPatchActionGroupsRequest)) (*Response, *SecurityStatusResp, error) {
+		var r = SecurityPatchActionGroupsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityPatchActionGroups updates the action groups, or a single action group by name, with JSON patch operations.
+type SecurityPatchActionGroups func(o ...func(*SecurityPatchActionGroupsRequest)) (*Response, *SecurityStatusResp, error)
+
+// SecurityPatchActionGroupsRequest configures the Security Patch Action Groups API request.
+type SecurityPatchActionGroupsRequest struct {
+	ActionGroup string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SecurityStatusResp and error.
+func (r SecurityPatchActionGroupsRequest) Do(ctx context.Context, transport Transport) (*Response, *SecurityStatusResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SecurityStatusResp
+	)
+	method := "PATCH"
+
+	path.Grow(len("/_plugins/_security/api/actiongroups") + 1 + len(r.ActionGroup))
+	path.WriteString("/_plugins/_security/api/actiongroups")
+	if r.ActionGroup != "" {
+		path.WriteString("/")
+		path.WriteString(r.ActionGroup)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityPatchActionGroups) WithContext(v context.Context) func(*SecurityPatchActionGroupsRequest) {
+	return func(r *SecurityPatchActionGroupsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithActionGroup - the name of the action group.
+func (f SecurityPatchActionGroups) WithActionGroup(v string) func(*SecurityPatchActionGroupsRequest) {
+	return func(r *SecurityPatchActionGroupsRequest) {
+		r.ActionGroup = v
+	}
+}
+
+// WithBody - the JSON patch operations, see SecurityPatchOperation.
+func (f SecurityPatchActionGroups) WithBody(v io.Reader) func(*SecurityPatchActionGroupsRequest) {
+	return func(r *SecurityPatchActionGroupsRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityPatchActionGroups) WithPretty() func(*SecurityPatchActionGroupsRequest) {
+	return func(r *SecurityPatchActionGroupsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityPatchActionGroups) WithHuman() func(*SecurityPatchActionGroupsRequest) {
+	return func(r *SecurityPatchActionGroupsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityPatchActionGroups) WithErrorTrace() func(*SecurityPatchActionGroupsRequest) {
+	return func(r *SecurityPatchActionGroupsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityPatchActionGroups) WithFilterPath(v ...string) func(*SecurityPatchActionGroupsRequest) {
+	return func(r *SecurityPatchActionGroupsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityPatchActionGroups) WithHeader(h map[string]string) func(*SecurityPatchActionGroupsRequest) {
+	return func(r *SecurityPatchActionGroupsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityPatchActionGroups) WithOpaqueID(s string) func(*SecurityPatchActionGroupsRequest) {
+	return func(r *SecurityPatchActionGroupsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newSecurityPatchConfigFunc(t Transport) SecurityPatchConfig {
+	return func(o ...func(*SecurityPatchConfigRequest)) (*Response, *SecurityStatusResp, error) {
+		var r = SecurityPatchConfigRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityPatchConfig updates the security configuration with JSON patch operations.
+type SecurityPatchConfig func(o ...func(*SecurityPatchConfigRequest)) (*Response, *SecurityStatusResp, error)
+
+// SecurityPatchConfigRequest configures the Security Patch Config API request.
+type SecurityPatchConfigRequest struct {
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SecurityStatusResp and error.
+func (r SecurityPatchConfigRequest) Do(ctx context.Context, transport Transport) (*Response, *SecurityStatusResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SecurityStatusResp
+	)
+	method := "PATCH"
+
+	path.Grow(len("/_plugins/_security/api/securityconfig"))
+	path.WriteString("/_plugins/_security/api/securityconfig")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityPatchConfig) WithContext(v context.Context) func(*SecurityPatchConfigRequest) {
+	return func(r *SecurityPatchConfigRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - the JSON patch operations, see SecurityPatchOperation.
+func (f SecurityPatchConfig) WithBody(v io.Reader) func(*SecurityPatchConfigRequest) {
+	return func(r *SecurityPatchConfigRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityPatchConfig) WithPretty() func(*SecurityPatchConfigRequest) {
+	return func(r *SecurityPatchConfigRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityPatchConfig) WithHuman() func(*SecurityPatchConfigRequest) {
+	return func(r *SecurityPatchConfigRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityPatchConfig) WithErrorTrace() func(*SecurityPatchConfigRequest) {
+	return func(r *SecurityPatchConfigRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityPatchConfig) WithFilterPath(v ...string) func(*SecurityPatchConfigRequest) {
+	return func(r *SecurityPatchConfigRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityPatchConfig) WithHeader(h map[string]string) func(*SecurityPatchConfigRequest) {
+	return func(r *SecurityPatchConfigRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityPatchConfig) WithOpaqueID(s string) func(*SecurityPatchConfigRequest) {
+	return func(r *SecurityPatchConfigRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
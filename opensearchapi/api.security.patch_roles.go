@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newSecurityPatchRolesFunc(t Transport) SecurityPatchRoles {
+	return func(o ...func(*SecurityPatchRolesRequest)) (*Response, *SecurityStatusResp, error) {
+		var r = SecurityPatchRolesRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityPatchRoles updates the roles, or a single role by name, with JSON patch operations.
+type SecurityPatchRoles func(o ...func(*SecurityPatchRolesRequest)) (*Response, *SecurityStatusResp, error)
+
+// SecurityPatchRolesRequest configures the Security Patch Roles API request.
+type SecurityPatchRolesRequest struct {
+	Role string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SecurityStatusResp and error.
+func (r SecurityPatchRolesRequest) Do(ctx context.Context, transport Transport) (*Response, *SecurityStatusResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SecurityStatusResp
+	)
+	method := "PATCH"
+
+	path.Grow(len("/_plugins/_security/api/roles") + 1 + len(r.Role))
+	path.WriteString("/_plugins/_security/api/roles")
+	if r.Role != "" {
+		path.WriteString("/")
+		path.WriteString(r.Role)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityPatchRoles) WithContext(v context.Context) func(*SecurityPatchRolesRequest) {
+	return func(r *SecurityPatchRolesRequest) {
+		r.ctx = v
+	}
+}
+
+// WithRole - the name of the role.
+func (f SecurityPatchRoles) WithRole(v string) func(*SecurityPatchRolesRequest) {
+	return func(r *SecurityPatchRolesRequest) {
+		r.Role = v
+	}
+}
+
+// WithBody - the JSON patch operations, see SecurityPatchOperation.
+func (f SecurityPatchRoles) WithBody(v io.Reader) func(*SecurityPatchRolesRequest) {
+	return func(r *SecurityPatchRolesRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityPatchRoles) WithPretty() func(*SecurityPatchRolesRequest) {
+	return func(r *SecurityPatchRolesRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityPatchRoles) WithHuman() func(*SecurityPatchRolesRequest) {
+	return func(r *SecurityPatchRolesRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityPatchRoles) WithErrorTrace() func(*SecurityPatchRolesRequest) {
+	return func(r *SecurityPatchRolesRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityPatchRoles) WithFilterPath(v ...string) func(*SecurityPatchRolesRequest) {
+	return func(r *SecurityPatchRolesRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityPatchRoles) WithHeader(h map[string]string) func(*SecurityPatchRolesRequest) {
+	return func(r *SecurityPatchRolesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityPatchRoles) WithOpaqueID(s string) func(*SecurityPatchRolesRequest) {
+	return func(r *SecurityPatchRolesRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
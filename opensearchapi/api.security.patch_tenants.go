@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newSecurityPatchTenantsFunc(t Transport) SecurityPatchTenants {
+	return func(o ...func(*SecurityPatchTenantsRequest)) (*Response, *SecurityStatusResp, error) {
+		var r = SecurityPatchTenantsRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityPatchTenants updates the tenants, or a single tenant by name, with JSON patch operations.
+type SecurityPatchTenants func(o ...func(*SecurityPatchTenantsRequest)) (*Response, *SecurityStatusResp, error)
+
+// SecurityPatchTenantsRequest configures the Security Patch Tenants API request.
+type SecurityPatchTenantsRequest struct {
+	Tenant string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SecurityStatusResp and error.
+func (r SecurityPatchTenantsRequest) Do(ctx context.Context, transport Transport) (*Response, *SecurityStatusResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SecurityStatusResp
+	)
+	method := "PATCH"
+
+	path.Grow(len("/_plugins/_security/api/tenants") + 1 + len(r.Tenant))
+	path.WriteString("/_plugins/_security/api/tenants")
+	if r.Tenant != "" {
+		path.WriteString("/")
+		path.WriteString(r.Tenant)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityPatchTenants) WithContext(v context.Context) func(*SecurityPatchTenantsRequest) {
+	return func(r *SecurityPatchTenantsRequest) {
+		r.ctx = v
+	}
+}
+
+// WithTenant - the name of the tenant.
+func (f SecurityPatchTenants) WithTenant(v string) func(*SecurityPatchTenantsRequest) {
+	return func(r *SecurityPatchTenantsRequest) {
+		r.Tenant = v
+	}
+}
+
+// WithBody - the JSON patch operations, see SecurityPatchOperation.
+func (f SecurityPatchTenants) WithBody(v io.Reader) func(*SecurityPatchTenantsRequest) {
+	return func(r *SecurityPatchTenantsRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityPatchTenants) WithPretty() func(*SecurityPatchTenantsRequest) {
+	return func(r *SecurityPatchTenantsRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityPatchTenants) WithHuman() func(*SecurityPatchTenantsRequest) {
+	return func(r *SecurityPatchTenantsRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityPatchTenants) WithErrorTrace() func(*SecurityPatchTenantsRequest) {
+	return func(r *SecurityPatchTenantsRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityPatchTenants) WithFilterPath(v ...string) func(*SecurityPatchTenantsRequest) {
+	return func(r *SecurityPatchTenantsRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityPatchTenants) WithHeader(h map[string]string) func(*SecurityPatchTenantsRequest) {
+	return func(r *SecurityPatchTenantsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityPatchTenants) WithOpaqueID(s string) func(*SecurityPatchTenantsRequest) {
+	return func(r *SecurityPatchTenantsRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newSecurityPatchUsersFunc(t Transport) SecurityPatchUsers {
+	return func(o ...func(*SecurityPatchUsersRequest)) (*Response, *SecurityStatusResp, error) {
+		var r = SecurityPatchUsersRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityPatchUsers updates the users, or a single user by name, with JSON patch operations.
+type SecurityPatchUsers func(o ...func(*SecurityPatchUsersRequest)) (*Response, *SecurityStatusResp, error)
+
+// SecurityPatchUsersRequest configures the Security Patch Users API request.
+type SecurityPatchUsersRequest struct {
+	Username string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SecurityStatusResp and error.
+func (r SecurityPatchUsersRequest) Do(ctx context.Context, transport Transport) (*Response, *SecurityStatusResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SecurityStatusResp
+	)
+	method := "PATCH"
+
+	path.Grow(len("/_plugins/_security/api/internalusers") + 1 + len(r.Username))
+	path.WriteString("/_plugins/_security/api/internalusers")
+	if r.Username != "" {
+		path.WriteString("/")
+		path.WriteString(r.Username)
+	}
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityPatchUsers) WithContext(v context.Context) func(*SecurityPatchUsersRequest) {
+	return func(r *SecurityPatchUsersRequest) {
+		r.ctx = v
+	}
+}
+
+// WithUsername - the name of the internal user.
+func (f SecurityPatchUsers) WithUsername(v string) func(*SecurityPatchUsersRequest) {
+	return func(r *SecurityPatchUsersRequest) {
+		r.Username = v
+	}
+}
+
+// WithBody - the JSON patch operations, see SecurityPatchOperation.
+func (f SecurityPatchUsers) WithBody(v io.Reader) func(*SecurityPatchUsersRequest) {
+	return func(r *SecurityPatchUsersRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityPatchUsers) WithPretty() func(*SecurityPatchUsersRequest) {
+	return func(r *SecurityPatchUsersRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityPatchUsers) WithHuman() func(*SecurityPatchUsersRequest) {
+	return func(r *SecurityPatchUsersRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityPatchUsers) WithErrorTrace() func(*SecurityPatchUsersRequest) {
+	return func(r *SecurityPatchUsersRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityPatchUsers) WithFilterPath(v ...string) func(*SecurityPatchUsersRequest) {
+	return func(r *SecurityPatchUsersRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityPatchUsers) WithHeader(h map[string]string) func(*SecurityPatchUsersRequest) {
+	return func(r *SecurityPatchUsersRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityPatchUsers) WithOpaqueID(s string) func(*SecurityPatchUsersRequest) {
+	return func(r *SecurityPatchUsersRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
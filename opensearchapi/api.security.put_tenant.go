@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newSecurityPutTenantFunc(t Transport) SecurityPutTenant {
+	return func(o ...func(*SecurityPutTenantRequest)) (*Response, *SecurityStatusResp, error) {
+		var r = SecurityPutTenantRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityPutTenant creates or replaces a tenant.
+type SecurityPutTenant func(o ...func(*SecurityPutTenantRequest)) (*Response, *SecurityStatusResp, error)
+
+// SecurityPutTenantRequest configures the Security Put Tenant API request.
+type SecurityPutTenantRequest struct {
+	Tenant string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SecurityStatusResp and error.
+func (r SecurityPutTenantRequest) Do(ctx context.Context, transport Transport) (*Response, *SecurityStatusResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SecurityStatusResp
+	)
+	method := "PUT"
+
+	path.Grow(len("/_plugins/_security/api/tenants") + 1 + len(r.Tenant))
+	path.WriteString("/_plugins/_security/api/tenants")
+	path.WriteString("/")
+	path.WriteString(r.Tenant)
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityPutTenant) WithContext(v context.Context) func(*SecurityPutTenantRequest) {
+	return func(r *SecurityPutTenantRequest) {
+		r.ctx = v
+	}
+}
+
+// WithTenant - the name of the tenant.
+func (f SecurityPutTenant) WithTenant(v string) func(*SecurityPutTenantRequest) {
+	return func(r *SecurityPutTenantRequest) {
+		r.Tenant = v
+	}
+}
+
+// WithBody - the tenant, see SecurityTenant.
+func (f SecurityPutTenant) WithBody(v io.Reader) func(*SecurityPutTenantRequest) {
+	return func(r *SecurityPutTenantRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityPutTenant) WithPretty() func(*SecurityPutTenantRequest) {
+	return func(r *SecurityPutTenantRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityPutTenant) WithHuman() func(*SecurityPutTenantRequest) {
+	return func(r *SecurityPutTenantRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityPutTenant) WithErrorTrace() func(*SecurityPutTenantRequest) {
+	return func(r *SecurityPutTenantRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityPutTenant) WithFilterPath(v ...string) func(*SecurityPutTenantRequest) {
+	return func(r *SecurityPutTenantRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityPutTenant) WithHeader(h map[string]string) func(*SecurityPutTenantRequest) {
+	return func(r *SecurityPutTenantRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityPutTenant) WithOpaqueID(s string) func(*SecurityPutTenantRequest) {
+	return func(r *SecurityPutTenantRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newSecurityPutUserFunc(t Transport) SecurityPutUser {
+	return func(o ...func(*SecurityPutUserRequest)) (*Response, *SecurityStatusResp, error) {
+		var r = SecurityPutUserRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SecurityPutUser creates or replaces a user.
+type SecurityPutUser func(o ...func(*SecurityPutUserRequest)) (*Response, *SecurityStatusResp, error)
+
+// SecurityPutUserRequest configures the Security Put User API request.
+type SecurityPutUserRequest struct {
+	Username string
+
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SecurityStatusResp and error.
+func (r SecurityPutUserRequest) Do(ctx context.Context, transport Transport) (*Response, *SecurityStatusResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SecurityStatusResp
+	)
+	method := "PUT"
+
+	path.Grow(len("/_plugins/_security/api/internalusers") + 1 + len(r.Username))
+	path.WriteString("/_plugins/_security/api/internalusers")
+	path.WriteString("/")
+	path.WriteString(r.Username)
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SecurityPutUser) WithContext(v context.Context) func(*SecurityPutUserRequest) {
+	return func(r *SecurityPutUserRequest) {
+		r.ctx = v
+	}
+}
+
+// WithUsername - the name of the internal user.
+func (f SecurityPutUser) WithUsername(v string) func(*SecurityPutUserRequest) {
+	return func(r *SecurityPutUserRequest) {
+		r.Username = v
+	}
+}
+
+// WithBody - the user, see SecurityUser.
+func (f SecurityPutUser) WithBody(v io.Reader) func(*SecurityPutUserRequest) {
+	return func(r *SecurityPutUserRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SecurityPutUser) WithPretty() func(*SecurityPutUserRequest) {
+	return func(r *SecurityPutUserRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SecurityPutUser) WithHuman() func(*SecurityPutUserRequest) {
+	return func(r *SecurityPutUserRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SecurityPutUser) WithErrorTrace() func(*SecurityPutUserRequest) {
+	return func(r *SecurityPutUserRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SecurityPutUser) WithFilterPath(v ...string) func(*SecurityPutUserRequest) {
+	return func(r *SecurityPutUserRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SecurityPutUser) WithHeader(h map[string]string) func(*SecurityPutUserRequest) {
+	return func(r *SecurityPutUserRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SecurityPutUser) WithOpaqueID(s string) func(*SecurityPutUserRequest) {
+	return func(r *SecurityPutUserRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestSecurity(t *testing.T) {
+	ctx := context.Background()
+	body := func(s string) io.Reader { return strings.NewReader(s) }
+
+	created := &SecurityStatusResp{Status: "CREATED", Message: "'analyst' created."}
+	deleted := &SecurityStatusResp{Status: "OK", Message: "'analysts' deleted."}
+	updated := &SecurityStatusResp{Status: "OK", Message: "'admin' updated."}
+	patched := &SecurityStatusResp{Status: "OK", Message: "Resource updated."}
+
+	testPlugin(t, pluginError{404, "security/error.json"}, []pluginTest{
+		{"GetAccount", func(tp Transport) (*Response, interface{}, error) { return SecurityGetAccountRequest{}.Do(ctx, tp) },
+			"GET", "/_plugins/_security/api/account", "",
+			"security/account.json", &SecurityAccountResp{
+				UserName:             "admin",
+				IsReserved:           true,
+				IsInternalUser:       true,
+				BackendRoles:         []string{},
+				CustomAttributeNames: []string{},
+				Tenants:              map[string]bool{"global_tenant": true, "admin_tenant": true, "admin": true},
+				Roles:                []string{"all_access", "own_index"},
+			}},
+		{"ChangePassword", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityChangePasswordRequest{Body: body(`{"current_password":"a","password":"b"}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_security/api/account", `{"current_password":"a","password":"b"}`,
+			"security/updated.json", updated},
+		{"AuthInfo", func(tp Transport) (*Response, interface{}, error) { return SecurityAuthInfoRequest{}.Do(ctx, tp) },
+			"GET", "/_plugins/_security/authinfo", "",
+			"security/authinfo.json", &SecurityAuthInfoResp{
+				User:                 "User [name=admin, backend_roles=[], requestedTenant=null]",
+				UserName:             "admin",
+				RemoteAddress:        "127.0.0.1:35044",
+				BackendRoles:         []string{},
+				CustomAttributeNames: []string{},
+				Roles:                []string{"all_access", "own_index"},
+				Tenants:              map[string]bool{"global_tenant": true, "admin_tenant": true, "admin": true},
+				PeerCertificates:     "0",
+			}},
+		{"Health", func(tp Transport) (*Response, interface{}, error) { return SecurityHealthRequest{}.Do(ctx, tp) },
+			"GET", "/_plugins/_security/health", "",
+			"security/health.json", &SecurityHealthResp{Mode: "strict", Status: "UP"}},
+		{"FlushCache", func(tp Transport) (*Response, interface{}, error) { return SecurityFlushCacheRequest{}.Do(ctx, tp) },
+			"DELETE", "/_plugins/_security/api/cache", "",
+			"security/cache.json", &SecurityStatusResp{Status: "OK", Message: "Cache flushed successfully."}},
+		{"GetConfig", func(tp Transport) (*Response, interface{}, error) { return SecurityGetConfigRequest{}.Do(ctx, tp) },
+			"GET", "/_plugins/_security/api/securityconfig", "",
+			"security/securityconfig.json", &SecurityConfigResp{Config: SecurityConfig{Dynamic: SecurityDynamicConfig{
+				FilteredAliasMode:    "warn",
+				MultiRolespanEnabled: true,
+				HostsResolverMode:    "ip-only",
+				Kibana:               json.RawMessage(`{"multitenancy_enabled": true, "server_username": "kibanaserver", "index": ".kibana"}`),
+				HTTP:                 json.RawMessage(`{"anonymous_auth_enabled": false, "xff": {"enabled": false, "remoteIpHeader": "X-Forwarded-For"}}`),
+				Authc: map[string]SecurityAuthDomain{"basic_internal_auth_domain": {
+					Description:           "Authenticate via HTTP Basic against internal users database",
+					HTTPEnabled:           true,
+					TransportEnabled:      true,
+					Order:                 4,
+					HTTPAuthenticator:     &SecurityAuthenticator{Type: "basic", Challenge: true, Config: map[string]interface{}{}},
+					AuthenticationBackend: &SecurityAuthBackend{Type: "intern", Config: map[string]interface{}{}},
+				}},
+				Authz:                map[string]SecurityAuthDomain{},
+				AuthFailureListeners: json.RawMessage(`{}`),
+			}}}},
+		{"PutConfig", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPutConfigRequest{Body: body(`{"dynamic":{}}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_security/api/securityconfig/config", `{"dynamic":{}}`,
+			"security/securityconfig_updated.json", &SecurityStatusResp{Status: "OK", Message: "'config' updated."}},
+		{"PatchConfig", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPatchConfigRequest{Body: body(`[{"op":"replace","path":"/config/dynamic/hosts_resolver_mode","value":"other"}]`)}.Do(ctx, tp)
+		}, "PATCH", "/_plugins/_security/api/securityconfig", `[{"op":"replace","path":"/config/dynamic/hosts_resolver_mode","value":"other"}]`,
+			"security/patched.json", patched},
+		{"GetUsers", func(tp Transport) (*Response, interface{}, error) { return SecurityGetUsersRequest{}.Do(ctx, tp) },
+			"GET", "/_plugins/_security/api/internalusers", "",
+			"security/internalusers.json", &SecurityUsersResp{"kibanaro": {
+				OpendistroSecurityRoles: []string{},
+				BackendRoles:            []string{"kibanauser", "readall"},
+				Attributes:              map[string]string{"attribute1": "value1", "attribute2": "value2"},
+			}}},
+		{"GetUser", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityGetUsersRequest{Username: "kibanaro"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_security/api/internalusers/kibanaro", "",
+			"security/internalusers.json", &SecurityUsersResp{"kibanaro": {
+				OpendistroSecurityRoles: []string{},
+				BackendRoles:            []string{"kibanauser", "readall"},
+				Attributes:              map[string]string{"attribute1": "value1", "attribute2": "value2"},
+			}}},
+		{"PutUser", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPutUserRequest{Username: "analyst", Body: body(`{"password":"secret"}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_security/api/internalusers/analyst", `{"password":"secret"}`,
+			"security/created.json", created},
+		{"PatchUsers", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPatchUsersRequest{Username: "admin", Body: body(`[{"op":"add","path":"/backend_roles","value":["admin"]}]`)}.Do(ctx, tp)
+		}, "PATCH", "/_plugins/_security/api/internalusers/admin", `[{"op":"add","path":"/backend_roles","value":["admin"]}]`,
+			"security/patched.json", patched},
+		{"DeleteUser", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityDeleteUserRequest{Username: "analysts"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_security/api/internalusers/analysts", "",
+			"security/deleted.json", deleted},
+		{"GetRole", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityGetRolesRequest{Role: "analysts"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_security/api/roles/analysts", "",
+			"security/roles.json", &SecurityRolesResp{"analysts": {
+				Description:        "Read access to the public logs",
+				ClusterPermissions: []string{"cluster_composite_ops_ro"},
+				IndexPermissions: []SecurityIndexPermission{{
+					IndexPatterns:  []string{"logs-*"},
+					DLS:            `{"term":{"public":true}}`,
+					FLS:            []string{"~secret"},
+					MaskedFields:   []string{"ip"},
+					AllowedActions: []string{"read"},
+				}},
+				TenantPermissions: []SecurityTenantPermission{{TenantPatterns: []string{"sales"}, AllowedActions: []string{"kibana_all_read"}}},
+			}}},
+		{"PutRole", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPutRoleRequest{Role: "analyst", Body: body(`{"cluster_permissions":["cluster_monitor"]}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_security/api/roles/analyst", `{"cluster_permissions":["cluster_monitor"]}`,
+			"security/created.json", created},
+		{"PatchRoles", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPatchRolesRequest{Body: body(`[{"op":"remove","path":"/analysts"}]`)}.Do(ctx, tp)
+		}, "PATCH", "/_plugins/_security/api/roles", `[{"op":"remove","path":"/analysts"}]`,
+			"security/patched.json", patched},
+		{"DeleteRole", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityDeleteRoleRequest{Role: "analysts"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_security/api/roles/analysts", "",
+			"security/deleted.json", deleted},
+		{"GetRoleMappings", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityGetRoleMappingsRequest{}.Do(ctx, tp)
+		}, "GET", "/_plugins/_security/api/rolesmapping", "",
+			"security/rolesmapping.json", &SecurityRoleMappingsResp{"manage_snapshots": {
+				BackendRoles:    []string{"admin"},
+				AndBackendRoles: []string{},
+				Hosts:           []string{},
+				Users:           []string{"snapshots"},
+			}}},
+		{"PutRoleMapping", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPutRoleMappingRequest{Role: "analyst", Body: body(`{"users":["analyst"]}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_security/api/rolesmapping/analyst", `{"users":["analyst"]}`,
+			"security/created.json", created},
+		{"PatchRoleMappings", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPatchRoleMappingsRequest{Body: body(`[{"op":"remove","path":"/analysts"}]`)}.Do(ctx, tp)
+		}, "PATCH", "/_plugins/_security/api/rolesmapping", `[{"op":"remove","path":"/analysts"}]`,
+			"security/patched.json", patched},
+		{"DeleteRoleMapping", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityDeleteRoleMappingRequest{Role: "analysts"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_security/api/rolesmapping/analysts", "",
+			"security/deleted.json", deleted},
+		{"GetActionGroup", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityGetActionGroupsRequest{ActionGroup: "my-action-group"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_security/api/actiongroups/my-action-group", "",
+			"security/actiongroups.json", &SecurityActionGroupsResp{"my-action-group": {
+				AllowedActions: []string{
+					"indices:data/write/index*",
+					"indices:data/write/update*",
+					"indices:admin/mapping/put",
+					"indices:data/write/bulk*",
+					"read",
+					"write",
+				},
+				Type:        "index",
+				Description: "Index and update documents",
+			}}},
+		{"PutActionGroup", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPutActionGroupRequest{ActionGroup: "read", Body: body(`{"allowed_actions":["indices:data/read*"]}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_security/api/actiongroups/read", `{"allowed_actions":["indices:data/read*"]}`,
+			"security/created.json", created},
+		{"PatchActionGroups", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPatchActionGroupsRequest{Body: body(`[{"op":"remove","path":"/read"}]`)}.Do(ctx, tp)
+		}, "PATCH", "/_plugins/_security/api/actiongroups", `[{"op":"remove","path":"/read"}]`,
+			"security/patched.json", patched},
+		{"DeleteActionGroup", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityDeleteActionGroupRequest{ActionGroup: "read"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_security/api/actiongroups/read", "",
+			"security/deleted.json", deleted},
+		{"GetTenants", func(tp Transport) (*Response, interface{}, error) { return SecurityGetTenantsRequest{}.Do(ctx, tp) },
+			"GET", "/_plugins/_security/api/tenants", "",
+			"security/tenants.json", &SecurityTenantsResp{
+				"global_tenant": {Description: "Global tenant", Reserved: true},
+				"sales":         {Description: "Sales tenant"},
+			}},
+		{"PutTenant", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPutTenantRequest{Tenant: "sales", Body: body(`{"description":"Sales"}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_security/api/tenants/sales", `{"description":"Sales"}`,
+			"security/created.json", created},
+		{"PatchTenant", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityPatchTenantsRequest{Tenant: "sales", Body: body(`[{"op":"replace","path":"/description","value":"Sales"}]`)}.Do(ctx, tp)
+		}, "PATCH", "/_plugins/_security/api/tenants/sales", `[{"op":"replace","path":"/description","value":"Sales"}]`,
+			"security/patched.json", patched},
+		{"DeleteTenant", func(tp Transport) (*Response, interface{}, error) {
+			return SecurityDeleteTenantRequest{Tenant: "sales"}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_security/api/tenants/sales", "",
+			"security/deleted.json", deleted},
+	})
+
+	t.Run("Functional options", func(t *testing.T) {
+		tp := &recordingTransport{body: `{"status":"OK","message":"'sales' updated."}`}
+		api := New(tp)
+
+		_, data, err := api.Security.PutTenant(
+			api.Security.PutTenant.WithTenant("sales"),
+			api.Security.PutTenant.WithBody(strings.NewReader(`{"description":"Sales"}`)),
+			api.Security.PutTenant.WithContext(ctx),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if tp.req.URL.Path != "/_plugins/_security/api/tenants/sales" || *data != (SecurityStatusResp{Status: "OK", Message: "'sales' updated."}) {
+			t.Errorf("Unexpected response: %s, %+v", tp.req.URL.Path, data)
+		}
+	})
+}
@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func newSQLCloseFunc(t Transport) SQLClose {
+	return func(o ...func(*SQLCloseRequest)) (*Response, *SQLCloseResp, error) {
+		var r = SQLCloseRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// SQLClose closes a cursor before its last page.
+type SQLClose func(o ...func(*SQLCloseRequest)) (*Response, *SQLCloseResp, error)
+
+// SQLCloseRequest configures the SQL Close API request.
+type SQLCloseRequest struct {
+	Body io.Reader
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, SQLCloseResp and error.
+func (r SQLCloseRequest) Do(ctx context.Context, transport Transport) (*Response, *SQLCloseResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data SQLCloseResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_sql/close"))
+	path.WriteString("/_plugins/_sql/close")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f SQLClose) WithContext(v context.Context) func(*SQLCloseRequest) {
+	return func(r *SQLCloseRequest) {
+		r.ctx = v
+	}
+}
+
+// WithBody - the cursor, see SQLCloseBody.
+func (f SQLClose) WithBody(v io.Reader) func(*SQLCloseRequest) {
+	return func(r *SQLCloseRequest) {
+		r.Body = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f SQLClose) WithPretty() func(*SQLCloseRequest) {
+	return func(r *SQLCloseRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f SQLClose) WithHuman() func(*SQLCloseRequest) {
+	return func(r *SQLCloseRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f SQLClose) WithErrorTrace() func(*SQLCloseRequest) {
+	return func(r *SQLCloseRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f SQLClose) WithFilterPath(v ...string) func(*SQLCloseRequest) {
+	return func(r *SQLCloseRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f SQLClose) WithHeader(h map[string]string) func(*SQLCloseRequest) {
+	return func(r *SQLCloseRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f SQLClose) WithOpaqueID(s string) func(*SQLCloseRequest) {
+	return func(r *SQLCloseRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import "encoding/json"
+
+// The response formats of the SQL and PPL APIs; only jdbc and json are JSON.
+const (
+	SQLFormatJDBC = "jdbc"
+	SQLFormatJSON = "json"
+	SQLFormatCSV  = "csv"
+	SQLFormatRaw  = "raw"
+)
+
+// SQLQueryBody is the body of the SQL and PPL Query and Explain APIs. Set either the query,
+// with the fetch size to paginate the results, or the cursor of the previous page.
+type SQLQueryBody struct {
+	Query     string      `json:"query,omitempty"`
+	FetchSize int         `json:"fetch_size,omitempty"`
+	Filter    interface{} `json:"filter,omitempty"`
+	Cursor    string      `json:"cursor,omitempty"`
+}
+
+// SQLCloseBody is the body of the SQL Close API.
+type SQLCloseBody struct {
+	Cursor string `json:"cursor"`
+}
+
+// SQLCloseResp is the response of the SQL Close API.
+type SQLCloseResp struct {
+	Succeeded bool `json:"succeeded"`
+}
+
+// SQLResponse is the response of the SQL and PPL Query APIs in the jdbc format.
+// The schema is only returned with the first page of a cursor.
+type SQLResponse struct {
+	Schema   []SQLColumn         `json:"schema"`
+	DataRows [][]json.RawMessage `json:"datarows"`
+	Total    int                 `json:"total"`
+	Size     int                 `json:"size"`
+	Status   int                 `json:"status"`
+	Cursor   string              `json:"cursor,omitempty"`
+}
+
+// SQLColumn represents a column of the schema; the Alias is set when the column is renamed with AS.
+type SQLColumn struct {
+	Name  string `json:"name"`
+	Alias string `json:"alias,omitempty"`
+	Type  string `json:"type"`
+}
+
+// Label returns the alias of the column, or its name.
+func (c SQLColumn) Label() string {
+	if c.Alias != "" {
+		return c.Alias
+	}
+	return c.Name
+}
+
+// DecodeSQLResponse decodes the response of the SQL or PPL Query APIs in the jdbc format,
+// or returns the error when the response status indicates failure.
+func DecodeSQLResponse(res *Response) (*SQLResponse, error) {
+	var data SQLResponse
+	if err := res.Decode(&data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestSQL(t *testing.T) {
+	ctx := context.Background()
+	body := func(s string) io.Reader { return strings.NewReader(s) }
+
+	// The Query and Explain APIs return the raw response, decoded with DecodeSQLResponse
+	// or into the JSON plan.
+	query := func(res *Response, err error) (*Response, interface{}, error) {
+		if err != nil {
+			return res, nil, err
+		}
+		data, err := DecodeSQLResponse(res)
+		return res, data, err
+	}
+	explain := func(res *Response, err error) (*Response, interface{}, error) {
+		if err != nil {
+			return res, nil, err
+		}
+		var plan json.RawMessage
+		if err := res.Decode(&plan); err != nil {
+			return res, nil, err
+		}
+		return res, &plan, nil
+	}
+	plan := func(name string) *json.RawMessage {
+		raw := json.RawMessage(strings.TrimSpace(fixture(t, name)))
+		return &raw
+	}
+
+	testPlugin(t, pluginError{400, "sql/error.json"}, []pluginTest{
+		{"SQLQuery", func(tp Transport) (*Response, interface{}, error) {
+			return query(SQLQueryRequest{Body: body(`{"query":"SELECT firstname, lastname, age FROM accounts","fetch_size":2}`)}.Do(ctx, tp))
+		}, "POST", "/_plugins/_sql", `{"query":"SELECT firstname, lastname, age FROM accounts","fetch_size":2}`,
+			"sql/query.json", &SQLResponse{
+				Schema: []SQLColumn{{Name: "firstname", Type: "text"}, {Name: "lastname", Type: "text"}, {Name: "age", Type: "long"}},
+				DataRows: [][]json.RawMessage{
+					{json.RawMessage(`"Nanette"`), json.RawMessage(`"Bates"`), json.RawMessage(`28`)},
+					{json.RawMessage(`"Amber"`), json.RawMessage(`"Duke"`), json.RawMessage(`32`)},
+				},
+				Total:  4,
+				Size:   2,
+				Status: 200,
+				Cursor: "d:eyJhIjp7fSwicyI6IkRYRjFaWEo1UVc1a1JtVjBZMmdCQUFBQUFBQUFBQU1XZWpkdFRFRkZUMlpTZEZkeFdsWnJkRlZoYnpaeVVRPT0iLCJjIjpbeyJuYW1lIjoiZmlyc3RuYW1lIiwidHlwZSI6InRleHQifV0sImYiOjIsImkiOiJhY2NvdW50cyIsImwiOjJ9",
+			}},
+		{"SQLExplain", func(tp Transport) (*Response, interface{}, error) {
+			return explain(SQLExplainRequest{Body: body(`{"query":"SELECT firstname, lastname FROM accounts"}`)}.Do(ctx, tp))
+		}, "POST", "/_plugins/_sql/_explain", `{"query":"SELECT firstname, lastname FROM accounts"}`,
+			"sql/explain.json", plan("sql/explain.json")},
+		{"SQLClose", func(tp Transport) (*Response, interface{}, error) {
+			return SQLCloseRequest{Body: body(`{"cursor":"c1"}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_sql/close", `{"cursor":"c1"}`,
+			"sql/close.json", &SQLCloseResp{Succeeded: true}},
+		{"PPLQuery", func(tp Transport) (*Response, interface{}, error) {
+			return query(PPLQueryRequest{Body: body(`{"query":"source=accounts | fields firstname, age"}`)}.Do(ctx, tp))
+		}, "POST", "/_plugins/_ppl", `{"query":"source=accounts | fields firstname, age"}`,
+			"sql/ppl_query.json", &SQLResponse{
+				Schema: []SQLColumn{{Name: "firstname", Type: "string"}, {Name: "age", Type: "integer"}},
+				DataRows: [][]json.RawMessage{
+					{json.RawMessage(`"Amber"`), json.RawMessage(`32`)},
+					{json.RawMessage(`"Hattie"`), json.RawMessage(`36`)},
+				},
+				Total: 2,
+				Size:  2,
+			}},
+		{"PPLExplain", func(tp Transport) (*Response, interface{}, error) {
+			return explain(PPLExplainRequest{Body: body(`{"query":"source=accounts | fields firstname, age"}`)}.Do(ctx, tp))
+		}, "POST", "/_plugins/_ppl/_explain", `{"query":"source=accounts | fields firstname, age"}`,
+			"sql/ppl_explain.json", plan("sql/ppl_explain.json")},
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		tp := &recordingTransport{body: "title,year\nMoneyball,2011\n"}
+		api := New(tp)
+
+		res, err := api.SQL.Query(
+			api.SQL.Query.WithBody(strings.NewReader(`{"query":"SELECT title, year FROM movies"}`)),
+			api.SQL.Query.WithFormat(SQLFormatCSV),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if tp.req.URL.RawQuery != "format=csv" || res.String() != "[200 OK] title,year\nMoneyball,2011\n" {
+			t.Errorf("Unexpected response: %s, %s", tp.req.URL.RawQuery, res.String())
+		}
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		tp := &recordingTransport{body: `{
+			"schema": [{"name": "title", "type": "text"}, {"name": "COUNT(*)", "alias": "n", "type": "integer"}],
+			"datarows": [["Moneyball", 1]],
+			"total": 1, "size": 1, "status": 200
+		}`}
+
+		res, err := SQLQueryRequest{Body: strings.NewReader(`{"query":"SELECT title, COUNT(*) AS n FROM movies GROUP BY title"}`)}.Do(ctx, tp)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		data, err := DecodeSQLResponse(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(data.Schema) != 2 || data.Schema[1].Label() != "n" || string(data.DataRows[0][1]) != "1" || data.Cursor != "" {
+			t.Errorf("Unexpected response: %+v", data)
+		}
+	})
+}
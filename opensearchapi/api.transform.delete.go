@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newTransformDeleteFunc(t Transport) TransformDelete {
+	return func(o ...func(*TransformDeleteRequest)) (*Response, *TransformDeleteResp, error) {
+		var r = TransformDeleteRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// TransformDelete deletes transform jobs.
+type TransformDelete func(o ...func(*TransformDeleteRequest)) (*Response, *TransformDeleteResp, error)
+
+// TransformDeleteRequest configures the Transform Delete API request.
+type TransformDeleteRequest struct {
+	TransformID []string
+
+	Force bool
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, TransformDeleteResp and error.
+func (r TransformDeleteRequest) Do(ctx context.Context, transport Transport) (*Response, *TransformDeleteResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data TransformDeleteResp
+	)
+	method := "DELETE"
+
+	path.Grow(len("/_plugins/_transform") + 1 + len(strings.Join(r.TransformID, ",")))
+	path.WriteString("/_plugins/_transform")
+	path.WriteString("/")
+	path.WriteString(strings.Join(r.TransformID, ","))
+
+	params = make(map[string]string)
+
+	if r.Force {
+		params["force"] = "true"
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f TransformDelete) WithContext(v context.Context) func(*TransformDeleteRequest) {
+	return func(r *TransformDeleteRequest) {
+		r.ctx = v
+	}
+}
+
+// WithTransformID - the IDs of the transform jobs.
+func (f TransformDelete) WithTransformID(v ...string) func(*TransformDeleteRequest) {
+	return func(r *TransformDeleteRequest) {
+		r.TransformID = v
+	}
+}
+
+// WithForce - deletes the transform jobs even when they are enabled.
+func (f TransformDelete) WithForce() func(*TransformDeleteRequest) {
+	return func(r *TransformDeleteRequest) {
+		r.Force = true
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f TransformDelete) WithPretty() func(*TransformDeleteRequest) {
+	return func(r *TransformDeleteRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f TransformDelete) WithHuman() func(*TransformDeleteRequest) {
+	return func(r *TransformDeleteRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f TransformDelete) WithErrorTrace() func(*TransformDeleteRequest) {
+	return func(r *TransformDeleteRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f TransformDelete) WithFilterPath(v ...string) func(*TransformDeleteRequest) {
+	return func(r *TransformDeleteRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f TransformDelete) WithHeader(h map[string]string) func(*TransformDeleteRequest) {
+	return func(r *TransformDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f TransformDelete) WithOpaqueID(s string) func(*TransformDeleteRequest) {
+	return func(r *TransformDeleteRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newTransformExplainFunc(t Transport) TransformExplain {
+	return func(o ...func(*TransformExplainRequest)) (*Response, *TransformExplainResp, error) {
+		var r = TransformExplainRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// TransformExplain returns the status of transform jobs.
+type TransformExplain func(o ...func(*TransformExplainRequest)) (*Response, *TransformExplainResp, error)
+
+// TransformExplainRequest configures the Transform Explain API request.
+type TransformExplainRequest struct {
+	TransformID []string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, TransformExplainResp and error.
+func (r TransformExplainRequest) Do(ctx context.Context, transport Transport) (*Response, *TransformExplainResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data TransformExplainResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_transform") + 1 + len(strings.Join(r.TransformID, ",")) + len("/_explain"))
+	path.WriteString("/_plugins/_transform")
+	path.WriteString("/")
+	path.WriteString(strings.Join(r.TransformID, ","))
+	path.WriteString("/_explain")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f TransformExplain) WithContext(v context.Context) func(*TransformExplainRequest) {
+	return func(r *TransformExplainRequest) {
+		r.ctx = v
+	}
+}
+
+// WithTransformID - the IDs of the transform jobs.
+func (f TransformExplain) WithTransformID(v ...string) func(*TransformExplainRequest) {
+	return func(r *TransformExplainRequest) {
+		r.TransformID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f TransformExplain) WithPretty() func(*TransformExplainRequest) {
+	return func(r *TransformExplainRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f TransformExplain) WithHuman() func(*TransformExplainRequest) {
+	return func(r *TransformExplainRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f TransformExplain) WithErrorTrace() func(*TransformExplainRequest) {
+	return func(r *TransformExplainRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f TransformExplain) WithFilterPath(v ...string) func(*TransformExplainRequest) {
+	return func(r *TransformExplainRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f TransformExplain) WithHeader(h map[string]string) func(*TransformExplainRequest) {
+	return func(r *TransformExplainRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f TransformExplain) WithOpaqueID(s string) func(*TransformExplainRequest) {
+	return func(r *TransformExplainRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
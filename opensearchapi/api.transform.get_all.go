@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func newTransformGetAllFunc(t Transport) TransformGetAll {
+	return func(o ...func(*TransformGetAllRequest)) (*Response, *TransformGetAllResp, error) {
+		var r = TransformGetAllRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// TransformGetAll returns the transform jobs.
+type TransformGetAll func(o ...func(*TransformGetAllRequest)) (*Response, *TransformGetAllResp, error)
+
+// TransformGetAllRequest configures the Transform Get All API request.
+type TransformGetAllRequest struct {
+	From          *int
+	Size          *int
+	Search        string
+	SortField     string
+	SortDirection string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, TransformGetAllResp and error.
+func (r TransformGetAllRequest) Do(ctx context.Context, transport Transport) (*Response, *TransformGetAllResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data TransformGetAllResp
+	)
+	method := "GET"
+
+	path.Grow(len("/_plugins/_transform"))
+	path.WriteString("/_plugins/_transform")
+
+	params = make(map[string]string)
+
+	if r.From != nil {
+		params["from"] = strconv.FormatInt(int64(*r.From), 10)
+	}
+
+	if r.Size != nil {
+		params["size"] = strconv.FormatInt(int64(*r.Size), 10)
+	}
+
+	if r.Search != "" {
+		params["search"] = r.Search
+	}
+
+	if r.SortField != "" {
+		params["sortField"] = r.SortField
+	}
+
+	if r.SortDirection != "" {
+		params["sortDirection"] = r.SortDirection
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f TransformGetAll) WithContext(v context.Context) func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		r.ctx = v
+	}
+}
+
+// WithFrom - the offset of the first transform job.
+func (f TransformGetAll) WithFrom(v int) func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		r.From = &v
+	}
+}
+
+// WithSize - the number of transform jobs to return.
+func (f TransformGetAll) WithSize(v int) func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		r.Size = &v
+	}
+}
+
+// WithSearch - a query string to filter the transform jobs.
+func (f TransformGetAll) WithSearch(v string) func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		r.Search = v
+	}
+}
+
+// WithSortField - the field to sort the transform jobs by.
+func (f TransformGetAll) WithSortField(v string) func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		r.SortField = v
+	}
+}
+
+// WithSortDirection - the sort order, asc or desc.
+func (f TransformGetAll) WithSortDirection(v string) func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		r.SortDirection = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f TransformGetAll) WithPretty() func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f TransformGetAll) WithHuman() func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f TransformGetAll) WithErrorTrace() func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f TransformGetAll) WithFilterPath(v ...string) func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f TransformGetAll) WithHeader(h map[string]string) func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f TransformGetAll) WithOpaqueID(s string) func(*TransformGetAllRequest) {
+	return func(r *TransformGetAllRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
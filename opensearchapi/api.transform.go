@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// TransformBody is the body of the Transform Put and Preview APIs.
+type TransformBody struct {
+	Transform TransformJob `json:"transform"`
+}
+
+// TransformJob represents a transform job: it groups the documents of the source index matching
+// the data selection query, and indexes the aggregations of each group in the target index.
+// The fields set by the plugin are omitted when empty, so that a job returned by the Transform Get API
+// can be sent back to the Transform Put API.
+type TransformJob struct {
+	TransformID        string                     `json:"transform_id,omitempty"`
+	SchemaVersion      int                        `json:"schema_version,omitempty"`
+	Enabled            bool                       `json:"enabled"`
+	EnabledAt          int64                      `json:"enabled_at,omitempty"`
+	UpdatedAt          int64                      `json:"updated_at,omitempty"`
+	Continuous         bool                       `json:"continuous"`
+	Schedule           JobSchedule                `json:"schedule"`
+	MetadataID         string                     `json:"metadata_id,omitempty"`
+	Description        string                     `json:"description,omitempty"`
+	SourceIndex        string                     `json:"source_index"`
+	TargetIndex        string                     `json:"target_index"`
+	DataSelectionQuery json.RawMessage            `json:"data_selection_query,omitempty"`
+	PageSize           int                        `json:"page_size"`
+	Groups             []TransformGroup           `json:"groups"`
+	Aggregations       map[string]json.RawMessage `json:"aggregations,omitempty"`
+	User               json.RawMessage            `json:"user,omitempty"`
+}
+
+// AddAggregation adds an aggregation to the job, such as an opensearchdsl.Aggregation.
+func (t *TransformJob) AddAggregation(name string, aggregation interface{}) error {
+	b, err := json.Marshal(aggregation)
+	if err != nil {
+		return fmt.Errorf("cannot encode aggregation %q: %w", name, err)
+	}
+	if t.Aggregations == nil {
+		t.Aggregations = make(map[string]json.RawMessage)
+	}
+	t.Aggregations[name] = b
+	return nil
+}
+
+// TransformGroup represents a group of a transform job; exactly one of the fields must be set.
+type TransformGroup struct {
+	Terms         *TransformTerms         `json:"terms,omitempty"`
+	Histogram     *TransformHistogram     `json:"histogram,omitempty"`
+	DateHistogram *TransformDateHistogram `json:"date_histogram,omitempty"`
+}
+
+// TransformTerms groups the documents by the values of a field.
+// The TargetField defaults to the source field followed by the group type, eg. "customer_id_terms".
+type TransformTerms struct {
+	SourceField string `json:"source_field"`
+	TargetField string `json:"target_field,omitempty"`
+}
+
+// TransformHistogram groups the documents by a numeric field.
+type TransformHistogram struct {
+	SourceField string  `json:"source_field"`
+	TargetField string  `json:"target_field,omitempty"`
+	Interval    float64 `json:"interval"`
+}
+
+// TransformDateHistogram groups the documents by a date field, eg. {SourceField: "timestamp", CalendarInterval: "1d"}.
+type TransformDateHistogram struct {
+	SourceField      string `json:"source_field"`
+	TargetField      string `json:"target_field,omitempty"`
+	FixedInterval    string `json:"fixed_interval,omitempty"`
+	CalendarInterval string `json:"calendar_interval,omitempty"`
+	Timezone         string `json:"timezone,omitempty"`
+}
+
+// TransformResp is the response of the Transform Put and Get APIs.
+type TransformResp struct {
+	ID          string       `json:"_id"`
+	Version     int64        `json:"_version"`
+	SeqNo       int64        `json:"_seq_no"`
+	PrimaryTerm int64        `json:"_primary_term"`
+	Transform   TransformJob `json:"transform"`
+}
+
+// TransformGetAllResp is the response of the Transform Get All API.
+type TransformGetAllResp struct {
+	TotalTransforms int             `json:"total_transforms"`
+	Transforms      []TransformResp `json:"transforms"`
+}
+
+// TransformDeleteResp is the response of the Transform Delete API, a bulk response with an item by job.
+type TransformDeleteResp struct {
+	Took   int                              `json:"took"`
+	Errors bool                             `json:"errors"`
+	Items  []map[string]TransformDeleteItem `json:"items"`
+}
+
+// TransformDeleteItem represents the deletion of a transform job.
+type TransformDeleteItem struct {
+	Index   string          `json:"_index"`
+	ID      string          `json:"_id"`
+	Version int64           `json:"_version"`
+	Result  string          `json:"result"`
+	Status  int             `json:"status"`
+	Error   json.RawMessage `json:"error,omitempty"`
+}
+
+// TransformAcknowledgedResp is the response of the Transform Start and Stop APIs.
+type TransformAcknowledgedResp struct {
+	Acknowledged bool `json:"acknowledged"`
+}
+
+// TransformExplainResp is the response of the Transform Explain API, by transform job ID.
+type TransformExplainResp map[string]TransformJobExplain
+
+// TransformJobExplain represents the status of a transform job; the metadata is nil until the job runs.
+type TransformJobExplain struct {
+	MetadataID        string             `json:"metadata_id"`
+	TransformMetadata *TransformMetadata `json:"transform_metadata"`
+}
+
+// TransformMetadata represents the metadata of a transform job.
+type TransformMetadata struct {
+	TransformID   string         `json:"transform_id"`
+	LastUpdatedAt int64          `json:"last_updated_at"`
+	Status        string         `json:"status"`
+	FailureReason string         `json:"failure_reason"`
+	Stats         TransformStats `json:"stats"`
+}
+
+// TransformStats represents the statistics of a transform job.
+type TransformStats struct {
+	PagesProcessed     int64 `json:"pages_processed"`
+	DocumentsProcessed int64 `json:"documents_processed"`
+	DocumentsIndexed   int64 `json:"documents_indexed"`
+	IndexTimeInMillis  int64 `json:"index_time_in_millis"`
+	SearchTimeInMillis int64 `json:"search_time_in_millis"`
+}
+
+// TransformPreviewResp is the response of the Transform Preview API, the documents the job would index.
+type TransformPreviewResp struct {
+	Documents []json.RawMessage `json:"documents"`
+}
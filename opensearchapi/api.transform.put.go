@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func newTransformPutFunc(t Transport) TransformPut {
+	return func(o ...func(*TransformPutRequest)) (*Response, *TransformResp, error) {
+		var r = TransformPutRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// TransformPut creates or updates a transform job.
+type TransformPut func(o ...func(*TransformPutRequest)) (*Response, *TransformResp, error)
+
+// TransformPutRequest configures the Transform Put API request.
+type TransformPutRequest struct {
+	TransformID string
+
+	Body io.Reader
+
+	IfSeqNo       *int64
+	IfPrimaryTerm *int64
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, TransformResp and error.
+func (r TransformPutRequest) Do(ctx context.Context, transport Transport) (*Response, *TransformResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data TransformResp
+	)
+	method := "PUT"
+
+	path.Grow(len("/_plugins/_transform") + 1 + len(r.TransformID))
+	path.WriteString("/_plugins/_transform")
+	path.WriteString("/")
+	path.WriteString(r.TransformID)
+
+	params = make(map[string]string)
+
+	if r.IfSeqNo != nil {
+		params["if_seq_no"] = strconv.FormatInt(*r.IfSeqNo, 10)
+	}
+
+	if r.IfPrimaryTerm != nil {
+		params["if_primary_term"] = strconv.FormatInt(*r.IfPrimaryTerm, 10)
+	}
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if r.Body != nil {
+		req.Header[headerContentType] = headerContentTypeJSON
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f TransformPut) WithContext(v context.Context) func(*TransformPutRequest) {
+	return func(r *TransformPutRequest) {
+		r.ctx = v
+	}
+}
+
+// WithTransformID - the ID of the transform job.
+func (f TransformPut) WithTransformID(v string) func(*TransformPutRequest) {
+	return func(r *TransformPutRequest) {
+		r.TransformID = v
+	}
+}
+
+// WithBody - the transform job, see TransformBody.
+func (f TransformPut) WithBody(v io.Reader) func(*TransformPutRequest) {
+	return func(r *TransformPutRequest) {
+		r.Body = v
+	}
+}
+
+// WithIfSeqNo - only updates the transform job if it has this sequence number.
+func (f TransformPut) WithIfSeqNo(v int64) func(*TransformPutRequest) {
+	return func(r *TransformPutRequest) {
+		r.IfSeqNo = &v
+	}
+}
+
+// WithIfPrimaryTerm - only updates the transform job if it has this primary term.
+func (f TransformPut) WithIfPrimaryTerm(v int64) func(*TransformPutRequest) {
+	return func(r *TransformPutRequest) {
+		r.IfPrimaryTerm = &v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f TransformPut) WithPretty() func(*TransformPutRequest) {
+	return func(r *TransformPutRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f TransformPut) WithHuman() func(*TransformPutRequest) {
+	return func(r *TransformPutRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f TransformPut) WithErrorTrace() func(*TransformPutRequest) {
+	return func(r *TransformPutRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f TransformPut) WithFilterPath(v ...string) func(*TransformPutRequest) {
+	return func(r *TransformPutRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f TransformPut) WithHeader(h map[string]string) func(*TransformPutRequest) {
+	return func(r *TransformPutRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f TransformPut) WithOpaqueID(s string) func(*TransformPutRequest) {
+	return func(r *TransformPutRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
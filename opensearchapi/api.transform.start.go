@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func newTransformStartFunc(t Transport) TransformStart {
+	return func(o ...func(*TransformStartRequest)) (*Response, *TransformAcknowledgedResp, error) {
+		var r = TransformStartRequest{}
+		for _, f := range o {
+			f(&r)
+		}
+		return r.Do(r.ctx, t)
+	}
+}
+
+// ----- API Definition -------------------------------------------------------
+
+// TransformStart starts a transform job.
+type TransformStart func(o ...func(*TransformStartRequest)) (*Response, *TransformAcknowledgedResp, error)
+
+// TransformStartRequest configures the Transform Start API request.
+type TransformStartRequest struct {
+	TransformID string
+
+	Pretty     bool
+	Human      bool
+	ErrorTrace bool
+	FilterPath []string
+
+	Header http.Header
+
+	ctx context.Context
+}
+
+// Do executes the request and returns response, TransformAcknowledgedResp and error.
+func (r TransformStartRequest) Do(ctx context.Context, transport Transport) (*Response, *TransformAcknowledgedResp, error) {
+	var (
+		path   strings.Builder
+		params map[string]string
+
+		data TransformAcknowledgedResp
+	)
+	method := "POST"
+
+	path.Grow(len("/_plugins/_transform") + 1 + len(r.TransformID) + len("/_start"))
+	path.WriteString("/_plugins/_transform")
+	path.WriteString("/")
+	path.WriteString(r.TransformID)
+	path.WriteString("/_start")
+
+	params = make(map[string]string)
+
+	if r.Pretty {
+		params["pretty"] = "true"
+	}
+
+	if r.Human {
+		params["human"] = "true"
+	}
+
+	if r.ErrorTrace {
+		params["error_trace"] = "true"
+	}
+
+	if len(r.FilterPath) > 0 {
+		params["filter_path"] = strings.Join(r.FilterPath, ",")
+	}
+
+	req, err := newRequest(method, path.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(params) > 0 {
+		q := req.URL.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if len(r.Header) > 0 {
+		if len(req.Header) == 0 {
+			req.Header = r.Header
+		} else {
+			for k, vv := range r.Header {
+				for _, v := range vv {
+					req.Header.Add(k, v)
+				}
+			}
+		}
+	}
+
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	res, err := transport.Perform(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}
+
+	if err = response.Err(); err != nil {
+		return &response, nil, err
+	}
+
+	if len(r.FilterPath) != 0 {
+		return &response, nil, nil
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return &response, nil, err
+	}
+	return &response, &data, nil
+}
+
+// WithContext sets the request context.
+func (f TransformStart) WithContext(v context.Context) func(*TransformStartRequest) {
+	return func(r *TransformStartRequest) {
+		r.ctx = v
+	}
+}
+
+// WithTransformID - the ID of the transform job.
+func (f TransformStart) WithTransformID(v string) func(*TransformStartRequest) {
+	return func(r *TransformStartRequest) {
+		r.TransformID = v
+	}
+}
+
+// WithPretty makes the response body pretty-printed.
+func (f TransformStart) WithPretty() func(*TransformStartRequest) {
+	return func(r *TransformStartRequest) {
+		r.Pretty = true
+	}
+}
+
+// WithHuman makes statistical values human-readable.
+func (f TransformStart) WithHuman() func(*TransformStartRequest) {
+	return func(r *TransformStartRequest) {
+		r.Human = true
+	}
+}
+
+// WithErrorTrace includes the stack trace for errors in the response body.
+func (f TransformStart) WithErrorTrace() func(*TransformStartRequest) {
+	return func(r *TransformStartRequest) {
+		r.ErrorTrace = true
+	}
+}
+
+// WithFilterPath filters the properties of the response body.
+func (f TransformStart) WithFilterPath(v ...string) func(*TransformStartRequest) {
+	return func(r *TransformStartRequest) {
+		r.FilterPath = v
+	}
+}
+
+// WithHeader adds the headers to the HTTP request.
+func (f TransformStart) WithHeader(h map[string]string) func(*TransformStartRequest) {
+	return func(r *TransformStartRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		for k, v := range h {
+			r.Header.Add(k, v)
+		}
+	}
+}
+
+// WithOpaqueID adds the X-Opaque-Id header to the HTTP request.
+func (f TransformStart) WithOpaqueID(s string) func(*TransformStartRequest) {
+	return func(r *TransformStartRequest) {
+		if r.Header == nil {
+			r.Header = make(http.Header)
+		}
+		r.Header.Set("X-Opaque-Id", s)
+	}
+}
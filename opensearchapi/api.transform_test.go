@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestTransform(t *testing.T) {
+	ctx := context.Background()
+	body := func(s string) io.Reader { return strings.NewReader(s) }
+
+	raw := func(s string) json.RawMessage { return json.RawMessage(s) }
+
+	transform := &TransformResp{
+		ID: "sample", Version: 7, SeqNo: 13, PrimaryTerm: 1,
+		Transform: TransformJob{
+			TransformID:        "sample",
+			Enabled:            true,
+			EnabledAt:          1621363350001,
+			UpdatedAt:          1621363350001,
+			Continuous:         true,
+			Schedule:           JobSchedule{Interval: &JobInterval{StartTime: 1621363350001, Period: 1, Unit: "Minutes"}},
+			Description:        "Sample transform job",
+			SourceIndex:        "sample_index",
+			TargetIndex:        "sample_target",
+			DataSelectionQuery: raw(`{"match_all": {"boost": 1.0}}`),
+			PageSize:           1,
+			Groups: []TransformGroup{
+				{Terms: &TransformTerms{SourceField: "customer_gender", TargetField: "gender"}},
+				{DateHistogram: &TransformDateHistogram{SourceField: "order_date", TargetField: "day", CalendarInterval: "1d", Timezone: "UTC"}},
+			},
+			Aggregations: map[string]json.RawMessage{"quantity": raw(`{"sum": {"field": "total_quantity"}}`)},
+		},
+	}
+	acknowledged := &TransformAcknowledgedResp{Acknowledged: true}
+
+	testPlugin(t, pluginError{404, "transform/error.json"}, []pluginTest{
+		{"Put", func(tp Transport) (*Response, interface{}, error) {
+			return TransformPutRequest{TransformID: "sample", Body: body(`{"transform":{}}`)}.Do(ctx, tp)
+		}, "PUT", "/_plugins/_transform/sample", `{"transform":{}}`,
+			"transform/transform.json", transform},
+		{"Get", func(tp Transport) (*Response, interface{}, error) {
+			return TransformGetRequest{TransformID: "sample"}.Do(ctx, tp)
+		}, "GET", "/_plugins/_transform/sample", "",
+			"transform/transform.json", transform},
+		{"GetAll", func(tp Transport) (*Response, interface{}, error) {
+			return TransformGetAllRequest{}.Do(ctx, tp)
+		}, "GET", "/_plugins/_transform", "",
+			"transform/get_all.json", &TransformGetAllResp{
+				TotalTransforms: 1,
+				Transforms: []TransformResp{{
+					ID: "sample", SeqNo: 13, PrimaryTerm: 1,
+					Transform: TransformJob{
+						TransformID:        "sample",
+						UpdatedAt:          1621363350001,
+						Schedule:           JobSchedule{Interval: &JobInterval{StartTime: 1621363350001, Period: 1, Unit: "Minutes"}},
+						Description:        "Sample transform job",
+						SourceIndex:        "sample_index",
+						TargetIndex:        "sample_target",
+						DataSelectionQuery: raw(`{"match_all": {"boost": 1.0}}`),
+						PageSize:           1,
+						Groups: []TransformGroup{
+							{Histogram: &TransformHistogram{SourceField: "taxful_total_price", TargetField: "price_bucket", Interval: 5}},
+						},
+						Aggregations: map[string]json.RawMessage{"count": raw(`{"value_count": {"field": "order_id"}}`)},
+					},
+				}},
+			}},
+		{"Delete", func(tp Transport) (*Response, interface{}, error) {
+			return TransformDeleteRequest{TransformID: []string{"sample", "other"}}.Do(ctx, tp)
+		}, "DELETE", "/_plugins/_transform/sample,other", "",
+			"transform/delete.json", &TransformDeleteResp{
+				Took: 205,
+				Items: []map[string]TransformDeleteItem{
+					{"delete": {Index: ".opensearch-ism-config", ID: "sample", Version: 4, Result: "deleted", Status: 200}},
+					{"delete": {Index: ".opensearch-ism-config", ID: "other", Version: 1, Result: "not_found", Status: 404,
+						Error: raw(`{"type": "document_missing_exception", "reason": "[other]: document missing"}`)}},
+				},
+			}},
+		{"Start", func(tp Transport) (*Response, interface{}, error) {
+			return TransformStartRequest{TransformID: "sample"}.Do(ctx, tp)
+		}, "POST", "/_plugins/_transform/sample/_start", "",
+			"transform/acknowledged.json", acknowledged},
+		{"Stop", func(tp Transport) (*Response, interface{}, error) {
+			return TransformStopRequest{TransformID: "sample"}.Do(ctx, tp)
+		}, "POST", "/_plugins/_transform/sample/_stop", "",
+			"transform/acknowledged.json", acknowledged},
+		{"Explain", func(tp Transport) (*Response, interface{}, error) {
+			return TransformExplainRequest{TransformID: []string{"sample"}}.Do(ctx, tp)
+		}, "GET", "/_plugins/_transform/sample/_explain", "",
+			"transform/explain.json", &TransformExplainResp{
+				"sample": {MetadataID: "PZmGUFsvYKBrpgQJXDRRDA", TransformMetadata: &TransformMetadata{
+					TransformID:   "sample",
+					LastUpdatedAt: 1621883525873,
+					Status:        JobStatusFinished,
+					Stats: TransformStats{
+						PagesProcessed: 2, DocumentsProcessed: 1000, DocumentsIndexed: 40, IndexTimeInMillis: 5, SearchTimeInMillis: 9,
+					},
+				}},
+			}},
+		{"Preview", func(tp Transport) (*Response, interface{}, error) {
+			return TransformPreviewRequest{Body: body(`{"transform":{}}`)}.Do(ctx, tp)
+		}, "POST", "/_plugins/_transform/_preview", `{"transform":{}}`,
+			"transform/preview.json", &TransformPreviewResp{Documents: []json.RawMessage{
+				raw(`{"quantity": 862.0, "gender": "FEMALE", "day": "Friday"}`),
+				raw(`{"quantity": 682.0, "gender": "FEMALE", "day": "Monday"}`),
+			}}},
+	})
+
+	t.Run("Job", func(t *testing.T) {
+		job := TransformJob{
+			Enabled:     true,
+			Schedule:    JobSchedule{Cron: &ISMCron{Expression: "0 2 * * *", Timezone: "UTC"}},
+			SourceIndex: "orders",
+			TargetIndex: "orders-by-customer",
+			PageSize:    500,
+			Groups: []TransformGroup{
+				{Terms: &TransformTerms{SourceField: "customer_id"}},
+				{DateHistogram: &TransformDateHistogram{SourceField: "timestamp", CalendarInterval: "1d"}},
+			},
+		}
+		if err := job.AddAggregation("total", map[string]interface{}{"sum": map[string]string{"field": "price"}}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		b, err := json.Marshal(TransformBody{Transform: job})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expected := `{"transform":{"enabled":true,"continuous":false,"schedule":{"cron":{"expression":"0 2 * * *","timezone":"UTC"}},` +
+			`"source_index":"orders","target_index":"orders-by-customer","page_size":500,` +
+			`"groups":[{"terms":{"source_field":"customer_id"}},{"date_histogram":{"source_field":"timestamp","calendar_interval":"1d"}}],` +
+			`"aggregations":{"total":{"sum":{"field":"price"}}}}}`
+		if string(b) != expected {
+			t.Errorf("Unexpected body:\n%s\nwant:\n%s", b, expected)
+		}
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		tp := &recordingTransport{body: `{}`}
+		size := 10
+
+		if _, _, err := (TransformGetAllRequest{Search: "sample", Size: &size}).Do(ctx, tp); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if q := tp.req.URL.Query(); q.Get("search") != "sample" || q.Get("size") != "10" {
+			t.Errorf("Unexpected query: %s", tp.req.URL.RawQuery)
+		}
+
+		if _, _, err := (TransformDeleteRequest{TransformID: []string{"sample"}, Force: true}).Do(ctx, tp); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if tp.req.URL.RawQuery != "force=true" {
+			t.Errorf("Unexpected query: %s", tp.req.URL.RawQuery)
+		}
+	})
+}
@@ -0,0 +1,381 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BucketsAggregate represents the result of a multi-bucket aggregation, such as terms, significant_terms,
+// histogram, date_histogram, range, filters or composite.
+//
+// Keyed buckets, eg. of the filters aggregation, are returned in the order of the response with the key set.
+type BucketsAggregate struct {
+	Buckets []Bucket `json:"buckets"`
+
+	// DocCountErrorUpperBound and SumOtherDocCount are set for the terms aggregation.
+	DocCountErrorUpperBound int64 `json:"doc_count_error_upper_bound,omitempty"`
+	SumOtherDocCount        int64 `json:"sum_other_doc_count,omitempty"`
+
+	// DocCount and BgCount are set for the significant_terms aggregation.
+	DocCount int64 `json:"doc_count,omitempty"`
+	BgCount  int64 `json:"bg_count,omitempty"`
+
+	// AfterKey is set for the composite aggregation; pass it to the next request to get the next page.
+	AfterKey map[string]interface{} `json:"after_key,omitempty"`
+}
+
+// UnmarshalJSON decodes the aggregation, with the buckets as an array or an object by key.
+func (a *BucketsAggregate) UnmarshalJSON(b []byte) error {
+	type bucketsAggregate BucketsAggregate
+	var data struct {
+		bucketsAggregate
+		Buckets  json.RawMessage `json:"buckets"`
+		AfterKey json.RawMessage `json:"after_key"`
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	*a = BucketsAggregate(data.bucketsAggregate)
+
+	if len(data.AfterKey) > 0 {
+		if err := unmarshalNumbers(data.AfterKey, &a.AfterKey); err != nil {
+			return err
+		}
+	}
+
+	buckets := bytes.TrimSpace(data.Buckets)
+	if len(buckets) == 0 {
+		return nil
+	}
+	if buckets[0] != '{' {
+		return json.Unmarshal(data.Buckets, &a.Buckets)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(buckets))
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var bucket Bucket
+		if err := dec.Decode(&bucket); err != nil {
+			return err
+		}
+		if bucket.Key == nil {
+			bucket.Key = tok
+		}
+		a.Buckets = append(a.Buckets, bucket)
+	}
+	return nil
+}
+
+// Bucket represents a bucket of a multi-bucket aggregation, or the result of a single bucket aggregation,
+// such as filter, nested, global or missing.
+//
+// The results of the sub-aggregations are kept raw; use Aggregation to decode them.
+type Bucket struct {
+	// Key is a string, a json.Number, or a map for the composite aggregation.
+	// It is nil for the result of a single bucket aggregation.
+	Key         interface{} `json:"key,omitempty"`
+	KeyAsString string      `json:"key_as_string,omitempty"`
+	DocCount    int64       `json:"doc_count"`
+
+	// DocCountErrorUpperBound is set for the terms aggregation with show_term_doc_count_error.
+	DocCountErrorUpperBound *int64 `json:"doc_count_error_upper_bound,omitempty"`
+
+	// From and To are set for the range and date_range aggregations.
+	From         *float64 `json:"from,omitempty"`
+	To           *float64 `json:"to,omitempty"`
+	FromAsString string   `json:"from_as_string,omitempty"`
+	ToAsString   string   `json:"to_as_string,omitempty"`
+
+	// Score and BgCount are set for the significant_terms aggregation.
+	Score   *float64 `json:"score,omitempty"`
+	BgCount *int64   `json:"bg_count,omitempty"`
+
+	Aggregations map[string]json.RawMessage `json:"-"`
+
+	aggregationTypes map[string]string
+}
+
+// bucketFields lists the keys of a bucket which are not sub-aggregations.
+var bucketFields = map[string]bool{
+	"key":                         true,
+	"key_as_string":               true,
+	"doc_count":                   true,
+	"doc_count_error_upper_bound": true,
+	"from":                        true,
+	"to":                          true,
+	"from_as_string":              true,
+	"to_as_string":                true,
+	"score":                       true,
+	"bg_count":                    true,
+}
+
+// UnmarshalJSON decodes the bucket, with the remaining keys as the sub-aggregations.
+func (b *Bucket) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	type bucket Bucket
+	var v bucket
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*b = Bucket(v)
+
+	if key, ok := fields["key"]; ok {
+		if err := unmarshalNumbers(key, &b.Key); err != nil {
+			return err
+		}
+	}
+
+	aggs := make(map[string]json.RawMessage)
+	for k, raw := range fields {
+		if !bucketFields[k] {
+			aggs[k] = raw
+		}
+	}
+	if len(aggs) > 0 {
+		b.Aggregations, b.aggregationTypes = splitTypedKeys(aggs, isAggregateType)
+	}
+	return nil
+}
+
+// KeyString returns the key of the bucket as a string, formatted if the response has one.
+func (b *Bucket) KeyString() string {
+	if b.KeyAsString != "" {
+		return b.KeyAsString
+	}
+	switch key := b.Key.(type) {
+	case nil:
+		return ""
+	case string:
+		return key
+	case json.Number:
+		return key.String()
+	default:
+		v, _ := json.Marshal(key)
+		return string(v)
+	}
+}
+
+// Aggregation decodes the sub-aggregation with the given name into v.
+// It returns false when the bucket has no such aggregation.
+func (b *Bucket) Aggregation(name string, v interface{}) (bool, error) {
+	return decodeAggregation(b.Aggregations, name, v)
+}
+
+// AggregationType returns the type of the sub-aggregation with the given name, eg. "sterms" or "avg".
+// It is empty unless the request sets TypedKeys.
+func (b *Bucket) AggregationType(name string) string {
+	return b.aggregationTypes[name]
+}
+
+// TypedAggregation decodes the sub-aggregation with the given name into the aggregate type for its type.
+// It requires the request to set TypedKeys; see DecodeAggregate.
+func (b *Bucket) TypedAggregation(name string) (interface{}, error) {
+	return decodeTypedAggregation(b.Aggregations, b.aggregationTypes, name)
+}
+
+// ValueAggregate represents the result of a single value metrics aggregation, such as avg, sum, min, max,
+// cardinality or value_count, or of a pipeline aggregation, such as avg_bucket or derivative.
+type ValueAggregate struct {
+	// Value is nil when there is no value, eg. the avg of no documents.
+	Value         *float64 `json:"value"`
+	ValueAsString string   `json:"value_as_string,omitempty"`
+
+	// Keys is set for the min_bucket and max_bucket aggregations.
+	Keys []string `json:"keys,omitempty"`
+}
+
+// StatsAggregate represents the result of the stats, extended_stats, stats_bucket
+// and extended_stats_bucket aggregations.
+type StatsAggregate struct {
+	Count int64    `json:"count"`
+	Min   *float64 `json:"min"`
+	Max   *float64 `json:"max"`
+	Avg   *float64 `json:"avg"`
+	Sum   float64  `json:"sum"`
+
+	// SumOfSquares, Variance and StdDeviation are set for the extended stats.
+	SumOfSquares *float64 `json:"sum_of_squares,omitempty"`
+	Variance     *float64 `json:"variance,omitempty"`
+	StdDeviation *float64 `json:"std_deviation,omitempty"`
+}
+
+// PercentilesAggregate represents the result of the percentiles, percentile_ranks and percentiles_bucket
+// aggregations.
+type PercentilesAggregate struct {
+	// Values is keyed by the percent, eg. "99.0", or by the value for the percentile ranks.
+	Values map[string]*float64 `json:"values"`
+}
+
+// UnmarshalJSON decodes the values, as an object by key or as an array with keyed set to false.
+func (a *PercentilesAggregate) UnmarshalJSON(b []byte) error {
+	var data struct {
+		Values json.RawMessage `json:"values"`
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	values := bytes.TrimSpace(data.Values)
+	if len(values) == 0 || values[0] != '[' {
+		return json.Unmarshal(data.Values, &a.Values)
+	}
+
+	var items []struct {
+		Key   float64  `json:"key"`
+		Value *float64 `json:"value"`
+	}
+	if err := json.Unmarshal(values, &items); err != nil {
+		return err
+	}
+	a.Values = make(map[string]*float64, len(items))
+	for _, item := range items {
+		key := strconv.FormatFloat(item.Key, 'f', -1, 64)
+		if !strings.Contains(key, ".") {
+			key += ".0"
+		}
+		a.Values[key] = item.Value
+	}
+	return nil
+}
+
+// TopHitsAggregate represents the result of the top_hits aggregation.
+type TopHitsAggregate struct {
+	Hits SearchHits `json:"hits"`
+}
+
+// DecodeAggregate decodes the result of an aggregation of the given type, as returned with TypedKeys,
+// into a *BucketsAggregate, *Bucket, *ValueAggregate, *StatsAggregate, *PercentilesAggregate or *TopHitsAggregate.
+//
+// The type is only known when the search request sets TypedKeys, eg. SearchRequest.TypedKeys,
+// from the prefix of the aggregation name in the response; see SearchResponse.AggregationType.
+// The result of other types is returned as json.RawMessage.
+func DecodeAggregate(typ string, raw json.RawMessage) (interface{}, error) {
+	v, _ := newAggregate(typ)
+	if v == nil {
+		return raw, nil
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return nil, fmt.Errorf("cannot decode %s aggregation: %w", typ, err)
+	}
+	return v, nil
+}
+
+// newAggregate returns the aggregate to decode an aggregation of the given type into,
+// or nil for the types decoded as json.RawMessage. It returns false when the type is unknown.
+func newAggregate(typ string) (interface{}, bool) {
+	switch typ {
+	case "sterms", "lterms", "dterms", "umterms", "multi_terms", "srareterms", "lrareterms", "umrareterms",
+		"sigsterms", "siglterms", "umsigterms",
+		"histogram", "date_histogram", "auto_date_histogram", "variable_width_histogram",
+		"range", "date_range", "geo_distance", "ip_range",
+		"filters", "adjacency_matrix", "composite", "geohash_grid", "geotile_grid":
+		return &BucketsAggregate{}, true
+	case "filter", "nested", "reverse_nested", "global", "missing", "sampler", "children", "parent":
+		return &Bucket{}, true
+	case "avg", "sum", "min", "max", "cardinality", "value_count", "weighted_avg", "median_absolute_deviation",
+		"simple_value", "derivative", "bucket_metric_value":
+		return &ValueAggregate{}, true
+	case "stats", "extended_stats", "stats_bucket", "extended_stats_bucket":
+		return &StatsAggregate{}, true
+	case "tdigest_percentiles", "hdr_percentiles", "tdigest_percentile_ranks", "hdr_percentile_ranks",
+		"percentiles_bucket":
+		return &PercentilesAggregate{}, true
+	case "top_hits":
+		return &TopHitsAggregate{}, true
+	case "scripted_metric", "geo_bounds", "geo_centroid", "matrix_stats", "unmapped_sampler":
+		return nil, true
+	}
+	return nil, false
+}
+
+// isAggregateType reports whether the type is a known aggregation type, as returned with TypedKeys.
+func isAggregateType(typ string) bool {
+	_, ok := newAggregate(typ)
+	return ok
+}
+
+// isSuggestionType reports whether the type is a known suggester type, as returned with TypedKeys.
+func isSuggestionType(typ string) bool {
+	return typ == "term" || typ == "phrase" || typ == "completion"
+}
+
+// splitTypedKeys removes the type prefix from the keys returned with TypedKeys, eg. "sterms#genres",
+// and returns the types by name.
+//
+// Only the prefixes of known types are removed, so that names containing '#' are kept
+// when the request doesn't set TypedKeys.
+func splitTypedKeys(m map[string]json.RawMessage, known func(string) bool) (map[string]json.RawMessage, map[string]string) {
+	var types map[string]string
+	for key := range m {
+		if i := strings.IndexByte(key, '#'); i > 0 && known(key[:i]) {
+			types = make(map[string]string, len(m))
+			break
+		}
+	}
+	if types == nil {
+		return m, nil
+	}
+
+	out := make(map[string]json.RawMessage, len(m))
+	for key, raw := range m {
+		if i := strings.IndexByte(key, '#'); i > 0 && known(key[:i]) {
+			types[key[i+1:]] = key[:i]
+			key = key[i+1:]
+		}
+		out[key] = raw
+	}
+	return out, types
+}
+
+func decodeAggregation(aggs map[string]json.RawMessage, name string, v interface{}) (bool, error) {
+	raw, ok := aggs[name]
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return true, fmt.Errorf("cannot decode aggregation %q: %w", name, err)
+	}
+	return true, nil
+}
+
+func decodeTypedAggregation(aggs map[string]json.RawMessage, types map[string]string, name string) (interface{}, error) {
+	raw, ok := aggs[name]
+	if !ok {
+		return nil, fmt.Errorf("aggregation %q not found", name)
+	}
+	typ, ok := types[name]
+	if !ok {
+		return nil, fmt.Errorf("aggregation %q has no type, set TypedKeys on the request", name)
+	}
+	return DecodeAggregate(typ, raw)
+}
+
+// unmarshalNumbers decodes the JSON into v, with the numbers as json.Number to preserve their precision.
+func unmarshalNumbers(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
@@ -22,12 +22,21 @@
 
 package opensearchapi
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
 
 // Error represents the API error response.
 type Error struct {
 	Err    Err `json:"error"`
 	Status int `json:"status"`
+
+	// OpaqueID is the X-Opaque-Id of the request, as echoed by OpenSearch.
+	OpaqueID string `json:"-"`
 }
 
 // Err represents the error of an API error response
@@ -36,18 +45,243 @@ type Err struct {
 	Type      string      `json:"type"`
 	Reason    string      `json:"reason"`
 	Index     string      `json:"index,omitempty"`
-	IndexUUID string      `json:"index_uudi,omitempty"`
+	IndexUUID string      `json:"index_uuid,omitempty"`
+	Shard     string      `json:"shard,omitempty"`
+	Line      int         `json:"line,omitempty"`
+	Col       int         `json:"col,omitempty"`
+
+	// Script errors
+	Script      string          `json:"script,omitempty"`
+	Lang        string          `json:"lang,omitempty"`
+	ScriptStack []string        `json:"script_stack,omitempty"`
+	Position    *ScriptPosition `json:"position,omitempty"`
+
+	// Search phase errors
+	Phase        string        `json:"phase,omitempty"`
+	Grouped      bool          `json:"grouped,omitempty"`
+	FailedShards []FailedShard `json:"failed_shards,omitempty"`
+
+	Header   ErrorHeader `json:"header,omitempty"`
+	CausedBy *Cause      `json:"caused_by,omitempty"`
 }
 
-// RootCause represents the root_cause of an API error response
-type RootCause struct {
+// Cause represents a cause of an API error response, such as the caused_by or a root_cause of the error.
+// The chain of causes can be inspected with errors.As and errors.Is.
+type Cause struct {
 	Type      string `json:"type"`
 	Reason    string `json:"reason"`
 	Index     string `json:"index,omitempty"`
-	IndexUUID string `json:"index_uudi,omitempty"`
+	IndexUUID string `json:"index_uuid,omitempty"`
+	Shard     string `json:"shard,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Col       int    `json:"col,omitempty"`
+
+	Script      string          `json:"script,omitempty"`
+	Lang        string          `json:"lang,omitempty"`
+	ScriptStack []string        `json:"script_stack,omitempty"`
+	Position    *ScriptPosition `json:"position,omitempty"`
+
+	Header   ErrorHeader `json:"header,omitempty"`
+	CausedBy *Cause      `json:"caused_by,omitempty"`
+}
+
+// RootCause represents the root_cause of an API error response
+type RootCause = Cause
+
+// FailedShard represents a shard failure of a search phase error.
+type FailedShard struct {
+	Shard  int    `json:"shard"`
+	Index  string `json:"index,omitempty"`
+	Node   string `json:"node,omitempty"`
+	Reason *Cause `json:"reason,omitempty"`
+}
+
+// ScriptPosition represents the position of a script error.
+type ScriptPosition struct {
+	Offset int `json:"offset"`
+	Start  int `json:"start"`
+	End    int `json:"end"`
+}
+
+// ErrorHeader represents the headers of an API error, such as WWW-Authenticate.
+//
+// OpenSearch returns a single value as a string, and multiple values as an array.
+type ErrorHeader map[string][]string
+
+// UnmarshalJSON decodes both single and multiple header values.
+func (h *ErrorHeader) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	header := make(ErrorHeader, len(raw))
+	for k, v := range raw {
+		var values []string
+		if err := json.Unmarshal(v, &values); err != nil {
+			var value string
+			if err := json.Unmarshal(v, &value); err != nil {
+				return fmt.Errorf("cannot decode header %q: %w", k, err)
+			}
+			values = []string{value}
+		}
+		header[k] = values
+	}
+	*h = header
+
+	return nil
 }
 
 // Error returns a string.
 func (e *Error) Error() string {
-	return fmt.Sprintf("status: %d, type: %s, reason: %s, root_cause: %s", e.Status, e.Err.Type, e.Err.Reason, e.Err.RootCause)
+	rootCauses := make([]string, len(e.Err.RootCause))
+	for i := range e.Err.RootCause {
+		rootCauses[i] = e.Err.RootCause[i].Error()
+	}
+
+	msg := fmt.Sprintf("status: %d, type: %s, reason: %s, root_cause: [%s]", e.Status, e.Err.Type, e.Err.Reason, strings.Join(rootCauses, ", "))
+	if e.Err.CausedBy != nil {
+		msg += ", caused_by: " + e.Err.CausedBy.Error()
+	}
+	if e.OpaqueID != "" {
+		msg += ", opaque_id: " + e.OpaqueID
+	}
+	return msg
+}
+
+// Unwrap returns the cause of the error, if any.
+func (e *Error) Unwrap() error {
+	if e.Err.CausedBy == nil {
+		return nil
+	}
+	return e.Err.CausedBy
+}
+
+// Error returns a string.
+func (c *Cause) Error() string {
+	return c.Type + ": " + c.Reason
+}
+
+// Unwrap returns the nested cause, if any.
+func (c *Cause) Unwrap() error {
+	if c.CausedBy == nil {
+		return nil
+	}
+	return c.CausedBy
+}
+
+// StringError represents an API error response which is not a structured OpenSearch error,
+// for example a response from a proxy.
+type StringError struct {
+	Status int
+	Body   string
+
+	// OpaqueID is the X-Opaque-Id of the request, as echoed by OpenSearch.
+	OpaqueID string
+}
+
+// Error returns a string.
+func (e *StringError) Error() string {
+	var msg string
+	if e.Body == "" {
+		msg = fmt.Sprintf("%d %s", e.Status, http.StatusText(e.Status))
+	} else {
+		msg = fmt.Sprintf("status: %d, error: %s", e.Status, e.Body)
+	}
+	if e.OpaqueID != "" {
+		msg += ", opaque_id: " + e.OpaqueID
+	}
+	return msg
+}
+
+// ErrorStatus returns the HTTP status of the API error in the chain of err,
+// or 0 when err is not an API error.
+func ErrorStatus(err error) int {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Status
+	}
+	var strErr *StringError
+	if errors.As(err, &strErr) {
+		return strErr.Status
+	}
+	return 0
+}
+
+// HasErrorType returns true when the API error in the chain of err, one of its causes
+// or one of its shard failures is of the given type, eg. "index_not_found_exception".
+func HasErrorType(err error, typ string) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	if apiErr.Err.Type == typ || causeHasType(apiErr.Err.CausedBy, typ) {
+		return true
+	}
+	for i := range apiErr.Err.RootCause {
+		if causeHasType(&apiErr.Err.RootCause[i], typ) {
+			return true
+		}
+	}
+	for _, f := range apiErr.Err.FailedShards {
+		if causeHasType(f.Reason, typ) {
+			return true
+		}
+	}
+	return false
+}
+
+// causeHasType returns true when the cause or one of its nested causes is of the given type.
+func causeHasType(c *Cause, typ string) bool {
+	for ; c != nil; c = c.CausedBy {
+		if c.Type == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound returns true when err is an API error for a missing resource, such as an index or a document.
+func IsNotFound(err error) bool {
+	return ErrorStatus(err) == http.StatusNotFound ||
+		HasErrorType(err, "index_not_found_exception") ||
+		HasErrorType(err, "resource_not_found_exception")
+}
+
+// IsConflict returns true when err is an API error for a conflict, such as a version conflict.
+func IsConflict(err error) bool {
+	return ErrorStatus(err) == http.StatusConflict || IsVersionConflict(err)
+}
+
+// IsVersionConflict returns true when err is an API error for a version, sequence number or primary term conflict.
+func IsVersionConflict(err error) bool {
+	return HasErrorType(err, "version_conflict_engine_exception")
+}
+
+// IsIndexAlreadyExists returns true when err is an API error for the creation of an index which already exists.
+func IsIndexAlreadyExists(err error) bool {
+	return HasErrorType(err, "resource_already_exists_exception") ||
+		HasErrorType(err, "index_already_exists_exception")
+}
+
+// IsTooManyRequests returns true when err is an API error for a rejected request,
+// such as a full thread pool queue or a tripped circuit breaker.
+func IsTooManyRequests(err error) bool {
+	return ErrorStatus(err) == http.StatusTooManyRequests ||
+		HasErrorType(err, "es_rejected_execution_exception") ||
+		HasErrorType(err, "circuit_breaking_exception")
+}
+
+// IsRetryable returns true when err is an API error which may succeed when the request is retried,
+// such as a rejected request or an unavailable cluster.
+func IsRetryable(err error) bool {
+	if IsTooManyRequests(err) {
+		return true
+	}
+	switch ErrorStatus(err) {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
 }
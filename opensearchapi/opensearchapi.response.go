@@ -29,6 +29,7 @@ package opensearchapi
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -39,47 +40,131 @@ import (
 )
 
 // Response represents the API response.
+//
+// The methods String, Err and Decode read the body once and cache it, so they can be called
+// in any order; the Body is replaced with a reader of the cached body.
 type Response struct {
 	StatusCode int
 	Header     http.Header
 	Body       io.ReadCloser
+
+	body     []byte
+	bodyErr  error
+	bodyRead bool
+}
+
+// DecodeOption configures the JSON decoder used by Decode.
+type DecodeOption func(*json.Decoder)
+
+// DisallowUnknownFields makes Decode return an error when the body contains
+// fields which are not present in the destination value.
+//
+// The json.Decoder doesn't apply it to the types with an UnmarshalJSON method;
+// SearchResponse and MsearchResponse are checked separately.
+func DisallowUnknownFields() DecodeOption {
+	return func(d *json.Decoder) { d.DisallowUnknownFields() }
+}
+
+// UseNumber makes Decode unmarshal numbers into interface{} values as json.Number instead of float64.
+func UseNumber() DecodeOption {
+	return func(d *json.Decoder) { d.UseNumber() }
+}
+
+// readBody reads and closes the body on the first call, and returns the cached body afterwards.
+func (r *Response) readBody() ([]byte, error) {
+	if r.bodyRead {
+		return r.body, r.bodyErr
+	}
+	r.bodyRead = true
+
+	if r.Body == nil {
+		return nil, nil
+	}
+
+	r.body, r.bodyErr = io.ReadAll(r.Body)
+	r.Body.Close() // errcheck exclude
+	r.Body = ioutil.NopCloser(bytes.NewReader(r.body))
+
+	return r.body, r.bodyErr
+}
+
+// Bytes returns the response body.
+func (r *Response) Bytes() ([]byte, error) {
+	return r.readBody()
+}
+
+// Decode decodes the JSON body into v, or returns the error when the response status indicates failure.
+//
+// The body is closed, so there is no need to close it when using Decode.
+func (r *Response) Decode(v interface{}, opts ...DecodeOption) error {
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	body, err := r.readBody()
+	if err != nil {
+		return fmt.Errorf("error reading response body: %w", err)
+	}
+	if len(body) == 0 {
+		return errors.New("cannot decode response: empty body")
+	}
+
+	if sv, ok := v.(strictDecoder); ok && disallowsUnknownFields(opts) {
+		if err := newDecoder(body, opts).Decode(sv.strictValue()); err != nil {
+			return fmt.Errorf("cannot decode response: %w", err)
+		}
+	}
+
+	if err := newDecoder(body, opts).Decode(v); err != nil {
+		return fmt.Errorf("cannot decode response: %w", err)
+	}
+	return nil
+}
+
+// strictDecoder is implemented by the responses decoded with an UnmarshalJSON method,
+// to which the json.Decoder doesn't pass DisallowUnknownFields; strictValue returns
+// a value with the same fields and without the method, to check the body for unknown fields.
+type strictDecoder interface {
+	strictValue() interface{}
+}
+
+func newDecoder(body []byte, opts []DecodeOption) *json.Decoder {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	for _, opt := range opts {
+		opt(dec)
+	}
+	return dec
+}
+
+// disallowsUnknownFields reports whether the options make the decoder reject unknown fields.
+func disallowsUnknownFields(opts []DecodeOption) bool {
+	var v struct{}
+	return newDecoder([]byte(`{"unknown":true}`), opts).Decode(&v) != nil
 }
 
 // String returns the response as a string.
 //
 // The intended usage is for testing or debugging only.
 func (r *Response) String() string {
-	var (
-		out = new(bytes.Buffer)
-		b1  = bytes.NewBuffer([]byte{})
-		b2  = bytes.NewBuffer([]byte{})
-		tr  io.Reader
-	)
-
-	if r != nil && r.Body != nil {
-		tr = io.TeeReader(r.Body, b1)
-		defer r.Body.Close()
-
-		if _, err := io.Copy(b2, tr); err != nil {
-			out.WriteString(fmt.Sprintf("<error reading response body: %v>", err))
-			return out.String()
-		}
-		defer func() { r.Body = ioutil.NopCloser(b1) }()
-	}
+	out := new(bytes.Buffer)
 
-	if r != nil {
-		out.WriteString(fmt.Sprintf("[%d %s]", r.StatusCode, http.StatusText(r.StatusCode)))
-		if r.StatusCode > 0 {
-			out.WriteRune(' ')
-		}
-	} else {
+	if r == nil {
 		out.WriteString("[0 <nil>]")
+		return out.String()
 	}
 
-	if r != nil && r.Body != nil {
-		out.ReadFrom(b2) // errcheck exclude (*bytes.Buffer).ReadFrom
+	body, err := r.readBody()
+	if err != nil {
+		out.WriteString(fmt.Sprintf("<error reading response body: %v>", err))
+		return out.String()
 	}
 
+	out.WriteString(fmt.Sprintf("[%d %s]", r.StatusCode, http.StatusText(r.StatusCode)))
+	if r.StatusCode > 0 {
+		out.WriteRune(' ')
+	}
+	out.Write(body)
+
 	return out.String()
 }
 
@@ -110,21 +195,25 @@ func (r *Response) HasWarnings() bool {
 }
 
 // Err returns an error when the response status indicates failures.
+//
+// It reads the body, which remains available to String, Decode and Bytes.
+//
+// The error is an *Error when the body is a structured OpenSearch error,
+// or a *StringError otherwise.
 func (r *Response) Err() error {
 	if r.IsError() {
-		if r.Body == nil {
-			return fmt.Errorf(r.Status())
-		}
-		body, err := io.ReadAll(r.Body)
+		opaqueID := r.Header.Get("X-Opaque-Id")
+		body, err := r.readBody()
 		if err != nil {
-			return fmt.Errorf(r.Status())
+			return &StringError{Status: r.StatusCode, OpaqueID: opaqueID}
 		}
 		var e *Error
 		err = json.Unmarshal(body, &e)
-		if err == nil && !reflect.ValueOf(e.Err).IsZero() {
+		if err == nil && e != nil && !reflect.ValueOf(e.Err).IsZero() {
+			e.OpaqueID = opaqueID
 			return e
 		}
-		return fmt.Errorf("status: %d, error: %s", r.StatusCode, string(body))
+		return &StringError{Status: r.StatusCode, Body: string(body), OpaqueID: opaqueID}
 	}
 	return nil
 }
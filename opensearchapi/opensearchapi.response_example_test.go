@@ -76,3 +76,25 @@ func ExampleResponse_String() {
 	// ...
 	// }
 }
+
+func ExampleResponse_Decode() {
+	client, _ := opensearch.NewDefaultClient()
+
+	res, err := client.Info()
+	if err != nil {
+		log.Fatalf("ERROR: %v", err)
+	}
+
+	// Decode the body, or get the error of an error response (4xx, 5xx)
+	//
+	var info struct {
+		ClusterName string `json:"cluster_name"`
+	}
+	if err := res.Decode(&info); err != nil {
+		log.Fatalf("ERROR: %v", err)
+	}
+
+	log.Println(info.ClusterName)
+
+	// opensearch-go
+}
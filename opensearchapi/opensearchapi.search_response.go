@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// SearchResponse represents the response of the Search, Scroll and SearchTemplate APIs,
+// and of each search in the Msearch and MsearchTemplate APIs.
+//
+// Aggregations and suggestions are kept raw; use Aggregation and Suggestions to decode them.
+// With TypedKeys set on the request, the type prefix is removed from their names,
+// and the types are available from AggregationType and TypedAggregation.
+type SearchResponse struct {
+	Took            int        `json:"took"`
+	TimedOut        bool       `json:"timed_out"`
+	TerminatedEarly bool       `json:"terminated_early,omitempty"`
+	Shards          ShardsInfo `json:"_shards"`
+	Hits            SearchHits `json:"hits"`
+
+	Aggregations map[string]json.RawMessage `json:"aggregations,omitempty"`
+	Suggest      map[string]json.RawMessage `json:"suggest,omitempty"`
+
+	ScrollID string `json:"_scroll_id,omitempty"`
+	PitID    string `json:"pit_id,omitempty"`
+
+	// Status and Error are set for the failed searches of the Msearch API.
+	Status int  `json:"status,omitempty"`
+	Error  *Err `json:"error,omitempty"`
+
+	aggregationTypes map[string]string
+}
+
+// UnmarshalJSON decodes the response, removing the type prefix of the aggregations and suggestions.
+func (r *SearchResponse) UnmarshalJSON(b []byte) error {
+	type searchResponse SearchResponse
+	var v searchResponse
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*r = SearchResponse(v)
+
+	r.Aggregations, r.aggregationTypes = splitTypedKeys(r.Aggregations, isAggregateType)
+	r.Suggest, _ = splitTypedKeys(r.Suggest, isSuggestionType)
+	return nil
+}
+
+// searchResponseFields has the fields of SearchResponse, without the UnmarshalJSON method.
+type searchResponseFields SearchResponse
+
+func (r *SearchResponse) strictValue() interface{} {
+	return &searchResponseFields{}
+}
+
+// MsearchResponse represents the response of the Msearch and MsearchTemplate APIs.
+type MsearchResponse struct {
+	Took      int              `json:"took"`
+	Responses []SearchResponse `json:"responses"`
+}
+
+func (r *MsearchResponse) strictValue() interface{} {
+	return &struct {
+		Took      int                    `json:"took"`
+		Responses []searchResponseFields `json:"responses"`
+	}{}
+}
+
+// ShardsInfo represents the _shards section of a response.
+type ShardsInfo struct {
+	Total      int           `json:"total"`
+	Successful int           `json:"successful"`
+	Skipped    int           `json:"skipped"`
+	Failed     int           `json:"failed"`
+	Failures   []FailedShard `json:"failures,omitempty"`
+}
+
+// SearchHits represents the hits section of a search response.
+type SearchHits struct {
+	Total    *TotalHits  `json:"total,omitempty"`
+	MaxScore *float64    `json:"max_score"`
+	Hits     []SearchHit `json:"hits"`
+}
+
+// TotalHits represents the total number of hits; Relation is "eq" for an accurate value,
+// or "gte" for a lower bound.
+type TotalHits struct {
+	Value    int64  `json:"value"`
+	Relation string `json:"relation"`
+}
+
+// UnmarshalJSON decodes the total hits, also when returned as a number with rest_total_hits_as_int.
+func (t *TotalHits) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] != '{' {
+		t.Relation = "eq"
+		return json.Unmarshal(b, &t.Value)
+	}
+
+	type totalHits TotalHits
+	return json.Unmarshal(b, (*totalHits)(t))
+}
+
+// SearchHit represents a single hit of a search response.
+type SearchHit struct {
+	Index       string          `json:"_index"`
+	ID          string          `json:"_id"`
+	Score       *float64        `json:"_score"`
+	Routing     string          `json:"_routing,omitempty"`
+	Version     *int64          `json:"_version,omitempty"`
+	SeqNo       *int64          `json:"_seq_no,omitempty"`
+	PrimaryTerm *int64          `json:"_primary_term,omitempty"`
+	Source      json.RawMessage `json:"_source,omitempty"`
+	Explanation json.RawMessage `json:"_explanation,omitempty"`
+
+	// Sort values are kept raw to preserve the precision of long values, eg. for search_after.
+	Sort []json.RawMessage `json:"sort,omitempty"`
+
+	Fields         map[string]json.RawMessage `json:"fields,omitempty"`
+	Highlight      map[string][]string        `json:"highlight,omitempty"`
+	InnerHits      map[string]InnerHits       `json:"inner_hits,omitempty"`
+	MatchedQueries []string                   `json:"matched_queries,omitempty"`
+}
+
+// InnerHits represents the inner hits of a search hit.
+type InnerHits struct {
+	Hits SearchHits `json:"hits"`
+}
+
+// Suggestion represents an entry of a suggester in a search response.
+type Suggestion struct {
+	Text    string             `json:"text"`
+	Offset  int                `json:"offset"`
+	Length  int                `json:"length"`
+	Options []SuggestionOption `json:"options"`
+}
+
+// SuggestionOption represents an option of a suggestion.
+// The fields depend on the suggester: term, phrase or completion.
+type SuggestionOption struct {
+	Text         string              `json:"text"`
+	Score        float64             `json:"score,omitempty"`
+	Freq         int                 `json:"freq,omitempty"`
+	Highlighted  string              `json:"highlighted,omitempty"`
+	CollateMatch *bool               `json:"collate_match,omitempty"`
+	Index        string              `json:"_index,omitempty"`
+	ID           string              `json:"_id,omitempty"`
+	Source       json.RawMessage     `json:"_source,omitempty"`
+	Contexts     map[string][]string `json:"contexts,omitempty"`
+}
+
+// DecodeSearchResponse decodes the response of the Search, Scroll or SearchTemplate APIs,
+// or returns the error when the response status indicates failure.
+func DecodeSearchResponse(res *Response, opts ...DecodeOption) (*SearchResponse, error) {
+	var data SearchResponse
+	if err := res.Decode(&data, opts...); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// DecodeMsearchResponse decodes the response of the Msearch or MsearchTemplate APIs,
+// or returns the error when the response status indicates failure.
+//
+// Use the Err method of each search response to check for its failure.
+func DecodeMsearchResponse(res *Response, opts ...DecodeOption) (*MsearchResponse, error) {
+	var data MsearchResponse
+	if err := res.Decode(&data, opts...); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// Err returns the error of a failed search of the Msearch API, or nil.
+func (r *SearchResponse) Err() error {
+	if r.Error == nil {
+		return nil
+	}
+	return &Error{Err: *r.Error, Status: r.Status}
+}
+
+// Aggregation decodes the aggregation with the given name into v.
+// It returns false when the response has no such aggregation.
+func (r *SearchResponse) Aggregation(name string, v interface{}) (bool, error) {
+	return decodeAggregation(r.Aggregations, name, v)
+}
+
+// AggregationType returns the type of the aggregation with the given name, eg. "sterms" or "avg".
+// It is empty unless the request sets TypedKeys.
+func (r *SearchResponse) AggregationType(name string) string {
+	return r.aggregationTypes[name]
+}
+
+// TypedAggregation decodes the aggregation with the given name into the aggregate type for its type.
+// It requires the request to set TypedKeys; see DecodeAggregate.
+func (r *SearchResponse) TypedAggregation(name string) (interface{}, error) {
+	return decodeTypedAggregation(r.Aggregations, r.aggregationTypes, name)
+}
+
+// Suggestions returns the entries of the suggester with the given name,
+// or nil when the response has no such suggester.
+func (r *SearchResponse) Suggestions(name string) ([]Suggestion, error) {
+	raw, ok := r.Suggest[name]
+	if !ok {
+		return nil, nil
+	}
+
+	var suggestions []Suggestion
+	if err := json.Unmarshal(raw, &suggestions); err != nil {
+		return nil, fmt.Errorf("cannot decode suggestion %q: %w", name, err)
+	}
+	return suggestions, nil
+}
+
+// DecodeSource decodes the _source of the hit into v.
+func (h *SearchHit) DecodeSource(v interface{}) error {
+	if len(h.Source) == 0 {
+		return fmt.Errorf("hit %q has no _source", h.ID)
+	}
+	return json.Unmarshal(h.Source, v)
+}
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearchapi
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var aggregationsResponseBody = `{
+  "took": 3,
+  "timed_out": false,
+  "_shards": {"total": 1, "successful": 1, "skipped": 0, "failed": 0},
+  "hits": {"total": {"value": 3, "relation": "eq"}, "max_score": null, "hits": []},
+  "aggregations": {
+    "sterms#genres": {
+      "doc_count_error_upper_bound": 0,
+      "sum_other_doc_count": 1,
+      "buckets": [
+        {"key": "drama", "doc_count": 2, "avg#avg_rating": {"value": 7.5}, "top_hits#latest": {"hits": {"total": {"value": 2, "relation": "eq"}, "max_score": 1, "hits": [{"_index": "movies", "_id": "1", "_score": 1, "_source": {"title": "Moneyball"}}]}}},
+        {"key": "sport", "doc_count": 1, "avg#avg_rating": {"value": null}}
+      ]
+    },
+    "date_histogram#per_year": {"buckets": [{"key_as_string": "2011", "key": 1293840000000, "doc_count": 3}]},
+    "range#ratings": {"buckets": {"low": {"to": 5, "doc_count": 0}, "high": {"from": 5, "doc_count": 3}}},
+    "filter#dramas": {"doc_count": 2, "max#max_year": {"value": 2011}},
+    "composite#pages": {"after_key": {"id": 9007199254740993}, "buckets": [{"key": {"id": 9007199254740993}, "doc_count": 1}]},
+    "stats#year_stats": {"count": 3, "min": 2003, "max": 2011, "avg": 2008, "sum": 6024},
+    "tdigest_percentiles#load": {"values": {"50.0": 1.5, "99.0": 3}},
+    "bucket_metric_value#best_genre": {"value": 2, "keys": ["drama"]},
+    "scripted_metric#custom": {"value": [1, 2]}
+  }
+}`
+
+func TestAggregations(t *testing.T) {
+	res := &Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(aggregationsResponseBody))}
+	data, err := DecodeSearchResponse(res)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	t.Run("Typed keys", func(t *testing.T) {
+		if _, ok := data.Aggregations["genres"]; !ok {
+			t.Errorf("Expected the type prefix to be removed, got: %v", data.Aggregations)
+		}
+		if typ := data.AggregationType("genres"); typ != "sterms" {
+			t.Errorf("Unexpected type: %q", typ)
+		}
+	})
+
+	t.Run("Terms", func(t *testing.T) {
+		var genres BucketsAggregate
+		if ok, err := data.Aggregation("genres", &genres); !ok || err != nil {
+			t.Fatalf("Unexpected result: %v, %v", ok, err)
+		}
+		if genres.SumOtherDocCount != 1 || len(genres.Buckets) != 2 {
+			t.Fatalf("Unexpected aggregation: %+v", genres)
+		}
+
+		drama := genres.Buckets[0]
+		if drama.KeyString() != "drama" || drama.DocCount != 2 || drama.AggregationType("avg_rating") != "avg" {
+			t.Errorf("Unexpected bucket: %+v", drama)
+		}
+
+		var rating ValueAggregate
+		if _, err := drama.Aggregation("avg_rating", &rating); err != nil || *rating.Value != 7.5 {
+			t.Errorf("Unexpected sub-aggregation: %+v, %v", rating, err)
+		}
+		if _, err := genres.Buckets[1].Aggregation("avg_rating", &rating); err != nil || rating.Value != nil {
+			t.Errorf("Expected no value, got: %+v, %v", rating, err)
+		}
+
+		latest, err := drama.TypedAggregation("latest")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if hits, ok := latest.(*TopHitsAggregate); !ok || hits.Hits.Hits[0].ID != "1" {
+			t.Errorf("Unexpected top hits: %#v", latest)
+		}
+	})
+
+	t.Run("Date histogram", func(t *testing.T) {
+		var perYear BucketsAggregate
+		if _, err := data.Aggregation("per_year", &perYear); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		b := perYear.Buckets[0]
+		if b.KeyString() != "2011" || b.Key != json.Number("1293840000000") {
+			t.Errorf("Unexpected bucket: %+v", b)
+		}
+	})
+
+	t.Run("Keyed range", func(t *testing.T) {
+		var ratings BucketsAggregate
+		if _, err := data.Aggregation("ratings", &ratings); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(ratings.Buckets) != 2 || ratings.Buckets[0].Key != "low" || *ratings.Buckets[0].To != 5 ||
+			ratings.Buckets[1].Key != "high" || *ratings.Buckets[1].From != 5 || ratings.Buckets[1].DocCount != 3 {
+			t.Errorf("Unexpected buckets: %+v", ratings.Buckets)
+		}
+	})
+
+	t.Run("Composite", func(t *testing.T) {
+		var pages BucketsAggregate
+		if _, err := data.Aggregation("pages", &pages); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expected := map[string]interface{}{"id": json.Number("9007199254740993")}
+		if !reflect.DeepEqual(pages.AfterKey, expected) || !reflect.DeepEqual(pages.Buckets[0].Key, expected) {
+			t.Errorf("Unexpected keys: %v, %v", pages.AfterKey, pages.Buckets[0].Key)
+		}
+		if s := pages.Buckets[0].KeyString(); s != `{"id":9007199254740993}` {
+			t.Errorf("Unexpected key string: %s", s)
+		}
+	})
+
+	t.Run("Typed aggregations", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			expected interface{}
+		}{
+			{"year_stats", &StatsAggregate{Count: 3, Min: float64Ptr(2003), Max: float64Ptr(2011), Avg: float64Ptr(2008), Sum: 6024}},
+			{"load", &PercentilesAggregate{Values: map[string]*float64{"50.0": float64Ptr(1.5), "99.0": float64Ptr(3)}}},
+			{"best_genre", &ValueAggregate{Value: float64Ptr(2), Keys: []string{"drama"}}},
+			{"custom", json.RawMessage(`{"value": [1, 2]}`)},
+		}
+		for _, tt := range tests {
+			v, err := data.TypedAggregation(tt.name)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(v, tt.expected) {
+				t.Errorf("Unexpected %s: %#v", tt.name, v)
+			}
+		}
+
+		v, err := data.TypedAggregation("dramas")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		dramas, ok := v.(*Bucket)
+		if !ok || dramas.Key != nil || dramas.DocCount != 2 || dramas.AggregationType("max_year") != "max" {
+			t.Errorf("Unexpected single bucket: %#v", v)
+		}
+	})
+
+	t.Run("Untyped", func(t *testing.T) {
+		var data SearchResponse
+		if err := json.Unmarshal([]byte(`{"aggregations": {"genres": {"buckets": []}}}`), &data); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if typ := data.AggregationType("genres"); typ != "" {
+			t.Errorf("Unexpected type: %q", typ)
+		}
+		if _, err := data.TypedAggregation("genres"); err == nil || !strings.Contains(err.Error(), "set TypedKeys") {
+			t.Errorf("Expected error, got: %v", err)
+		}
+		if ok, err := data.Aggregation("missing", &BucketsAggregate{}); ok || err != nil {
+			t.Errorf("Unexpected result: %v, %v", ok, err)
+		}
+	})
+
+	t.Run("Names with a hash", func(t *testing.T) {
+		var data SearchResponse
+		body := `{"aggregations": {"tags#top": {"buckets": []}, "sterms#genres": {"buckets": []}}, "suggest": {"my#suggest": []}}`
+		if err := json.Unmarshal([]byte(body), &data); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if _, ok := data.Aggregations["tags#top"]; !ok || data.AggregationType("tags#top") != "" {
+			t.Errorf("Expected the name to be kept, got: %v", data.Aggregations)
+		}
+		if data.AggregationType("genres") != "sterms" {
+			t.Errorf("Unexpected type: %q", data.AggregationType("genres"))
+		}
+		if _, ok := data.Suggest["my#suggest"]; !ok {
+			t.Errorf("Expected the name to be kept, got: %v", data.Suggest)
+		}
+	})
+
+	t.Run("Percentiles array", func(t *testing.T) {
+		var p PercentilesAggregate
+		if err := json.Unmarshal([]byte(`{"values": [{"key": 50, "value": 1.5}, {"key": 99.9, "value": null}]}`), &p); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if *p.Values["50.0"] != 1.5 || p.Values["99.9"] != nil || len(p.Values) != 2 {
+			t.Errorf("Unexpected values: %v", p.Values)
+		}
+	})
+}
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearchapi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newErrorResponse(status int, body string) *Response {
+	return &Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func TestAPIError(t *testing.T) {
+	t.Run("Full error", func(t *testing.T) {
+		res := newErrorResponse(400, `{
+			"error": {
+				"root_cause": [{"type": "script_exception", "reason": "runtime error", "script_stack": ["doc['foo'].value", "^---- HERE"], "script": "doc['foo'].value", "lang": "painless"}],
+				"type": "search_phase_execution_exception",
+				"reason": "all shards failed",
+				"phase": "query",
+				"grouped": true,
+				"failed_shards": [{
+					"shard": 0,
+					"index": "test",
+					"node": "node-1",
+					"reason": {
+						"type": "script_exception",
+						"reason": "runtime error",
+						"script_stack": ["doc['foo'].value", "^---- HERE"],
+						"script": "doc['foo'].value",
+						"lang": "painless",
+						"position": {"offset": 4, "start": 0, "end": 16},
+						"caused_by": {"type": "illegal_argument_exception", "reason": "No field found for [foo] in mapping"}
+					}
+				}],
+				"caused_by": {
+					"type": "script_exception",
+					"reason": "runtime error",
+					"caused_by": {"type": "illegal_argument_exception", "reason": "No field found for [foo] in mapping"}
+				}
+			},
+			"status": 400
+		}`)
+
+		err := res.Err()
+
+		var apiErr *Error
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("Expected error to be of type opensearchapi.Error: %T", err)
+		}
+		if apiErr.Err.Phase != "query" || !apiErr.Err.Grouped || len(apiErr.Err.FailedShards) != 1 {
+			t.Errorf("Unexpected error: %+v", apiErr.Err)
+		}
+
+		shard := apiErr.Err.FailedShards[0]
+		if shard.Shard != 0 || shard.Index != "test" || shard.Node != "node-1" || shard.Reason == nil {
+			t.Fatalf("Unexpected shard failure: %+v", shard)
+		}
+		if !reflect.DeepEqual(shard.Reason.ScriptStack, []string{"doc['foo'].value", "^---- HERE"}) ||
+			shard.Reason.Lang != "painless" ||
+			!reflect.DeepEqual(shard.Reason.Position, &ScriptPosition{Offset: 4, Start: 0, End: 16}) {
+			t.Errorf("Unexpected shard failure reason: %+v", shard.Reason)
+		}
+
+		var cause *Cause
+		if !errors.As(err, &cause) || cause.Type != "script_exception" {
+			t.Errorf("Expected the caused_by in the error chain, got: %v", cause)
+		}
+		if unwrapped := errors.Unwrap(cause); unwrapped == nil || unwrapped.Error() != "illegal_argument_exception: No field found for [foo] in mapping" {
+			t.Errorf("Expected the nested caused_by in the error chain, got: %v", unwrapped)
+		}
+
+		expected := "status: 400, type: search_phase_execution_exception, reason: all shards failed, " +
+			"root_cause: [script_exception: runtime error], caused_by: script_exception: runtime error"
+		if err.Error() != expected {
+			t.Errorf("Unexpected error message: %s, want: %s", err, expected)
+		}
+
+		if !HasErrorType(err, "illegal_argument_exception") || HasErrorType(err, "index_not_found_exception") {
+			t.Errorf("Unexpected result of HasErrorType()")
+		}
+	})
+
+	t.Run("Index UUID and header", func(t *testing.T) {
+		res := newErrorResponse(401, `{
+			"error": {
+				"root_cause": [{"type": "security_exception", "reason": "missing authentication credentials", "index_uuid": "abc"}],
+				"type": "security_exception",
+				"reason": "missing authentication credentials",
+				"index_uuid": "abc",
+				"header": {"WWW-Authenticate": "Basic realm=\"OpenSearch Security\"", "X-Multiple": ["a", "b"]}
+			},
+			"status": 401
+		}`)
+
+		var apiErr *Error
+		if err := res.Err(); !errors.As(err, &apiErr) {
+			t.Fatalf("Expected error to be of type opensearchapi.Error: %T", err)
+		}
+		if apiErr.Err.IndexUUID != "abc" || apiErr.Err.RootCause[0].IndexUUID != "abc" {
+			t.Errorf("Unexpected index UUID: %+v", apiErr.Err)
+		}
+		expected := ErrorHeader{"WWW-Authenticate": {`Basic realm="OpenSearch Security"`}, "X-Multiple": {"a", "b"}}
+		if !reflect.DeepEqual(apiErr.Err.Header, expected) {
+			t.Errorf("Unexpected header: %v, want: %v", apiErr.Err.Header, expected)
+		}
+	})
+
+	t.Run("String error", func(t *testing.T) {
+		err := newErrorResponse(503, `Service Unavailable`).Err()
+
+		var strErr *StringError
+		if !errors.As(err, &strErr) {
+			t.Fatalf("Expected error to be of type opensearchapi.StringError: %T", err)
+		}
+		if strErr.Status != 503 || strErr.Body != "Service Unavailable" {
+			t.Errorf("Unexpected error: %+v", strErr)
+		}
+		if err.Error() != "status: 503, error: Service Unavailable" {
+			t.Errorf("Unexpected error message: %s", err)
+		}
+
+		err = (&Response{StatusCode: 403}).Err()
+		if err.Error() != "403 Forbidden" {
+			t.Errorf("Unexpected error message: %s", err)
+		}
+	})
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	apiErr := func(status int, typ string) error {
+		return &Error{Status: status, Err: Err{Type: typ}}
+	}
+
+	tests := []struct {
+		name string
+		fn   func(error) bool
+		err  error
+		want bool
+	}{
+		{"IsNotFound status", IsNotFound, &StringError{Status: 404}, true},
+		{"IsNotFound type", IsNotFound, apiErr(404, "index_not_found_exception"), true},
+		{"IsNotFound wrapped", IsNotFound, fmt.Errorf("get: %w", apiErr(404, "index_not_found_exception")), true},
+		{"IsNotFound other", IsNotFound, apiErr(400, "parsing_exception"), false},
+		{"IsNotFound non-API", IsNotFound, errors.New("MOCK ERROR"), false},
+		{"IsNotFound nil", IsNotFound, nil, false},
+
+		{"IsConflict", IsConflict, apiErr(409, "version_conflict_engine_exception"), true},
+		{"IsConflict other", IsConflict, apiErr(400, "mapper_parsing_exception"), false},
+		{"IsVersionConflict", IsVersionConflict, apiErr(409, "version_conflict_engine_exception"), true},
+
+		{"IsIndexAlreadyExists", IsIndexAlreadyExists, apiErr(400, "resource_already_exists_exception"), true},
+		{"IsIndexAlreadyExists other", IsIndexAlreadyExists, apiErr(400, "mapper_parsing_exception"), false},
+
+		{"IsTooManyRequests", IsTooManyRequests, apiErr(429, "circuit_breaking_exception"), true},
+		{"IsTooManyRequests cause", IsTooManyRequests, &Error{Status: 500, Err: Err{
+			Type:     "search_phase_execution_exception",
+			CausedBy: &Cause{Type: "es_rejected_execution_exception"},
+		}}, true},
+
+		{"IsRetryable 429", IsRetryable, &StringError{Status: 429}, true},
+		{"IsRetryable 503", IsRetryable, &StringError{Status: 503}, true},
+		{"IsRetryable 400", IsRetryable, apiErr(400, "parsing_exception"), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.fn(tt.err); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -42,6 +42,16 @@ type errReader struct{}
 
 func (errReader) Read(p []byte) (n int, err error) { return 1, errors.New("MOCK ERROR") }
 
+type countingCloser struct {
+	io.Reader
+	closed int
+}
+
+func (c *countingCloser) Close() error {
+	c.closed++
+	return nil
+}
+
 func TestAPIResponse(t *testing.T) {
 	var (
 		body string
@@ -178,6 +188,105 @@ func TestAPIResponse(t *testing.T) {
 		}
 	})
 
+	t.Run("Error with opaque ID", func(t *testing.T) {
+		hdr := http.Header{}
+		hdr.Set("X-Opaque-Id", "req-1")
+
+		res = &Response{
+			StatusCode: 404,
+			Header:     hdr,
+			Body: io.NopCloser(strings.NewReader(
+				`{"error":{"type":"index_not_found_exception","reason":"no such index [foo]"},"status":404}`,
+			)),
+		}
+
+		var errTest *Error
+		if err = res.Err(); !errors.As(err, &errTest) {
+			t.Fatalf("Expected error to be of type opensearchapi.Error: %T", err)
+		}
+		if errTest.OpaqueID != "req-1" || !strings.HasSuffix(err.Error(), "opaque_id: req-1") {
+			t.Errorf("Expected error to contain the opaque ID, got: %s", err)
+		}
+
+		res = &Response{StatusCode: 502, Header: hdr, Body: io.NopCloser(strings.NewReader(`Bad Gateway`))}
+		if err = res.Err(); err == nil || !strings.HasSuffix(err.Error(), "opaque_id: req-1") {
+			t.Errorf("Expected error to contain the opaque ID, got: %v", err)
+		}
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		var v struct {
+			Foo string `json:"foo"`
+		}
+
+		res = &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{"foo":"bar","baz":1}`))}
+		if err = res.Decode(&v); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if v.Foo != "bar" {
+			t.Errorf("Unexpected value: %+v", v)
+		}
+
+		if err = res.Decode(&v, DisallowUnknownFields()); err == nil || !strings.Contains(err.Error(), `unknown field "baz"`) {
+			t.Errorf("Expected unknown field error, got: %v", err)
+		}
+
+		res = &Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(``))}
+		if err = res.Decode(&v); err == nil {
+			t.Errorf("Expected error for empty body")
+		}
+
+		res = &Response{StatusCode: 200, Body: ioutil.NopCloser(errReader{})}
+		if err = res.Decode(&v); err == nil || !strings.Contains(err.Error(), "MOCK ERROR") {
+			t.Errorf("Expected read error, got: %v", err)
+		}
+	})
+
+	t.Run("Decode error status", func(t *testing.T) {
+		body = `{"error":{"type":"index_not_found_exception","reason":"no such index [foo]"},"status":404}`
+
+		var v map[string]interface{}
+		res = &Response{StatusCode: 404, Body: ioutil.NopCloser(strings.NewReader(body))}
+
+		var errTest *Error
+		if err = res.Decode(&v); !errors.As(err, &errTest) {
+			t.Fatalf("Expected error to be of type opensearchapi.Error: %T", err)
+		}
+		if v != nil {
+			t.Errorf("Expected value to be left untouched, got: %v", v)
+		}
+	})
+
+	t.Run("Body is read once", func(t *testing.T) {
+		body = `{"error":{"type":"index_not_found_exception","reason":"no such index [foo]"},"status":404}`
+
+		closer := &countingCloser{Reader: strings.NewReader(body)}
+		res = &Response{StatusCode: 404, Body: closer}
+
+		if err = res.Err(); err == nil {
+			t.Fatalf("Expected error for response: %s", res.Status())
+		}
+		if err = res.Err(); !errors.As(err, new(*Error)) {
+			t.Errorf("Expected error to be of type opensearchapi.Error on the second call: %T", err)
+		}
+		if res.String() != `[404 Not Found] `+body {
+			t.Errorf("Unexpected response: %s", res.String())
+		}
+
+		b, _ := res.Bytes()
+		if string(b) != body {
+			t.Errorf("Unexpected body: %s", b)
+		}
+		b, _ = io.ReadAll(res.Body)
+		if string(b) != body {
+			t.Errorf("Expected the body to be readable, got: %s", b)
+		}
+
+		if closer.closed != 1 {
+			t.Errorf("Expected the body to be closed once, got: %d", closer.closed)
+		}
+	})
+
 	t.Run("Warnings", func(t *testing.T) {
 		hdr := http.Header{}
 		hdr.Add("Warning", "Foo 1")
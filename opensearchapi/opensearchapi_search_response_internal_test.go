@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearchapi
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var searchResponseBody = `{
+  "took": 5,
+  "timed_out": false,
+  "_scroll_id": "scroll-1",
+  "pit_id": "pit-1",
+  "_shards": {
+    "total": 2, "successful": 1, "skipped": 0, "failed": 1,
+    "failures": [{"shard": 1, "index": "movies", "node": "node-1", "reason": {"type": "query_shard_exception", "reason": "failed to create query"}}]
+  },
+  "hits": {
+    "total": {"value": 10000, "relation": "gte"},
+    "max_score": 1.5,
+    "hits": [
+      {
+        "_index": "movies",
+        "_id": "1",
+        "_score": 1.5,
+        "_source": {"title": "Moneyball", "year": 2011},
+        "sort": [1318204800000, 9007199254740993],
+        "fields": {"year": [2011]},
+        "highlight": {"title": ["<em>Moneyball</em>"]},
+        "matched_queries": ["title"],
+        "inner_hits": {
+          "actors": {"hits": {"total": {"value": 1, "relation": "eq"}, "max_score": null, "hits": [{"_index": "movies", "_id": "1", "_score": null}]}}
+        }
+      }
+    ]
+  },
+  "aggregations": {
+    "years": {"buckets": [{"key": 2011, "doc_count": 1}]}
+  },
+  "suggest": {
+    "title-suggest": [{"text": "moneybal", "offset": 0, "length": 8, "options": [{"text": "moneyball", "score": 0.875, "freq": 1}]}]
+  }
+}`
+
+func TestSearchResponse(t *testing.T) {
+	t.Run("Decode", func(t *testing.T) {
+		res := &Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(searchResponseBody))}
+
+		data, err := DecodeSearchResponse(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if data.Took != 5 || data.ScrollID != "scroll-1" || data.PitID != "pit-1" {
+			t.Errorf("Unexpected response: %+v", data)
+		}
+		if data.Shards.Failed != 1 || len(data.Shards.Failures) != 1 || data.Shards.Failures[0].Reason.Type != "query_shard_exception" {
+			t.Errorf("Unexpected shards: %+v", data.Shards)
+		}
+		if *data.Hits.Total != (TotalHits{Value: 10000, Relation: "gte"}) || *data.Hits.MaxScore != 1.5 {
+			t.Errorf("Unexpected hits: %+v", data.Hits)
+		}
+
+		hit := data.Hits.Hits[0]
+		if hit.ID != "1" || *hit.Score != 1.5 || hit.Highlight["title"][0] != "<em>Moneyball</em>" || hit.MatchedQueries[0] != "title" {
+			t.Errorf("Unexpected hit: %+v", hit)
+		}
+		if len(hit.Sort) != 2 || string(hit.Sort[1]) != "9007199254740993" {
+			t.Errorf("Expected the sort values to be kept raw, got: %s", hit.Sort)
+		}
+		if string(hit.Fields["year"]) != "[2011]" {
+			t.Errorf("Unexpected fields: %s", hit.Fields["year"])
+		}
+		if inner := hit.InnerHits["actors"].Hits; inner.Total.Value != 1 || inner.MaxScore != nil || inner.Hits[0].Score != nil {
+			t.Errorf("Unexpected inner hits: %+v", inner)
+		}
+
+		var doc struct {
+			Title string `json:"title"`
+			Year  int    `json:"year"`
+		}
+		if err := hit.DecodeSource(&doc); err != nil || doc.Title != "Moneyball" || doc.Year != 2011 {
+			t.Errorf("Unexpected source: %+v, error: %v", doc, err)
+		}
+	})
+
+	t.Run("Aggregations and suggestions", func(t *testing.T) {
+		res := &Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(searchResponseBody))}
+		data, _ := DecodeSearchResponse(res)
+
+		var years struct {
+			Buckets []struct {
+				Key      int `json:"key"`
+				DocCount int `json:"doc_count"`
+			} `json:"buckets"`
+		}
+		if ok, err := data.Aggregation("years", &years); !ok || err != nil {
+			t.Fatalf("Unexpected result: %v, %v", ok, err)
+		}
+		if len(years.Buckets) != 1 || years.Buckets[0].Key != 2011 || years.Buckets[0].DocCount != 1 {
+			t.Errorf("Unexpected aggregation: %+v", years)
+		}
+		if ok, _ := data.Aggregation("missing", &years); ok {
+			t.Errorf("Expected missing aggregation")
+		}
+
+		suggestions, err := data.Suggestions("title-suggest")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expected := []Suggestion{{Text: "moneybal", Length: 8, Options: []SuggestionOption{{Text: "moneyball", Score: 0.875, Freq: 1}}}}
+		if !reflect.DeepEqual(suggestions, expected) {
+			t.Errorf("Unexpected suggestions: %+v", suggestions)
+		}
+	})
+
+	t.Run("Total hits as int", func(t *testing.T) {
+		res := &Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{"hits":{"total":42,"hits":[]}}`))}
+
+		data, err := DecodeSearchResponse(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if *data.Hits.Total != (TotalHits{Value: 42, Relation: "eq"}) {
+			t.Errorf("Unexpected total hits: %+v", data.Hits.Total)
+		}
+	})
+
+	t.Run("Strict", func(t *testing.T) {
+		res := &Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(searchResponseBody))}
+		if _, err := DecodeSearchResponse(res, DisallowUnknownFields()); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		res = &Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{"took":1,"hits":{"hits":[]},"foo":true}`))}
+		if _, err := DecodeSearchResponse(res, DisallowUnknownFields()); err == nil || !strings.Contains(err.Error(), `unknown field "foo"`) {
+			t.Errorf("Expected unknown field error, got: %v", err)
+		}
+
+		res = &Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{"took":1,"responses":[{"took":1,"hits":{"hits":[{"_id":"1","bar":1}]}}]}`))}
+		if _, err := DecodeMsearchResponse(res, DisallowUnknownFields()); err == nil || !strings.Contains(err.Error(), `unknown field "bar"`) {
+			t.Errorf("Expected unknown field error, got: %v", err)
+		}
+
+		res = &Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{"took":1,"hits":{"hits":[]},"foo":true}`))}
+		if _, err := DecodeSearchResponse(res); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		res := &Response{StatusCode: 404, Body: io.NopCloser(strings.NewReader(
+			`{"error":{"type":"index_not_found_exception","reason":"no such index [foo]"},"status":404}`,
+		))}
+
+		if _, err := DecodeSearchResponse(res); !IsNotFound(err) {
+			t.Errorf("Expected not found error, got: %v", err)
+		}
+	})
+
+	t.Run("Msearch", func(t *testing.T) {
+		res := &Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{
+			"took": 3,
+			"responses": [
+				{"took": 1, "timed_out": false, "_shards": {"total": 1, "successful": 1, "skipped": 0, "failed": 0}, "hits": {"total": {"value": 0, "relation": "eq"}, "max_score": null, "hits": []}, "status": 200},
+				{"error": {"type": "index_not_found_exception", "reason": "no such index [foo]"}, "status": 404}
+			]
+		}`))}
+
+		data, err := DecodeMsearchResponse(res)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if data.Took != 3 || len(data.Responses) != 2 {
+			t.Fatalf("Unexpected response: %+v", data)
+		}
+		if err := data.Responses[0].Err(); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+
+		var apiErr *Error
+		if err := data.Responses[1].Err(); !errors.As(err, &apiErr) || !IsNotFound(err) {
+			t.Errorf("Expected not found error, got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,653 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchdsl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Aggregation represents an aggregation of the query DSL.
+//
+// The results can be decoded into the aggregate types of opensearchapi, such as BucketsAggregate
+// for bucket aggregations, or ValueAggregate and StatsAggregate for metrics aggregations.
+type Aggregation interface {
+	json.Marshaler
+}
+
+// aggregation holds the type, the parameters and the sub-aggregations of an aggregation.
+type aggregation struct {
+	typ     string
+	params  params
+	subAggs map[string]Aggregation
+}
+
+func newAggregation(typ string, p params) aggregation {
+	return aggregation{typ: typ, params: p}
+}
+
+func (a *aggregation) addSubAggregation(name string, agg Aggregation) {
+	if a.subAggs == nil {
+		a.subAggs = make(map[string]Aggregation)
+	}
+	a.subAggs[name] = agg
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a *aggregation) MarshalJSON() ([]byte, error) {
+	if field, ok := a.params["field"]; ok && field == "" {
+		return nil, fmt.Errorf("opensearchdsl: %s aggregation requires a field", a.typ)
+	}
+	p := params{a.typ: a.params}
+	if len(a.subAggs) > 0 {
+		p["aggs"] = a.subAggs
+	}
+	return json.Marshal(p)
+}
+
+// TermsAggregation buckets the documents by the values of a field.
+type TermsAggregation struct {
+	aggregation
+}
+
+// NewTermsAggregation returns a terms aggregation on the field.
+func NewTermsAggregation(field string) *TermsAggregation {
+	return &TermsAggregation{newAggregation("terms", params{"field": field})}
+}
+
+// Size sets the number of buckets to return.
+func (a *TermsAggregation) Size(size int) *TermsAggregation {
+	a.params["size"] = size
+	return a
+}
+
+// ShardSize sets the number of buckets each shard returns.
+func (a *TermsAggregation) ShardSize(size int) *TermsAggregation {
+	a.params["shard_size"] = size
+	return a
+}
+
+// MinDocCount sets the minimum number of documents of the buckets.
+func (a *TermsAggregation) MinDocCount(n int) *TermsAggregation {
+	a.params["min_doc_count"] = n
+	return a
+}
+
+// Order sets the order of the buckets, eg. by "_count", "_key" or a sub-aggregation, and "asc" or "desc".
+func (a *TermsAggregation) Order(key, direction string) *TermsAggregation {
+	a.params["order"] = params{key: direction}
+	return a
+}
+
+// Missing sets the bucket of documents without the field.
+func (a *TermsAggregation) Missing(v interface{}) *TermsAggregation {
+	a.params["missing"] = v
+	return a
+}
+
+// Include sets a regular expression or values of the buckets to include.
+func (a *TermsAggregation) Include(v interface{}) *TermsAggregation {
+	a.params["include"] = v
+	return a
+}
+
+// Exclude sets a regular expression or values of the buckets to exclude.
+func (a *TermsAggregation) Exclude(v interface{}) *TermsAggregation {
+	a.params["exclude"] = v
+	return a
+}
+
+// SubAggregation adds an aggregation computed for each bucket.
+func (a *TermsAggregation) SubAggregation(name string, agg Aggregation) *TermsAggregation {
+	a.addSubAggregation(name, agg)
+	return a
+}
+
+// SignificantTermsAggregation buckets the documents by the unusually frequent values of a field.
+type SignificantTermsAggregation struct {
+	aggregation
+}
+
+// NewSignificantTermsAggregation returns a significant_terms aggregation on the field.
+func NewSignificantTermsAggregation(field string) *SignificantTermsAggregation {
+	return &SignificantTermsAggregation{newAggregation("significant_terms", params{"field": field})}
+}
+
+// Size sets the number of buckets to return.
+func (a *SignificantTermsAggregation) Size(size int) *SignificantTermsAggregation {
+	a.params["size"] = size
+	return a
+}
+
+// MinDocCount sets the minimum number of documents of the buckets.
+func (a *SignificantTermsAggregation) MinDocCount(n int) *SignificantTermsAggregation {
+	a.params["min_doc_count"] = n
+	return a
+}
+
+// BackgroundFilter sets the query of the documents the frequencies are compared to. Default: the index.
+func (a *SignificantTermsAggregation) BackgroundFilter(q Query) *SignificantTermsAggregation {
+	a.params["background_filter"] = q
+	return a
+}
+
+// SubAggregation adds an aggregation computed for each bucket.
+func (a *SignificantTermsAggregation) SubAggregation(name string, agg Aggregation) *SignificantTermsAggregation {
+	a.addSubAggregation(name, agg)
+	return a
+}
+
+// HistogramAggregation buckets the documents by intervals of the values of a numeric field.
+type HistogramAggregation struct {
+	aggregation
+}
+
+// NewHistogramAggregation returns a histogram aggregation on the field.
+func NewHistogramAggregation(field string, interval float64) *HistogramAggregation {
+	return &HistogramAggregation{newAggregation("histogram", params{"field": field, "interval": interval})}
+}
+
+// MinDocCount sets the minimum number of documents of the buckets.
+func (a *HistogramAggregation) MinDocCount(n int) *HistogramAggregation {
+	a.params["min_doc_count"] = n
+	return a
+}
+
+// Offset shifts the bucket boundaries.
+func (a *HistogramAggregation) Offset(offset float64) *HistogramAggregation {
+	a.params["offset"] = offset
+	return a
+}
+
+// ExtendedBounds sets the range of the buckets returned even if they are empty.
+func (a *HistogramAggregation) ExtendedBounds(min, max float64) *HistogramAggregation {
+	a.params["extended_bounds"] = params{"min": min, "max": max}
+	return a
+}
+
+// SubAggregation adds an aggregation computed for each bucket.
+func (a *HistogramAggregation) SubAggregation(name string, agg Aggregation) *HistogramAggregation {
+	a.addSubAggregation(name, agg)
+	return a
+}
+
+// DateHistogramAggregation buckets the documents by intervals of the values of a date field.
+type DateHistogramAggregation struct {
+	aggregation
+}
+
+// NewDateHistogramAggregation returns a date_histogram aggregation on the field;
+// set the interval with CalendarInterval or FixedInterval.
+func NewDateHistogramAggregation(field string) *DateHistogramAggregation {
+	return &DateHistogramAggregation{newAggregation("date_histogram", params{"field": field})}
+}
+
+// CalendarInterval sets a calendar aware interval, eg. "1d", "week" or "month".
+func (a *DateHistogramAggregation) CalendarInterval(interval string) *DateHistogramAggregation {
+	a.params["calendar_interval"] = interval
+	return a
+}
+
+// FixedInterval sets a fixed interval, eg. "90m".
+func (a *DateHistogramAggregation) FixedInterval(interval string) *DateHistogramAggregation {
+	a.params["fixed_interval"] = interval
+	return a
+}
+
+// Format sets the format of the keys.
+func (a *DateHistogramAggregation) Format(format string) *DateHistogramAggregation {
+	a.params["format"] = format
+	return a
+}
+
+// TimeZone sets the time zone of the buckets, eg. "Europe/Berlin".
+func (a *DateHistogramAggregation) TimeZone(tz string) *DateHistogramAggregation {
+	a.params["time_zone"] = tz
+	return a
+}
+
+// MinDocCount sets the minimum number of documents of the buckets.
+func (a *DateHistogramAggregation) MinDocCount(n int) *DateHistogramAggregation {
+	a.params["min_doc_count"] = n
+	return a
+}
+
+// ExtendedBounds sets the range of the buckets returned even if they are empty.
+func (a *DateHistogramAggregation) ExtendedBounds(min, max interface{}) *DateHistogramAggregation {
+	a.params["extended_bounds"] = params{"min": min, "max": max}
+	return a
+}
+
+// SubAggregation adds an aggregation computed for each bucket.
+func (a *DateHistogramAggregation) SubAggregation(name string, agg Aggregation) *DateHistogramAggregation {
+	a.addSubAggregation(name, agg)
+	return a
+}
+
+// RangeAggregation buckets the documents by ranges of the values of a field.
+type RangeAggregation struct {
+	aggregation
+	ranges []params
+}
+
+// NewRangeAggregation returns a range aggregation on the field.
+func NewRangeAggregation(field string) *RangeAggregation {
+	return &RangeAggregation{aggregation: newAggregation("range", params{"field": field})}
+}
+
+// AddRange adds a range from the inclusive lower bound to the exclusive upper bound; either bound may be nil.
+func (a *RangeAggregation) AddRange(from, to interface{}) *RangeAggregation {
+	return a.AddKeyedRange("", from, to)
+}
+
+// AddKeyedRange adds a range with a key.
+func (a *RangeAggregation) AddKeyedRange(key string, from, to interface{}) *RangeAggregation {
+	r := params{}
+	if key != "" {
+		r["key"] = key
+	}
+	if from != nil {
+		r["from"] = from
+	}
+	if to != nil {
+		r["to"] = to
+	}
+	a.ranges = append(a.ranges, r)
+	return a
+}
+
+// Keyed returns the buckets as an object by key instead of an array.
+func (a *RangeAggregation) Keyed(v bool) *RangeAggregation {
+	a.params["keyed"] = v
+	return a
+}
+
+// SubAggregation adds an aggregation computed for each bucket.
+func (a *RangeAggregation) SubAggregation(name string, agg Aggregation) *RangeAggregation {
+	a.addSubAggregation(name, agg)
+	return a
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a *RangeAggregation) MarshalJSON() ([]byte, error) {
+	if len(a.ranges) == 0 {
+		return nil, errors.New("opensearchdsl: range aggregation requires at least one range")
+	}
+	a.params["ranges"] = a.ranges
+	return a.aggregation.MarshalJSON()
+}
+
+// FilterAggregation computes the sub-aggregations for the documents matching a query.
+type FilterAggregation struct {
+	query   Query
+	subAggs map[string]Aggregation
+}
+
+// NewFilterAggregation returns a filter aggregation.
+func NewFilterAggregation(q Query) *FilterAggregation {
+	return &FilterAggregation{query: q}
+}
+
+// SubAggregation adds an aggregation computed for the matching documents.
+func (a *FilterAggregation) SubAggregation(name string, agg Aggregation) *FilterAggregation {
+	if a.subAggs == nil {
+		a.subAggs = make(map[string]Aggregation)
+	}
+	a.subAggs[name] = agg
+	return a
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a *FilterAggregation) MarshalJSON() ([]byte, error) {
+	if a.query == nil {
+		return nil, errors.New("opensearchdsl: filter aggregation requires a query")
+	}
+	p := params{"filter": a.query}
+	if len(a.subAggs) > 0 {
+		p["aggs"] = a.subAggs
+	}
+	return json.Marshal(p)
+}
+
+// FiltersAggregation buckets the documents by the queries they match.
+type FiltersAggregation struct {
+	aggregation
+	filters map[string]Query
+}
+
+// NewFiltersAggregation returns a filters aggregation.
+func NewFiltersAggregation() *FiltersAggregation {
+	return &FiltersAggregation{aggregation: newAggregation("filters", params{}), filters: map[string]Query{}}
+}
+
+// Filter adds a bucket for the documents matching the query.
+func (a *FiltersAggregation) Filter(name string, q Query) *FiltersAggregation {
+	a.filters[name] = q
+	return a
+}
+
+// OtherBucketKey adds a bucket with the key for the documents matching none of the queries.
+func (a *FiltersAggregation) OtherBucketKey(key string) *FiltersAggregation {
+	a.params["other_bucket_key"] = key
+	return a
+}
+
+// SubAggregation adds an aggregation computed for each bucket.
+func (a *FiltersAggregation) SubAggregation(name string, agg Aggregation) *FiltersAggregation {
+	a.addSubAggregation(name, agg)
+	return a
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a *FiltersAggregation) MarshalJSON() ([]byte, error) {
+	if len(a.filters) == 0 {
+		return nil, errors.New("opensearchdsl: filters aggregation requires at least one filter")
+	}
+	a.params["filters"] = a.filters
+	return a.aggregation.MarshalJSON()
+}
+
+// NestedAggregation computes the sub-aggregations for the nested objects of a path.
+type NestedAggregation struct {
+	aggregation
+}
+
+// NewNestedAggregation returns a nested aggregation on the path.
+func NewNestedAggregation(path string) *NestedAggregation {
+	return &NestedAggregation{newAggregation("nested", params{"path": path})}
+}
+
+// SubAggregation adds an aggregation computed for the nested objects.
+func (a *NestedAggregation) SubAggregation(name string, agg Aggregation) *NestedAggregation {
+	a.addSubAggregation(name, agg)
+	return a
+}
+
+// CompositeAggregation buckets the documents by combinations of values, and pages through all buckets.
+type CompositeAggregation struct {
+	aggregation
+	sources []*CompositeSource
+}
+
+// NewCompositeAggregation returns a composite aggregation with the sources.
+func NewCompositeAggregation(sources ...*CompositeSource) *CompositeAggregation {
+	return &CompositeAggregation{aggregation: newAggregation("composite", params{}), sources: sources}
+}
+
+// Size sets the number of buckets to return.
+func (a *CompositeAggregation) Size(size int) *CompositeAggregation {
+	a.params["size"] = size
+	return a
+}
+
+// After sets the key of the last bucket of the previous page, eg. the AfterKey of the BucketsAggregate.
+func (a *CompositeAggregation) After(key map[string]interface{}) *CompositeAggregation {
+	a.params["after"] = key
+	return a
+}
+
+// SubAggregation adds an aggregation computed for each bucket.
+func (a *CompositeAggregation) SubAggregation(name string, agg Aggregation) *CompositeAggregation {
+	a.addSubAggregation(name, agg)
+	return a
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a *CompositeAggregation) MarshalJSON() ([]byte, error) {
+	if len(a.sources) == 0 {
+		return nil, errors.New("opensearchdsl: composite aggregation requires at least one source")
+	}
+	a.params["sources"] = a.sources
+	return a.aggregation.MarshalJSON()
+}
+
+// CompositeSource represents a values source of the composite aggregation.
+type CompositeSource struct {
+	name string
+	typ  string
+	p    params
+}
+
+// NewCompositeTermsSource returns a source of the values of a field.
+func NewCompositeTermsSource(name, field string) *CompositeSource {
+	return &CompositeSource{name: name, typ: "terms", p: params{"field": field}}
+}
+
+// NewCompositeHistogramSource returns a source of intervals of the values of a numeric field.
+func NewCompositeHistogramSource(name, field string, interval float64) *CompositeSource {
+	return &CompositeSource{name: name, typ: "histogram", p: params{"field": field, "interval": interval}}
+}
+
+// NewCompositeDateHistogramSource returns a source of calendar intervals of the values of a date field.
+func NewCompositeDateHistogramSource(name, field, calendarInterval string) *CompositeSource {
+	return &CompositeSource{name: name, typ: "date_histogram", p: params{"field": field, "calendar_interval": calendarInterval}}
+}
+
+// Order sets the order of the values, "asc" or "desc".
+func (s *CompositeSource) Order(order string) *CompositeSource {
+	s.p["order"] = order
+	return s
+}
+
+// MissingBucket sets whether to return a bucket for documents without a value.
+func (s *CompositeSource) MissingBucket(v bool) *CompositeSource {
+	s.p["missing_bucket"] = v
+	return s
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *CompositeSource) MarshalJSON() ([]byte, error) {
+	return clause(s.name, params{s.typ: s.p})
+}
+
+// TopHitsAggregation returns the top matching documents of each bucket.
+type TopHitsAggregation struct {
+	aggregation
+	sort []Sorter
+}
+
+// NewTopHitsAggregation returns a top_hits aggregation.
+func NewTopHitsAggregation() *TopHitsAggregation {
+	return &TopHitsAggregation{aggregation: newAggregation("top_hits", params{})}
+}
+
+// From sets the offset of the first hit.
+func (a *TopHitsAggregation) From(from int) *TopHitsAggregation {
+	a.params["from"] = from
+	return a
+}
+
+// Size sets the number of hits to return.
+func (a *TopHitsAggregation) Size(size int) *TopHitsAggregation {
+	a.params["size"] = size
+	return a
+}
+
+// Sort adds sort clauses.
+func (a *TopHitsAggregation) Sort(sorts ...Sorter) *TopHitsAggregation {
+	a.sort = append(a.sort, sorts...)
+	return a
+}
+
+// SourceIncludes sets the fields of the _source to return.
+func (a *TopHitsAggregation) SourceIncludes(fields ...string) *TopHitsAggregation {
+	a.params["_source"] = &sourceFilter{includes: fields}
+	return a
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a *TopHitsAggregation) MarshalJSON() ([]byte, error) {
+	if len(a.sort) > 0 {
+		a.params["sort"] = a.sort
+	}
+	return a.aggregation.MarshalJSON()
+}
+
+// MetricAggregation computes a metric from the values of a field or a script.
+type MetricAggregation struct {
+	aggregation
+}
+
+func newMetricAggregation(typ, field string) *MetricAggregation {
+	p := params{}
+	if field != "" {
+		p["field"] = field
+	}
+	return &MetricAggregation{newAggregation(typ, p)}
+}
+
+// NewAvgAggregation returns an avg aggregation on the field; the field may be empty when a script is set.
+func NewAvgAggregation(field string) *MetricAggregation {
+	return newMetricAggregation("avg", field)
+}
+
+// NewSumAggregation returns a sum aggregation on the field; the field may be empty when a script is set.
+func NewSumAggregation(field string) *MetricAggregation {
+	return newMetricAggregation("sum", field)
+}
+
+// NewMinAggregation returns a min aggregation on the field; the field may be empty when a script is set.
+func NewMinAggregation(field string) *MetricAggregation {
+	return newMetricAggregation("min", field)
+}
+
+// NewMaxAggregation returns a max aggregation on the field; the field may be empty when a script is set.
+func NewMaxAggregation(field string) *MetricAggregation {
+	return newMetricAggregation("max", field)
+}
+
+// NewValueCountAggregation returns a value_count aggregation on the field.
+func NewValueCountAggregation(field string) *MetricAggregation {
+	return newMetricAggregation("value_count", field)
+}
+
+// NewStatsAggregation returns a stats aggregation on the field; the field may be empty when a script is set.
+func NewStatsAggregation(field string) *MetricAggregation {
+	return newMetricAggregation("stats", field)
+}
+
+// NewExtendedStatsAggregation returns an extended_stats aggregation on the field.
+func NewExtendedStatsAggregation(field string) *MetricAggregation {
+	return newMetricAggregation("extended_stats", field)
+}
+
+// NewCardinalityAggregation returns a cardinality aggregation, which approximates the number of distinct values of the field.
+func NewCardinalityAggregation(field string) *MetricAggregation {
+	return newMetricAggregation("cardinality", field)
+}
+
+// NewPercentilesAggregation returns a percentiles aggregation on the field.
+// The percents default to 1, 5, 25, 50, 75, 95 and 99.
+func NewPercentilesAggregation(field string, percents ...float64) *MetricAggregation {
+	a := newMetricAggregation("percentiles", field)
+	if len(percents) > 0 {
+		a.params["percents"] = percents
+	}
+	return a
+}
+
+// Missing sets the value of documents without the field.
+func (a *MetricAggregation) Missing(v interface{}) *MetricAggregation {
+	a.params["missing"] = v
+	return a
+}
+
+// Script sets the script which computes the values.
+func (a *MetricAggregation) Script(script *Script) *MetricAggregation {
+	a.params["script"] = script
+	return a
+}
+
+// PrecisionThreshold sets the count below which the cardinality is expected to be exact.
+func (a *MetricAggregation) PrecisionThreshold(n int) *MetricAggregation {
+	a.params["precision_threshold"] = n
+	return a
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a *MetricAggregation) MarshalJSON() ([]byte, error) {
+	if a.params["field"] == nil && a.params["script"] == nil {
+		return nil, fmt.Errorf("opensearchdsl: %s aggregation requires a field or a script", a.typ)
+	}
+	return a.aggregation.MarshalJSON()
+}
+
+// PipelineAggregation computes a value from the results of other aggregations.
+type PipelineAggregation struct {
+	aggregation
+}
+
+func newPipelineAggregation(typ string, bucketsPath interface{}) *PipelineAggregation {
+	return &PipelineAggregation{newAggregation(typ, params{"buckets_path": bucketsPath})}
+}
+
+// NewAvgBucketAggregation returns an avg_bucket aggregation of the metric at the path, eg. "sales_per_month>sales".
+func NewAvgBucketAggregation(bucketsPath string) *PipelineAggregation {
+	return newPipelineAggregation("avg_bucket", bucketsPath)
+}
+
+// NewSumBucketAggregation returns a sum_bucket aggregation of the metric at the path.
+func NewSumBucketAggregation(bucketsPath string) *PipelineAggregation {
+	return newPipelineAggregation("sum_bucket", bucketsPath)
+}
+
+// NewMinBucketAggregation returns a min_bucket aggregation of the metric at the path.
+func NewMinBucketAggregation(bucketsPath string) *PipelineAggregation {
+	return newPipelineAggregation("min_bucket", bucketsPath)
+}
+
+// NewMaxBucketAggregation returns a max_bucket aggregation of the metric at the path.
+func NewMaxBucketAggregation(bucketsPath string) *PipelineAggregation {
+	return newPipelineAggregation("max_bucket", bucketsPath)
+}
+
+// NewStatsBucketAggregation returns a stats_bucket aggregation of the metric at the path.
+func NewStatsBucketAggregation(bucketsPath string) *PipelineAggregation {
+	return newPipelineAggregation("stats_bucket", bucketsPath)
+}
+
+// NewDerivativeAggregation returns a derivative aggregation of the metric at the path, within a histogram.
+func NewDerivativeAggregation(bucketsPath string) *PipelineAggregation {
+	return newPipelineAggregation("derivative", bucketsPath)
+}
+
+// NewCumulativeSumAggregation returns a cumulative_sum aggregation of the metric at the path, within a histogram.
+func NewCumulativeSumAggregation(bucketsPath string) *PipelineAggregation {
+	return newPipelineAggregation("cumulative_sum", bucketsPath)
+}
+
+// NewBucketScriptAggregation returns a bucket_script aggregation, which computes a value with the script
+// from the metrics at the paths, keyed by the names of the script variables.
+func NewBucketScriptAggregation(bucketsPath map[string]string, script *Script) *PipelineAggregation {
+	a := newPipelineAggregation("bucket_script", bucketsPath)
+	a.params["script"] = script
+	return a
+}
+
+// NewBucketSelectorAggregation returns a bucket_selector aggregation, which keeps the buckets
+// for which the script, using the metrics at the paths, returns true.
+func NewBucketSelectorAggregation(bucketsPath map[string]string, script *Script) *PipelineAggregation {
+	a := newPipelineAggregation("bucket_selector", bucketsPath)
+	a.params["script"] = script
+	return a
+}
+
+// GapPolicy sets how missing values are handled, "skip" or "insert_zeros".
+func (a *PipelineAggregation) GapPolicy(policy string) *PipelineAggregation {
+	a.params["gap_policy"] = policy
+	return a
+}
+
+// Format sets the format of the value.
+func (a *PipelineAggregation) Format(format string) *PipelineAggregation {
+	a.params["format"] = format
+	return a
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearchdsl
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAggregations(t *testing.T) {
+	tests := []struct {
+		name     string
+		agg      Aggregation
+		expected string
+	}{
+		{
+			"terms",
+			NewTermsAggregation("genre").Size(10).MinDocCount(2).Order("_count", "desc").Missing("N/A").
+				SubAggregation("avg_rating", NewAvgAggregation("rating")),
+			`{"aggs":{"avg_rating":{"avg":{"field":"rating"}}},"terms":{"field":"genre","min_doc_count":2,"missing":"N/A","order":{"_count":"desc"},"size":10}}`,
+		},
+		{
+			"significant_terms",
+			NewSignificantTermsAggregation("tags").BackgroundFilter(NewTermQuery("genre", "drama")),
+			`{"significant_terms":{"background_filter":{"term":{"genre":{"value":"drama"}}},"field":"tags"}}`,
+		},
+		{"histogram", NewHistogramAggregation("rating", 0.5).ExtendedBounds(0, 10), `{"histogram":{"extended_bounds":{"max":10,"min":0},"field":"rating","interval":0.5}}`},
+		{
+			"date_histogram",
+			NewDateHistogramAggregation("released").CalendarInterval("year").Format("yyyy").TimeZone("Europe/Berlin"),
+			`{"date_histogram":{"calendar_interval":"year","field":"released","format":"yyyy","time_zone":"Europe/Berlin"}}`,
+		},
+		{
+			"range",
+			NewRangeAggregation("rating").AddRange(nil, 5).AddKeyedRange("high", 5, nil).Keyed(true),
+			`{"range":{"field":"rating","keyed":true,"ranges":[{"to":5},{"from":5,"key":"high"}]}}`,
+		},
+		{
+			"filter",
+			NewFilterAggregation(NewTermQuery("genre", "drama")).SubAggregation("max_year", NewMaxAggregation("year")),
+			`{"aggs":{"max_year":{"max":{"field":"year"}}},"filter":{"term":{"genre":{"value":"drama"}}}}`,
+		},
+		{
+			"filters",
+			NewFiltersAggregation().Filter("dramas", NewTermQuery("genre", "drama")).OtherBucketKey("other"),
+			`{"filters":{"filters":{"dramas":{"term":{"genre":{"value":"drama"}}}},"other_bucket_key":"other"}}`,
+		},
+		{
+			"nested",
+			NewNestedAggregation("actors").SubAggregation("names", NewTermsAggregation("actors.name")),
+			`{"aggs":{"names":{"terms":{"field":"actors.name"}}},"nested":{"path":"actors"}}`,
+		},
+		{
+			"composite",
+			NewCompositeAggregation(
+				NewCompositeTermsSource("genre", "genre").MissingBucket(true),
+				NewCompositeDateHistogramSource("year", "released", "year").Order("desc"),
+			).Size(100).After(map[string]interface{}{"genre": "drama", "year": 1293840000000}),
+			`{"composite":{
+				"after":{"genre":"drama","year":1293840000000},
+				"size":100,
+				"sources":[
+					{"genre":{"terms":{"field":"genre","missing_bucket":true}}},
+					{"year":{"date_histogram":{"calendar_interval":"year","field":"released","order":"desc"}}}
+				]
+			}}`,
+		},
+		{
+			"top_hits",
+			NewTopHitsAggregation().Size(1).Sort(NewFieldSort("year").Desc()).SourceIncludes("title"),
+			`{"top_hits":{"_source":{"includes":["title"]},"size":1,"sort":[{"year":{"order":"desc"}}]}}`,
+		},
+		{"sum script", NewSumAggregation("").Script(NewScript("doc['likes'].value * 2")), `{"sum":{"script":{"source":"doc['likes'].value * 2"}}}`},
+		{"stats", NewStatsAggregation("year").Missing(2000), `{"stats":{"field":"year","missing":2000}}`},
+		{"cardinality", NewCardinalityAggregation("director").PrecisionThreshold(100), `{"cardinality":{"field":"director","precision_threshold":100}}`},
+		{"percentiles", NewPercentilesAggregation("load", 50, 99.9), `{"percentiles":{"field":"load","percents":[50,99.9]}}`},
+		{"max_bucket", NewMaxBucketAggregation("genres>_count"), `{"max_bucket":{"buckets_path":"genres\u003e_count"}}`},
+		{"derivative", NewDerivativeAggregation("sales").GapPolicy("insert_zeros"), `{"derivative":{"buckets_path":"sales","gap_policy":"insert_zeros"}}`},
+		{
+			"bucket_selector",
+			NewBucketSelectorAggregation(map[string]string{"count": "_count"}, NewScript("params.count > 1")),
+			`{"bucket_selector":{"buckets_path":{"count":"_count"},"script":{"source":"params.count \u003e 1"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assertJSON(t, tt.agg, tt.expected)
+		})
+	}
+
+	t.Run("Search", func(t *testing.T) {
+		s := NewSearch().Size(0).Aggregation("genres", NewTermsAggregation("genre"))
+		assertJSON(t, s, `{"aggs":{"genres":{"terms":{"field":"genre"}}},"size":0}`)
+	})
+}
+
+func TestAggregationErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		agg  Aggregation
+		err  string
+	}{
+		{"terms", NewTermsAggregation(""), "terms aggregation requires a field"},
+		{"avg", NewAvgAggregation(""), "avg aggregation requires a field or a script"},
+		{"range", NewRangeAggregation("rating"), "range aggregation requires at least one range"},
+		{"filter", NewFilterAggregation(nil), "filter aggregation requires a query"},
+		{"filters", NewFiltersAggregation(), "filters aggregation requires at least one filter"},
+		{"composite", NewCompositeAggregation(), "composite aggregation requires at least one source"},
+		{"sub-aggregation", NewTermsAggregation("genre").SubAggregation("sum", NewSumAggregation("")), "sum aggregation requires a field or a script"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := json.Marshal(tt.agg)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Expected error %q, got: %v", tt.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,464 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchdsl
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// BoolQuery matches documents with a boolean combination of queries.
+type BoolQuery struct {
+	must    []Query
+	should  []Query
+	filter  []Query
+	mustNot []Query
+	params  params
+}
+
+// NewBoolQuery returns a bool query.
+func NewBoolQuery() *BoolQuery {
+	return &BoolQuery{params: params{}}
+}
+
+// Must adds queries which must match and contribute to the score.
+func (q *BoolQuery) Must(queries ...Query) *BoolQuery {
+	q.must = append(q.must, queries...)
+	return q
+}
+
+// Should adds queries which should match and contribute to the score.
+func (q *BoolQuery) Should(queries ...Query) *BoolQuery {
+	q.should = append(q.should, queries...)
+	return q
+}
+
+// Filter adds queries which must match without contributing to the score.
+func (q *BoolQuery) Filter(queries ...Query) *BoolQuery {
+	q.filter = append(q.filter, queries...)
+	return q
+}
+
+// MustNot adds queries which must not match.
+func (q *BoolQuery) MustNot(queries ...Query) *BoolQuery {
+	q.mustNot = append(q.mustNot, queries...)
+	return q
+}
+
+// MinimumShouldMatch sets the number or percentage of the should queries which must match.
+func (q *BoolQuery) MinimumShouldMatch(v interface{}) *BoolQuery {
+	q.params["minimum_should_match"] = v
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *BoolQuery) Boost(boost float64) *BoolQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *BoolQuery) MarshalJSON() ([]byte, error) {
+	p := make(params, len(q.params)+4)
+	for k, v := range q.params {
+		p[k] = v
+	}
+	for name, queries := range map[string][]Query{"must": q.must, "should": q.should, "filter": q.filter, "must_not": q.mustNot} {
+		if len(queries) > 0 {
+			p[name] = queries
+		}
+	}
+	return clause("bool", p)
+}
+
+// DisMaxQuery matches documents matching any of the queries, scored by the best matching one.
+type DisMaxQuery struct {
+	queries []Query
+	params  params
+}
+
+// NewDisMaxQuery returns a dis_max query.
+func NewDisMaxQuery(queries ...Query) *DisMaxQuery {
+	return &DisMaxQuery{queries: queries, params: params{}}
+}
+
+// Query adds queries.
+func (q *DisMaxQuery) Query(queries ...Query) *DisMaxQuery {
+	q.queries = append(q.queries, queries...)
+	return q
+}
+
+// TieBreaker sets the weight of the scores of the queries other than the best matching one.
+func (q *DisMaxQuery) TieBreaker(v float64) *DisMaxQuery {
+	q.params["tie_breaker"] = v
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *DisMaxQuery) Boost(boost float64) *DisMaxQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *DisMaxQuery) MarshalJSON() ([]byte, error) {
+	if len(q.queries) == 0 {
+		return nil, errors.New("opensearchdsl: dis_max query requires at least one query")
+	}
+	p := params{"queries": q.queries}
+	for k, v := range q.params {
+		p[k] = v
+	}
+	return clause("dis_max", p)
+}
+
+// ConstantScoreQuery matches documents matching the filter with the same score.
+type ConstantScoreQuery struct {
+	params params
+}
+
+// NewConstantScoreQuery returns a constant_score query with the filter.
+func NewConstantScoreQuery(filter Query) *ConstantScoreQuery {
+	return &ConstantScoreQuery{params: params{"filter": filter}}
+}
+
+// Boost sets the score of the matching documents.
+func (q *ConstantScoreQuery) Boost(boost float64) *ConstantScoreQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *ConstantScoreQuery) MarshalJSON() ([]byte, error) {
+	return clause("constant_score", q.params)
+}
+
+// ScriptScoreQuery computes the score of the documents matching a query with a script.
+type ScriptScoreQuery struct {
+	params params
+}
+
+// NewScriptScoreQuery returns a script_score query; the query may be nil to match all documents.
+func NewScriptScoreQuery(query Query, script *Script) *ScriptScoreQuery {
+	if query == nil {
+		query = NewMatchAllQuery()
+	}
+	return &ScriptScoreQuery{params: params{"query": query, "script": script}}
+}
+
+// MinScore sets the minimum score of the matching documents.
+func (q *ScriptScoreQuery) MinScore(v float64) *ScriptScoreQuery {
+	q.params["min_score"] = v
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *ScriptScoreQuery) Boost(boost float64) *ScriptScoreQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *ScriptScoreQuery) MarshalJSON() ([]byte, error) {
+	return clause("script_score", q.params)
+}
+
+// FunctionScoreQuery modifies the score of the documents matching a query with functions.
+type FunctionScoreQuery struct {
+	functions []ScoreFunction
+	params    params
+}
+
+// NewFunctionScoreQuery returns a function_score query; the query may be nil to match all documents.
+func NewFunctionScoreQuery(query Query) *FunctionScoreQuery {
+	q := &FunctionScoreQuery{params: params{}}
+	if query != nil {
+		q.params["query"] = query
+	}
+	return q
+}
+
+// Functions adds score functions.
+func (q *FunctionScoreQuery) Functions(functions ...ScoreFunction) *FunctionScoreQuery {
+	q.functions = append(q.functions, functions...)
+	return q
+}
+
+// ScoreMode sets how the scores of the functions are combined, eg. "multiply", "sum" or "max".
+func (q *FunctionScoreQuery) ScoreMode(mode string) *FunctionScoreQuery {
+	q.params["score_mode"] = mode
+	return q
+}
+
+// BoostMode sets how the score of the functions is combined with the score of the query, eg. "multiply" or "replace".
+func (q *FunctionScoreQuery) BoostMode(mode string) *FunctionScoreQuery {
+	q.params["boost_mode"] = mode
+	return q
+}
+
+// MaxBoost sets the maximum score of the functions.
+func (q *FunctionScoreQuery) MaxBoost(v float64) *FunctionScoreQuery {
+	q.params["max_boost"] = v
+	return q
+}
+
+// MinScore sets the minimum score of the matching documents.
+func (q *FunctionScoreQuery) MinScore(v float64) *FunctionScoreQuery {
+	q.params["min_score"] = v
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *FunctionScoreQuery) Boost(boost float64) *FunctionScoreQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *FunctionScoreQuery) MarshalJSON() ([]byte, error) {
+	p := make(params, len(q.params)+1)
+	for k, v := range q.params {
+		p[k] = v
+	}
+	if len(q.functions) > 0 {
+		p["functions"] = q.functions
+	}
+	return clause("function_score", p)
+}
+
+// ScoreFunction represents a function of the function_score query.
+type ScoreFunction interface {
+	json.Marshaler
+}
+
+// Decay functions.
+const (
+	DecayGauss  = "gauss"
+	DecayLinear = "linear"
+	DecayExp    = "exp"
+)
+
+// scoreFunction holds the parameters common to all score functions.
+type scoreFunction struct {
+	filter Query
+	weight *float64
+}
+
+func (f *scoreFunction) marshal(name string, value interface{}) ([]byte, error) {
+	p := params{}
+	if name != "" {
+		p[name] = value
+	}
+	if f.filter != nil {
+		p["filter"] = f.filter
+	}
+	if f.weight != nil {
+		p["weight"] = *f.weight
+	}
+	return json.Marshal(p)
+}
+
+// WeightFunction multiplies the score by a weight.
+type WeightFunction struct {
+	scoreFunction
+}
+
+// NewWeightFunction returns a weight function.
+func NewWeightFunction(weight float64) *WeightFunction {
+	return &WeightFunction{scoreFunction{weight: &weight}}
+}
+
+// Filter sets the query which documents must match for the function to apply.
+func (f *WeightFunction) Filter(q Query) *WeightFunction {
+	f.filter = q
+	return f
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f *WeightFunction) MarshalJSON() ([]byte, error) {
+	return f.marshal("", nil)
+}
+
+// FieldValueFactorFunction computes the score from the value of a field.
+type FieldValueFactorFunction struct {
+	scoreFunction
+	params params
+}
+
+// NewFieldValueFactorFunction returns a field_value_factor function on the field.
+func NewFieldValueFactorFunction(field string) *FieldValueFactorFunction {
+	return &FieldValueFactorFunction{params: params{"field": field}}
+}
+
+// Factor sets the multiplier of the field value.
+func (f *FieldValueFactorFunction) Factor(factor float64) *FieldValueFactorFunction {
+	f.params["factor"] = factor
+	return f
+}
+
+// Modifier sets the function applied to the field value, eg. "log1p" or "sqrt".
+func (f *FieldValueFactorFunction) Modifier(modifier string) *FieldValueFactorFunction {
+	f.params["modifier"] = modifier
+	return f
+}
+
+// Missing sets the value of documents without the field.
+func (f *FieldValueFactorFunction) Missing(v float64) *FieldValueFactorFunction {
+	f.params["missing"] = v
+	return f
+}
+
+// Filter sets the query which documents must match for the function to apply.
+func (f *FieldValueFactorFunction) Filter(q Query) *FieldValueFactorFunction {
+	f.filter = q
+	return f
+}
+
+// Weight sets the multiplier of the function score.
+func (f *FieldValueFactorFunction) Weight(weight float64) *FieldValueFactorFunction {
+	f.weight = &weight
+	return f
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f *FieldValueFactorFunction) MarshalJSON() ([]byte, error) {
+	return f.marshal("field_value_factor", f.params)
+}
+
+// RandomScoreFunction computes a random score.
+type RandomScoreFunction struct {
+	scoreFunction
+	params params
+}
+
+// NewRandomScoreFunction returns a random_score function.
+func NewRandomScoreFunction() *RandomScoreFunction {
+	return &RandomScoreFunction{params: params{}}
+}
+
+// Seed sets the seed and the field for reproducible scores.
+func (f *RandomScoreFunction) Seed(seed interface{}, field string) *RandomScoreFunction {
+	f.params["seed"] = seed
+	f.params["field"] = field
+	return f
+}
+
+// Filter sets the query which documents must match for the function to apply.
+func (f *RandomScoreFunction) Filter(q Query) *RandomScoreFunction {
+	f.filter = q
+	return f
+}
+
+// Weight sets the multiplier of the function score.
+func (f *RandomScoreFunction) Weight(weight float64) *RandomScoreFunction {
+	f.weight = &weight
+	return f
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f *RandomScoreFunction) MarshalJSON() ([]byte, error) {
+	return f.marshal("random_score", f.params)
+}
+
+// ScriptScoreFunction computes the score with a script.
+type ScriptScoreFunction struct {
+	scoreFunction
+	script *Script
+}
+
+// NewScriptScoreFunction returns a script_score function.
+func NewScriptScoreFunction(script *Script) *ScriptScoreFunction {
+	return &ScriptScoreFunction{script: script}
+}
+
+// Filter sets the query which documents must match for the function to apply.
+func (f *ScriptScoreFunction) Filter(q Query) *ScriptScoreFunction {
+	f.filter = q
+	return f
+}
+
+// Weight sets the multiplier of the function score.
+func (f *ScriptScoreFunction) Weight(weight float64) *ScriptScoreFunction {
+	f.weight = &weight
+	return f
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f *ScriptScoreFunction) MarshalJSON() ([]byte, error) {
+	return f.marshal("script_score", params{"script": f.script})
+}
+
+// DecayFunction computes the score from the distance of a field value to an origin.
+type DecayFunction struct {
+	scoreFunction
+	decay  string
+	field  string
+	params params
+	mode   string
+}
+
+// NewDecayFunction returns a decay function, DecayGauss, DecayLinear or DecayExp, on the field.
+func NewDecayFunction(decay, field string) *DecayFunction {
+	return &DecayFunction{decay: decay, field: field, params: params{}}
+}
+
+// Origin sets the origin of the distance.
+func (f *DecayFunction) Origin(origin interface{}) *DecayFunction {
+	f.params["origin"] = origin
+	return f
+}
+
+// Scale sets the distance at which the score is equal to the decay.
+func (f *DecayFunction) Scale(scale interface{}) *DecayFunction {
+	f.params["scale"] = scale
+	return f
+}
+
+// Offset sets the distance from the origin within which the score is not decayed.
+func (f *DecayFunction) Offset(offset interface{}) *DecayFunction {
+	f.params["offset"] = offset
+	return f
+}
+
+// Decay sets the score at the scale distance. Default: 0.5.
+func (f *DecayFunction) Decay(decay float64) *DecayFunction {
+	f.params["decay"] = decay
+	return f
+}
+
+// MultiValueMode sets the value used for fields with multiple values, eg. "min", "max" or "avg".
+func (f *DecayFunction) MultiValueMode(mode string) *DecayFunction {
+	f.mode = mode
+	return f
+}
+
+// Filter sets the query which documents must match for the function to apply.
+func (f *DecayFunction) Filter(q Query) *DecayFunction {
+	f.filter = q
+	return f
+}
+
+// Weight sets the multiplier of the function score.
+func (f *DecayFunction) Weight(weight float64) *DecayFunction {
+	f.weight = &weight
+	return f
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f *DecayFunction) MarshalJSON() ([]byte, error) {
+	if f.field == "" {
+		return nil, errors.New("opensearchdsl: decay function requires a field")
+	}
+	p := params{f.field: f.params}
+	if f.mode != "" {
+		p["multi_value_mode"] = f.mode
+	}
+	return f.marshal(f.decay, p)
+}
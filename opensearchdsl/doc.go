@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+/*
+Package opensearchdsl provides composable builders for the OpenSearch query DSL.
+
+The queries, sorts and request bodies implement json.Marshaler. Use NewSearch to build
+the body of the Search API, and NewQueryBody for the Count, DeleteByQuery, UpdateByQuery
+and Explain APIs; their Reader method returns the body for the request:
+
+	query := opensearchdsl.NewBoolQuery().
+		Must(opensearchdsl.NewMatchQuery("title", "moneyball")).
+		Filter(opensearchdsl.NewRangeQuery("year").Gte(2010))
+
+	body := opensearchdsl.NewSearch().Query(query).Size(10).Sort(opensearchdsl.NewFieldSort("year").Desc())
+
+	res, err := client.Search(client.Search.WithIndex("movies"), client.Search.WithBody(body.Reader()))
+
+Queries without a builder can be added with NewRawQuery.
+*/
+package opensearchdsl
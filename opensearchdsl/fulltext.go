@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchdsl
+
+import "errors"
+
+// MatchQuery matches documents with a full-text query on a field.
+type MatchQuery struct {
+	field  string
+	params params
+}
+
+// NewMatchQuery returns a match query on the field.
+func NewMatchQuery(field string, query interface{}) *MatchQuery {
+	return &MatchQuery{field: field, params: params{"query": query}}
+}
+
+// Operator sets the boolean logic between the terms of the query, "or" or "and".
+func (q *MatchQuery) Operator(op string) *MatchQuery {
+	q.params["operator"] = op
+	return q
+}
+
+// Analyzer sets the analyzer of the query text.
+func (q *MatchQuery) Analyzer(analyzer string) *MatchQuery {
+	q.params["analyzer"] = analyzer
+	return q
+}
+
+// Fuzziness sets the maximum edit distance for matching, eg. "AUTO".
+func (q *MatchQuery) Fuzziness(fuzziness string) *MatchQuery {
+	q.params["fuzziness"] = fuzziness
+	return q
+}
+
+// MinimumShouldMatch sets the minimum number of terms which must match, eg. "75%".
+func (q *MatchQuery) MinimumShouldMatch(v string) *MatchQuery {
+	q.params["minimum_should_match"] = v
+	return q
+}
+
+// ZeroTermsQuery sets the behavior when the analyzer removes all terms, "none" or "all".
+func (q *MatchQuery) ZeroTermsQuery(v string) *MatchQuery {
+	q.params["zero_terms_query"] = v
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *MatchQuery) Boost(boost float64) *MatchQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *MatchQuery) MarshalJSON() ([]byte, error) {
+	return fieldClause("match", q.field, q.params)
+}
+
+// MatchPhraseQuery matches documents containing the terms of the query in the same order.
+type MatchPhraseQuery struct {
+	field  string
+	params params
+}
+
+// NewMatchPhraseQuery returns a match_phrase query on the field.
+func NewMatchPhraseQuery(field string, query string) *MatchPhraseQuery {
+	return &MatchPhraseQuery{field: field, params: params{"query": query}}
+}
+
+// Slop sets the number of positions the terms may be moved.
+func (q *MatchPhraseQuery) Slop(slop int) *MatchPhraseQuery {
+	q.params["slop"] = slop
+	return q
+}
+
+// Analyzer sets the analyzer of the query text.
+func (q *MatchPhraseQuery) Analyzer(analyzer string) *MatchPhraseQuery {
+	q.params["analyzer"] = analyzer
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *MatchPhraseQuery) Boost(boost float64) *MatchPhraseQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *MatchPhraseQuery) MarshalJSON() ([]byte, error) {
+	return fieldClause("match_phrase", q.field, q.params)
+}
+
+// MultiMatchQuery matches documents with a full-text query on multiple fields.
+type MultiMatchQuery struct {
+	params params
+}
+
+// NewMultiMatchQuery returns a multi_match query on the fields, which may have a boost, eg. "title^2".
+func NewMultiMatchQuery(query interface{}, fields ...string) *MultiMatchQuery {
+	q := &MultiMatchQuery{params: params{"query": query}}
+	if len(fields) > 0 {
+		q.params["fields"] = fields
+	}
+	return q
+}
+
+// Type sets the type of the query, eg. "best_fields", "most_fields", "cross_fields" or "phrase".
+func (q *MultiMatchQuery) Type(typ string) *MultiMatchQuery {
+	q.params["type"] = typ
+	return q
+}
+
+// Operator sets the boolean logic between the terms of the query, "or" or "and".
+func (q *MultiMatchQuery) Operator(op string) *MultiMatchQuery {
+	q.params["operator"] = op
+	return q
+}
+
+// TieBreaker sets the weight of the scores of the fields other than the best matching one.
+func (q *MultiMatchQuery) TieBreaker(v float64) *MultiMatchQuery {
+	q.params["tie_breaker"] = v
+	return q
+}
+
+// Fuzziness sets the maximum edit distance for matching, eg. "AUTO".
+func (q *MultiMatchQuery) Fuzziness(fuzziness string) *MultiMatchQuery {
+	q.params["fuzziness"] = fuzziness
+	return q
+}
+
+// MinimumShouldMatch sets the minimum number of terms which must match, eg. "75%".
+func (q *MultiMatchQuery) MinimumShouldMatch(v string) *MultiMatchQuery {
+	q.params["minimum_should_match"] = v
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *MultiMatchQuery) Boost(boost float64) *MultiMatchQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *MultiMatchQuery) MarshalJSON() ([]byte, error) {
+	return clause("multi_match", q.params)
+}
+
+// QueryStringQuery matches documents with a query in the Lucene query string syntax.
+type QueryStringQuery struct {
+	params params
+}
+
+// NewQueryStringQuery returns a query_string query.
+func NewQueryStringQuery(query string) *QueryStringQuery {
+	return &QueryStringQuery{params: params{"query": query}}
+}
+
+// DefaultField sets the field to search when the query has no field.
+func (q *QueryStringQuery) DefaultField(field string) *QueryStringQuery {
+	q.params["default_field"] = field
+	return q
+}
+
+// Fields sets the fields to search when the query has no field.
+func (q *QueryStringQuery) Fields(fields ...string) *QueryStringQuery {
+	q.params["fields"] = fields
+	return q
+}
+
+// DefaultOperator sets the boolean logic between the terms without an operator, "OR" or "AND".
+func (q *QueryStringQuery) DefaultOperator(op string) *QueryStringQuery {
+	q.params["default_operator"] = op
+	return q
+}
+
+// AnalyzeWildcard sets whether to analyze the wildcard terms.
+func (q *QueryStringQuery) AnalyzeWildcard(v bool) *QueryStringQuery {
+	q.params["analyze_wildcard"] = v
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *QueryStringQuery) Boost(boost float64) *QueryStringQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *QueryStringQuery) MarshalJSON() ([]byte, error) {
+	if q.params["query"] == "" {
+		return nil, errors.New("opensearchdsl: query_string query requires a query")
+	}
+	return clause("query_string", q.params)
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchdsl
+
+import "errors"
+
+// GeoPoint represents a point with a latitude and a longitude.
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// GeoDistanceQuery matches documents with a geo point within a distance of a point.
+type GeoDistanceQuery struct {
+	field  string
+	point  *GeoPoint
+	params params
+}
+
+// NewGeoDistanceQuery returns a geo_distance query on the field.
+func NewGeoDistanceQuery(field string) *GeoDistanceQuery {
+	return &GeoDistanceQuery{field: field, params: params{}}
+}
+
+// Point sets the center point.
+func (q *GeoDistanceQuery) Point(lat, lon float64) *GeoDistanceQuery {
+	q.point = &GeoPoint{Lat: lat, Lon: lon}
+	return q
+}
+
+// Distance sets the radius around the point, eg. "10km".
+func (q *GeoDistanceQuery) Distance(distance string) *GeoDistanceQuery {
+	q.params["distance"] = distance
+	return q
+}
+
+// DistanceType sets how the distance is calculated, "arc" or "plane".
+func (q *GeoDistanceQuery) DistanceType(typ string) *GeoDistanceQuery {
+	q.params["distance_type"] = typ
+	return q
+}
+
+// ValidationMethod sets how invalid coordinates are handled, eg. "STRICT", "IGNORE_MALFORMED" or "COERCE".
+func (q *GeoDistanceQuery) ValidationMethod(method string) *GeoDistanceQuery {
+	q.params["validation_method"] = method
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *GeoDistanceQuery) MarshalJSON() ([]byte, error) {
+	if q.field == "" {
+		return nil, errNoField("geo_distance")
+	}
+	if q.point == nil || q.params["distance"] == nil {
+		return nil, errors.New("opensearchdsl: geo_distance query requires a point and a distance")
+	}
+	p := params{q.field: q.point}
+	for k, v := range q.params {
+		p[k] = v
+	}
+	return clause("geo_distance", p)
+}
+
+// GeoBoundingBoxQuery matches documents with a geo point within a bounding box.
+type GeoBoundingBoxQuery struct {
+	field       string
+	topLeft     *GeoPoint
+	bottomRight *GeoPoint
+	params      params
+}
+
+// NewGeoBoundingBoxQuery returns a geo_bounding_box query on the field.
+func NewGeoBoundingBoxQuery(field string) *GeoBoundingBoxQuery {
+	return &GeoBoundingBoxQuery{field: field, params: params{}}
+}
+
+// TopLeft sets the top left corner of the box.
+func (q *GeoBoundingBoxQuery) TopLeft(lat, lon float64) *GeoBoundingBoxQuery {
+	q.topLeft = &GeoPoint{Lat: lat, Lon: lon}
+	return q
+}
+
+// BottomRight sets the bottom right corner of the box.
+func (q *GeoBoundingBoxQuery) BottomRight(lat, lon float64) *GeoBoundingBoxQuery {
+	q.bottomRight = &GeoPoint{Lat: lat, Lon: lon}
+	return q
+}
+
+// ValidationMethod sets how invalid coordinates are handled, eg. "STRICT", "IGNORE_MALFORMED" or "COERCE".
+func (q *GeoBoundingBoxQuery) ValidationMethod(method string) *GeoBoundingBoxQuery {
+	q.params["validation_method"] = method
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *GeoBoundingBoxQuery) MarshalJSON() ([]byte, error) {
+	if q.field == "" {
+		return nil, errNoField("geo_bounding_box")
+	}
+	if q.topLeft == nil || q.bottomRight == nil {
+		return nil, errors.New("opensearchdsl: geo_bounding_box query requires the top left and bottom right corners")
+	}
+	p := params{q.field: params{"top_left": q.topLeft, "bottom_right": q.bottomRight}}
+	for k, v := range q.params {
+		p[k] = v
+	}
+	return clause("geo_bounding_box", p)
+}
+
+// GeoShapeQuery matches documents with a geo shape or point related to a shape.
+type GeoShapeQuery struct {
+	field  string
+	params params
+}
+
+// NewGeoShapeQuery returns a geo_shape query on the field.
+func NewGeoShapeQuery(field string) *GeoShapeQuery {
+	return &GeoShapeQuery{field: field, params: params{}}
+}
+
+// Shape sets the shape in the GeoJSON format, eg. {"type": "envelope", "coordinates": [[13.0, 53.0], [14.0, 52.0]]}.
+func (q *GeoShapeQuery) Shape(shape interface{}) *GeoShapeQuery {
+	q.params["shape"] = shape
+	return q
+}
+
+// IndexedShape sets a shape indexed in a document of another index.
+func (q *GeoShapeQuery) IndexedShape(index, id, path string) *GeoShapeQuery {
+	q.params["indexed_shape"] = params{"index": index, "id": id, "path": path}
+	return q
+}
+
+// Relation sets the spatial relation, eg. "intersects", "disjoint", "within" or "contains".
+func (q *GeoShapeQuery) Relation(relation string) *GeoShapeQuery {
+	q.params["relation"] = relation
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *GeoShapeQuery) MarshalJSON() ([]byte, error) {
+	if q.params["shape"] == nil && q.params["indexed_shape"] == nil {
+		return nil, errors.New("opensearchdsl: geo_shape query requires a shape")
+	}
+	return fieldClause("geo_shape", q.field, q.params)
+}
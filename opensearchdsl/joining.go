@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchdsl
+
+import "errors"
+
+// NestedQuery matches documents with nested objects matching a query.
+type NestedQuery struct {
+	params params
+}
+
+// NewNestedQuery returns a nested query on the path of the nested objects.
+func NewNestedQuery(path string, query Query) *NestedQuery {
+	return &NestedQuery{params: params{"path": path, "query": query}}
+}
+
+// ScoreMode sets how the scores of the nested objects are combined, eg. "avg", "max" or "none".
+func (q *NestedQuery) ScoreMode(mode string) *NestedQuery {
+	q.params["score_mode"] = mode
+	return q
+}
+
+// IgnoreUnmapped sets whether to ignore an unmapped path instead of returning an error.
+func (q *NestedQuery) IgnoreUnmapped(v bool) *NestedQuery {
+	q.params["ignore_unmapped"] = v
+	return q
+}
+
+// InnerHits returns the matching nested objects with each hit.
+func (q *NestedQuery) InnerHits(ih *InnerHits) *NestedQuery {
+	q.params["inner_hits"] = ih
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *NestedQuery) MarshalJSON() ([]byte, error) {
+	if q.params["path"] == "" {
+		return nil, errors.New("opensearchdsl: nested query requires a path")
+	}
+	return clause("nested", q.params)
+}
+
+// HasChildQuery matches parent documents with child documents matching a query.
+type HasChildQuery struct {
+	params params
+}
+
+// NewHasChildQuery returns a has_child query on the child relation type.
+func NewHasChildQuery(typ string, query Query) *HasChildQuery {
+	return &HasChildQuery{params: params{"type": typ, "query": query}}
+}
+
+// ScoreMode sets how the scores of the child documents are combined, eg. "none", "avg", "max" or "sum".
+func (q *HasChildQuery) ScoreMode(mode string) *HasChildQuery {
+	q.params["score_mode"] = mode
+	return q
+}
+
+// MinChildren sets the minimum number of matching child documents.
+func (q *HasChildQuery) MinChildren(n int) *HasChildQuery {
+	q.params["min_children"] = n
+	return q
+}
+
+// MaxChildren sets the maximum number of matching child documents.
+func (q *HasChildQuery) MaxChildren(n int) *HasChildQuery {
+	q.params["max_children"] = n
+	return q
+}
+
+// IgnoreUnmapped sets whether to ignore an unmapped type instead of returning an error.
+func (q *HasChildQuery) IgnoreUnmapped(v bool) *HasChildQuery {
+	q.params["ignore_unmapped"] = v
+	return q
+}
+
+// InnerHits returns the matching child documents with each hit.
+func (q *HasChildQuery) InnerHits(ih *InnerHits) *HasChildQuery {
+	q.params["inner_hits"] = ih
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *HasChildQuery) MarshalJSON() ([]byte, error) {
+	if q.params["type"] == "" {
+		return nil, errors.New("opensearchdsl: has_child query requires a type")
+	}
+	return clause("has_child", q.params)
+}
+
+// HasParentQuery matches child documents with a parent document matching a query.
+type HasParentQuery struct {
+	params params
+}
+
+// NewHasParentQuery returns a has_parent query on the parent relation type.
+func NewHasParentQuery(parentType string, query Query) *HasParentQuery {
+	return &HasParentQuery{params: params{"parent_type": parentType, "query": query}}
+}
+
+// Score sets whether the score of the parent document is used.
+func (q *HasParentQuery) Score(v bool) *HasParentQuery {
+	q.params["score"] = v
+	return q
+}
+
+// IgnoreUnmapped sets whether to ignore an unmapped type instead of returning an error.
+func (q *HasParentQuery) IgnoreUnmapped(v bool) *HasParentQuery {
+	q.params["ignore_unmapped"] = v
+	return q
+}
+
+// InnerHits returns the matching parent document with each hit.
+func (q *HasParentQuery) InnerHits(ih *InnerHits) *HasParentQuery {
+	q.params["inner_hits"] = ih
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *HasParentQuery) MarshalJSON() ([]byte, error) {
+	if q.params["parent_type"] == "" {
+		return nil, errors.New("opensearchdsl: has_parent query requires a parent type")
+	}
+	return clause("has_parent", q.params)
+}
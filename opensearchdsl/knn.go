@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchdsl
+
+import "errors"
+
+// KNNQuery finds the approximate nearest neighbors of a vector in a knn_vector field.
+type KNNQuery struct {
+	field  string
+	params params
+}
+
+// NewKNNQuery returns a knn query for the k nearest neighbors of the vector.
+// Set k to 0 for a radial search with MaxDistance or MinScore.
+func NewKNNQuery(field string, vector []float32, k int) *KNNQuery {
+	q := &KNNQuery{field: field, params: params{"vector": vector}}
+	if k > 0 {
+		q.params["k"] = k
+	}
+	return q
+}
+
+// Filter restricts the neighbors to the documents matching the query, during the search
+// rather than after it, so that k documents are returned when enough of them match.
+func (q *KNNQuery) Filter(filter Query) *KNNQuery {
+	q.params["filter"] = filter
+	return q
+}
+
+// MaxDistance returns the neighbors within the distance, for a radial search.
+func (q *KNNQuery) MaxDistance(d float64) *KNNQuery {
+	q.params["max_distance"] = d
+	return q
+}
+
+// MinScore returns the neighbors with at least the score, for a radial search.
+func (q *KNNQuery) MinScore(score float64) *KNNQuery {
+	q.params["min_score"] = score
+	return q
+}
+
+// MethodParameters sets the search parameters of the method, eg. {"ef_search": 100} or {"nprobes": 8}.
+func (q *KNNQuery) MethodParameters(p map[string]interface{}) *KNNQuery {
+	q.params["method_parameters"] = p
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *KNNQuery) Boost(boost float64) *KNNQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *KNNQuery) MarshalJSON() ([]byte, error) {
+	_, k := q.params["k"]
+	_, d := q.params["max_distance"]
+	_, s := q.params["min_score"]
+	if !k && !d && !s {
+		return nil, errors.New("opensearchdsl: knn query requires k, max_distance or min_score")
+	}
+	return fieldClause("knn", q.field, q.params)
+}
+
+// NewKNNScoreScript returns the knn_score script of the k-NN plugin, which scores the documents
+// with the distance between the vector and the field in the space, eg. "l2" or "cosinesimil".
+// Use it with NewScriptScoreQuery for an exact k-NN search on the documents matching a query:
+//
+//	opensearchdsl.NewScriptScoreQuery(
+//		opensearchdsl.NewTermQuery("color", "red"),
+//		opensearchdsl.NewKNNScoreScript("embedding", vector, "l2"),
+//	)
+func NewKNNScoreScript(field string, vector []float32, spaceType string) *Script {
+	return NewScript("knn_score").Lang("knn").Params(map[string]interface{}{
+		"field":       field,
+		"query_value": vector,
+		"space_type":  spaceType,
+	})
+}
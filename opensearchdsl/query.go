@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchdsl
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Query represents a query clause of the query DSL.
+type Query interface {
+	json.Marshaler
+}
+
+// params holds the parameters of a clause, which are serialized as a JSON object.
+type params map[string]interface{}
+
+// clause returns the JSON of {name: value}.
+func clause(name string, value interface{}) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{name: value})
+}
+
+// fieldClause returns the JSON of {name: {field: value}}, or an error when the field is empty.
+func fieldClause(name, field string, value interface{}) ([]byte, error) {
+	if field == "" {
+		return nil, errNoField(name)
+	}
+	return clause(name, map[string]interface{}{field: value})
+}
+
+func errNoField(name string) error {
+	return fmt.Errorf("opensearchdsl: %s query requires a field", name)
+}
+
+// RawQuery represents a query which is serialized as is, for queries without a builder.
+type RawQuery struct {
+	raw interface{}
+}
+
+// NewRawQuery returns a query from a JSON value, such as json.RawMessage or map[string]interface{}.
+func NewRawQuery(raw interface{}) *RawQuery {
+	return &RawQuery{raw: raw}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *RawQuery) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.raw)
+}
+
+// MatchAllQuery matches all documents.
+type MatchAllQuery struct {
+	params params
+}
+
+// NewMatchAllQuery returns a match_all query.
+func NewMatchAllQuery() *MatchAllQuery {
+	return &MatchAllQuery{params: params{}}
+}
+
+// Boost sets the score of the matching documents.
+func (q *MatchAllQuery) Boost(boost float64) *MatchAllQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *MatchAllQuery) MarshalJSON() ([]byte, error) {
+	return clause("match_all", q.params)
+}
+
+// MatchNoneQuery matches no documents.
+type MatchNoneQuery struct{}
+
+// NewMatchNoneQuery returns a match_none query.
+func NewMatchNoneQuery() *MatchNoneQuery {
+	return &MatchNoneQuery{}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *MatchNoneQuery) MarshalJSON() ([]byte, error) {
+	return clause("match_none", params{})
+}
+
+// Script represents an inline or stored script.
+type Script struct {
+	params params
+}
+
+// NewScript returns an inline script with the source, in the painless language by default.
+func NewScript(source string) *Script {
+	return &Script{params: params{"source": source}}
+}
+
+// NewStoredScript returns a stored script with the ID.
+func NewStoredScript(id string) *Script {
+	return &Script{params: params{"id": id}}
+}
+
+// Lang sets the language of the script.
+func (s *Script) Lang(lang string) *Script {
+	s.params["lang"] = lang
+	return s
+}
+
+// Params sets the parameters of the script.
+func (s *Script) Params(p map[string]interface{}) *Script {
+	s.params["params"] = p
+	return s
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *Script) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.params)
+}
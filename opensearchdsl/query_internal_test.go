@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearchdsl
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// assertJSON compares the JSON encoding of v to the expected JSON, ignoring the formatting.
+func assertJSON(t *testing.T, v json.Marshaler, expected string) {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var want bytes.Buffer
+	if err := json.Compact(&want, []byte(expected)); err != nil {
+		t.Fatalf("Invalid expected JSON: %s", err)
+	}
+	if string(b) != want.String() {
+		t.Errorf("Unexpected JSON:\n got: %s\nwant: %s", b, want.String())
+	}
+}
+
+func TestQueries(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    Query
+		expected string
+	}{
+		{"match_all", NewMatchAllQuery(), `{"match_all":{}}`},
+		{"match_none", NewMatchNoneQuery(), `{"match_none":{}}`},
+		{"raw", NewRawQuery(json.RawMessage(`{"knn":{"v":{"vector":[1,2],"k":2}}}`)), `{"knn":{"v":{"vector":[1,2],"k":2}}}`},
+		{
+			"match",
+			NewMatchQuery("title", "the moneyball").Operator("and").Fuzziness("AUTO").MinimumShouldMatch("75%").Boost(2),
+			`{"match":{"title":{"boost":2,"fuzziness":"AUTO","minimum_should_match":"75%","operator":"and","query":"the moneyball"}}}`,
+		},
+		{"match_phrase", NewMatchPhraseQuery("title", "money ball").Slop(1), `{"match_phrase":{"title":{"query":"money ball","slop":1}}}`},
+		{
+			"multi_match",
+			NewMultiMatchQuery("moneyball", "title^2", "plot").Type("best_fields").TieBreaker(0.3),
+			`{"multi_match":{"fields":["title^2","plot"],"query":"moneyball","tie_breaker":0.3,"type":"best_fields"}}`,
+		},
+		{
+			"query_string",
+			NewQueryStringQuery("title:money*").DefaultOperator("AND").AnalyzeWildcard(true),
+			`{"query_string":{"analyze_wildcard":true,"default_operator":"AND","query":"title:money*"}}`,
+		},
+		{"term", NewTermQuery("genre", "drama"), `{"term":{"genre":{"value":"drama"}}}`},
+		{"terms", NewTermsQuery("genre", "drama", "sport").Boost(1.5), `{"terms":{"boost":1.5,"genre":["drama","sport"]}}`},
+		{"ids", NewIDsQuery("1", "2"), `{"ids":{"values":["1","2"]}}`},
+		{
+			"range",
+			NewRangeQuery("released").Gte("2011-01-01").Lt("2012-01-01").Format("yyyy-MM-dd").TimeZone("+01:00"),
+			`{"range":{"released":{"format":"yyyy-MM-dd","gte":"2011-01-01","lt":"2012-01-01","time_zone":"+01:00"}}}`,
+		},
+		{"exists", NewExistsQuery("director"), `{"exists":{"field":"director"}}`},
+		{"prefix", NewPrefixQuery("title", "money").CaseInsensitive(true), `{"prefix":{"title":{"case_insensitive":true,"value":"money"}}}`},
+		{"wildcard", NewWildcardQuery("title", "mon*y"), `{"wildcard":{"title":{"value":"mon*y"}}}`},
+		{
+			"bool",
+			NewBoolQuery().
+				Must(NewMatchQuery("title", "moneyball")).
+				Filter(NewTermQuery("genre", "drama"), NewRangeQuery("year").Gte(2010)).
+				MustNot(NewExistsQuery("deleted")).
+				Should(NewTermQuery("director", "miller")).
+				MinimumShouldMatch(1),
+			`{"bool":{
+				"filter":[{"term":{"genre":{"value":"drama"}}},{"range":{"year":{"gte":2010}}}],
+				"minimum_should_match":1,
+				"must":[{"match":{"title":{"query":"moneyball"}}}],
+				"must_not":[{"exists":{"field":"deleted"}}],
+				"should":[{"term":{"director":{"value":"miller"}}}]
+			}}`,
+		},
+		{"bool empty", NewBoolQuery(), `{"bool":{}}`},
+		{
+			"dis_max",
+			NewDisMaxQuery(NewTermQuery("title", "moneyball"), NewTermQuery("plot", "moneyball")).TieBreaker(0.7),
+			`{"dis_max":{"queries":[{"term":{"title":{"value":"moneyball"}}},{"term":{"plot":{"value":"moneyball"}}}],"tie_breaker":0.7}}`,
+		},
+		{"constant_score", NewConstantScoreQuery(NewTermQuery("genre", "drama")).Boost(1.2), `{"constant_score":{"boost":1.2,"filter":{"term":{"genre":{"value":"drama"}}}}}`},
+		{
+			"function_score",
+			NewFunctionScoreQuery(NewMatchQuery("title", "moneyball")).
+				Functions(
+					NewWeightFunction(2).Filter(NewTermQuery("genre", "drama")),
+					NewFieldValueFactorFunction("likes").Factor(1.2).Modifier("log1p").Missing(1),
+					NewRandomScoreFunction().Seed(10, "_seq_no").Weight(0.5),
+					NewScriptScoreFunction(NewScript("Math.log(2 + doc['likes'].value)")),
+					NewDecayFunction(DecayGauss, "released").Origin("now").Scale("10d").Decay(0.5).MultiValueMode("avg"),
+				).
+				ScoreMode("sum").BoostMode("multiply").MaxBoost(10),
+			`{"function_score":{
+				"boost_mode":"multiply",
+				"functions":[
+					{"filter":{"term":{"genre":{"value":"drama"}}},"weight":2},
+					{"field_value_factor":{"factor":1.2,"field":"likes","missing":1,"modifier":"log1p"}},
+					{"random_score":{"field":"_seq_no","seed":10},"weight":0.5},
+					{"script_score":{"script":{"source":"Math.log(2 + doc['likes'].value)"}}},
+					{"gauss":{"multi_value_mode":"avg","released":{"decay":0.5,"origin":"now","scale":"10d"}}}
+				],
+				"max_boost":10,
+				"query":{"match":{"title":{"query":"moneyball"}}},
+				"score_mode":"sum"
+			}}`,
+		},
+		{
+			"nested",
+			NewNestedQuery("actors", NewMatchQuery("actors.name", "pitt")).ScoreMode("max").InnerHits(NewInnerHits().Size(3)),
+			`{"nested":{"inner_hits":{"size":3},"path":"actors","query":{"match":{"actors.name":{"query":"pitt"}}},"score_mode":"max"}}`,
+		},
+		{
+			"has_child",
+			NewHasChildQuery("review", NewRangeQuery("stars").Gte(4)).ScoreMode("avg").MinChildren(2),
+			`{"has_child":{"min_children":2,"query":{"range":{"stars":{"gte":4}}},"score_mode":"avg","type":"review"}}`,
+		},
+		{
+			"has_parent",
+			NewHasParentQuery("movie", NewTermQuery("genre", "drama")).Score(true),
+			`{"has_parent":{"parent_type":"movie","query":{"term":{"genre":{"value":"drama"}}},"score":true}}`,
+		},
+		{
+			"geo_distance",
+			NewGeoDistanceQuery("location").Point(40.71, -74.0).Distance("10km"),
+			`{"geo_distance":{"distance":"10km","location":{"lat":40.71,"lon":-74}}}`,
+		},
+		{
+			"geo_bounding_box",
+			NewGeoBoundingBoxQuery("location").TopLeft(40.73, -74.1).BottomRight(40.01, -71.12),
+			`{"geo_bounding_box":{"location":{"bottom_right":{"lat":40.01,"lon":-71.12},"top_left":{"lat":40.73,"lon":-74.1}}}}`,
+		},
+		{
+			"geo_shape",
+			NewGeoShapeQuery("area").Shape(map[string]interface{}{"type": "envelope", "coordinates": [][]float64{{13, 53}, {14, 52}}}).Relation("within"),
+			`{"geo_shape":{"area":{"relation":"within","shape":{"coordinates":[[13,53],[14,52]],"type":"envelope"}}}}`,
+		},
+		{
+			"knn",
+			NewKNNQuery("embedding", []float32{0.1, -2.5, 3}, 10).Filter(NewTermQuery("color", "red")).MethodParameters(map[string]interface{}{"ef_search": 100}),
+			`{"knn":{"embedding":{"filter":{"term":{"color":{"value":"red"}}},"k":10,"method_parameters":{"ef_search":100},"vector":[0.1,-2.5,3]}}}`,
+		},
+		{"knn radial", NewKNNQuery("embedding", []float32{1, 2}, 0).MaxDistance(2.5), `{"knn":{"embedding":{"max_distance":2.5,"vector":[1,2]}}}`},
+		{
+			"script_score knn",
+			NewScriptScoreQuery(NewTermQuery("color", "red"), NewKNNScoreScript("embedding", []float32{1, 2}, "l2")).MinScore(0.5),
+			`{"script_score":{
+				"min_score":0.5,
+				"query":{"term":{"color":{"value":"red"}}},
+				"script":{"lang":"knn","params":{"field":"embedding","query_value":[1,2],"space_type":"l2"},"source":"knn_score"}
+			}}`,
+		},
+		{
+			"script_score",
+			NewScriptScoreQuery(nil, NewScript("_score * doc['likes'].value")),
+			`{"script_score":{"query":{"match_all":{}},"script":{"source":"_score * doc['likes'].value"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assertJSON(t, tt.query, tt.expected)
+		})
+	}
+}
+
+func TestQueryErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query Query
+		err   string
+	}{
+		{"match", NewMatchQuery("", "moneyball"), "match query requires a field"},
+		{"terms", NewTermsQuery("", "drama"), "terms query requires a field"},
+		{"exists", NewExistsQuery(""), "exists query requires a field"},
+		{"query_string", NewQueryStringQuery(""), "query_string query requires a query"},
+		{"dis_max", NewDisMaxQuery(), "dis_max query requires at least one query"},
+		{"nested", NewNestedQuery("", NewMatchAllQuery()), "nested query requires a path"},
+		{"geo_distance", NewGeoDistanceQuery("location").Distance("10km"), "geo_distance query requires a point and a distance"},
+		{"geo_bounding_box", NewGeoBoundingBoxQuery("location").TopLeft(1, 1), "geo_bounding_box query requires the top left and bottom right corners"},
+		{"decay", NewFunctionScoreQuery(nil).Functions(NewDecayFunction(DecayExp, "")), "decay function requires a field"},
+		{"nested clause", NewBoolQuery().Must(NewTermQuery("", "drama")), "term query requires a field"},
+		{"knn", NewKNNQuery("embedding", []float32{1, 2}, 0), "knn query requires k, max_distance or min_score"},
+		{"knn field", NewKNNQuery("", []float32{1, 2}, 3), "knn query requires a field"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := json.Marshal(tt.query)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Expected error %q, got: %v", tt.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,611 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchdsl
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// Search represents the body of the Search API.
+type Search struct {
+	query       Query
+	postFilter  Query
+	sort        []Sorter
+	source      *sourceFilter
+	highlight   *Highlight
+	collapse    *Collapse
+	searchAfter []interface{}
+	aggs        map[string]Aggregation
+	params      params
+}
+
+// NewSearch returns an empty search body.
+func NewSearch() *Search {
+	return &Search{params: params{}}
+}
+
+// Query sets the query.
+func (s *Search) Query(q Query) *Search {
+	s.query = q
+	return s
+}
+
+// PostFilter sets a filter applied to the hits after the aggregations are computed.
+func (s *Search) PostFilter(q Query) *Search {
+	s.postFilter = q
+	return s
+}
+
+// From sets the offset of the first hit.
+func (s *Search) From(from int) *Search {
+	s.params["from"] = from
+	return s
+}
+
+// Size sets the number of hits to return.
+func (s *Search) Size(size int) *Search {
+	s.params["size"] = size
+	return s
+}
+
+// Sort adds sort clauses.
+func (s *Search) Sort(sorts ...Sorter) *Search {
+	s.sort = append(s.sort, sorts...)
+	return s
+}
+
+// SearchAfter sets the sort values of the last hit of the previous page, eg. the Sort of a SearchHit.
+func (s *Search) SearchAfter(values ...interface{}) *Search {
+	s.searchAfter = values
+	return s
+}
+
+// FetchSource sets whether to return the _source of the hits.
+func (s *Search) FetchSource(fetch bool) *Search {
+	if s.source == nil {
+		s.source = &sourceFilter{}
+	}
+	s.source.disabled = !fetch
+	return s
+}
+
+// SourceIncludes sets the fields of the _source to return; wildcards are supported.
+func (s *Search) SourceIncludes(fields ...string) *Search {
+	if s.source == nil {
+		s.source = &sourceFilter{}
+	}
+	s.source.includes = fields
+	return s
+}
+
+// SourceExcludes sets the fields of the _source not to return; wildcards are supported.
+func (s *Search) SourceExcludes(fields ...string) *Search {
+	if s.source == nil {
+		s.source = &sourceFilter{}
+	}
+	s.source.excludes = fields
+	return s
+}
+
+// Highlight sets the highlighting of the hits.
+func (s *Search) Highlight(h *Highlight) *Search {
+	s.highlight = h
+	return s
+}
+
+// Aggregation adds an aggregation with the name.
+func (s *Search) Aggregation(name string, agg Aggregation) *Search {
+	if s.aggs == nil {
+		s.aggs = make(map[string]Aggregation)
+	}
+	s.aggs[name] = agg
+	return s
+}
+
+// Collapse sets the field used to collapse the hits.
+func (s *Search) Collapse(c *Collapse) *Search {
+	s.collapse = c
+	return s
+}
+
+// TrackTotalHits sets whether to count the total hits accurately, or up to a number of hits.
+func (s *Search) TrackTotalHits(v interface{}) *Search {
+	s.params["track_total_hits"] = v
+	return s
+}
+
+// MinScore sets the minimum score of the hits.
+func (s *Search) MinScore(score float64) *Search {
+	s.params["min_score"] = score
+	return s
+}
+
+// Timeout sets the time to wait for the shards, eg. "10s".
+func (s *Search) Timeout(timeout string) *Search {
+	s.params["timeout"] = timeout
+	return s
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *Search) MarshalJSON() ([]byte, error) {
+	p := make(params, len(s.params)+8)
+	for k, v := range s.params {
+		p[k] = v
+	}
+	if s.query != nil {
+		p["query"] = s.query
+	}
+	if s.postFilter != nil {
+		p["post_filter"] = s.postFilter
+	}
+	if len(s.sort) > 0 {
+		p["sort"] = s.sort
+	}
+	if len(s.searchAfter) > 0 {
+		p["search_after"] = s.searchAfter
+	}
+	if s.source != nil {
+		p["_source"] = s.source
+	}
+	if s.highlight != nil {
+		p["highlight"] = s.highlight
+	}
+	if s.collapse != nil {
+		p["collapse"] = s.collapse
+	}
+	if len(s.aggs) > 0 {
+		p["aggs"] = s.aggs
+	}
+	return json.Marshal(p)
+}
+
+// Reader returns the body as an io.Reader for the request.
+func (s *Search) Reader() io.Reader {
+	return newReader(s)
+}
+
+// QueryBody represents the body of the Count, DeleteByQuery, UpdateByQuery and Explain APIs.
+type QueryBody struct {
+	params params
+}
+
+// NewQueryBody returns a body with the query.
+func NewQueryBody(q Query) *QueryBody {
+	return &QueryBody{params: params{"query": q}}
+}
+
+// Script sets the script which updates the documents, for the UpdateByQuery API.
+func (b *QueryBody) Script(script *Script) *QueryBody {
+	b.params["script"] = script
+	return b
+}
+
+// MaxDocs sets the maximum number of documents to process, for the DeleteByQuery and UpdateByQuery APIs.
+func (b *QueryBody) MaxDocs(n int) *QueryBody {
+	b.params["max_docs"] = n
+	return b
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (b *QueryBody) MarshalJSON() ([]byte, error) {
+	if b.params["query"] == nil {
+		return nil, errors.New("opensearchdsl: body requires a query")
+	}
+	return json.Marshal(b.params)
+}
+
+// Reader returns the body as an io.Reader for the request.
+func (b *QueryBody) Reader() io.Reader {
+	return newReader(b)
+}
+
+// newReader encodes v and returns it as a bytes.Reader, so the request body can be rewound for retries.
+// The encoding error, if any, is returned by the Read method.
+func newReader(v json.Marshaler) io.Reader {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return errReader{err}
+	}
+	return bytes.NewReader(b)
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// sourceFilter represents the _source filtering.
+type sourceFilter struct {
+	disabled bool
+	includes []string
+	excludes []string
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f *sourceFilter) MarshalJSON() ([]byte, error) {
+	if f.disabled {
+		return []byte("false"), nil
+	}
+	p := params{}
+	if len(f.includes) > 0 {
+		p["includes"] = f.includes
+	}
+	if len(f.excludes) > 0 {
+		p["excludes"] = f.excludes
+	}
+	if len(p) == 0 {
+		return []byte("true"), nil
+	}
+	return json.Marshal(p)
+}
+
+// Sorter represents a sort clause.
+type Sorter interface {
+	json.Marshaler
+}
+
+// FieldSort sorts the hits by the value of a field.
+type FieldSort struct {
+	field  string
+	params params
+}
+
+// NewFieldSort returns a sort by the field, in ascending order by default.
+func NewFieldSort(field string) *FieldSort {
+	return &FieldSort{field: field, params: params{}}
+}
+
+// Asc sorts in ascending order.
+func (s *FieldSort) Asc() *FieldSort {
+	s.params["order"] = "asc"
+	return s
+}
+
+// Desc sorts in descending order.
+func (s *FieldSort) Desc() *FieldSort {
+	s.params["order"] = "desc"
+	return s
+}
+
+// Missing sets the sort position of hits without the field, "_last", "_first" or a custom value.
+func (s *FieldSort) Missing(v interface{}) *FieldSort {
+	s.params["missing"] = v
+	return s
+}
+
+// Mode sets the value used for fields with multiple values, eg. "min", "max" or "avg".
+func (s *FieldSort) Mode(mode string) *FieldSort {
+	s.params["mode"] = mode
+	return s
+}
+
+// UnmappedType sets the type used for indices where the field is not mapped.
+func (s *FieldSort) UnmappedType(typ string) *FieldSort {
+	s.params["unmapped_type"] = typ
+	return s
+}
+
+// Format sets the format of the date sort values.
+func (s *FieldSort) Format(format string) *FieldSort {
+	s.params["format"] = format
+	return s
+}
+
+// Nested sets the path and an optional filter of the nested objects which contain the field.
+func (s *FieldSort) Nested(path string, filter Query) *FieldSort {
+	nested := params{"path": path}
+	if filter != nil {
+		nested["filter"] = filter
+	}
+	s.params["nested"] = nested
+	return s
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *FieldSort) MarshalJSON() ([]byte, error) {
+	if s.field == "" {
+		return nil, errors.New("opensearchdsl: sort requires a field")
+	}
+	if len(s.params) == 0 {
+		return json.Marshal(s.field)
+	}
+	return clause(s.field, s.params)
+}
+
+// NewScoreSort returns a sort by the score, in descending order by default.
+func NewScoreSort() *FieldSort {
+	return NewFieldSort("_score")
+}
+
+// GeoDistanceSort sorts the hits by the distance to a point.
+type GeoDistanceSort struct {
+	field  string
+	points []GeoPoint
+	params params
+}
+
+// NewGeoDistanceSort returns a sort by the distance of the field to the points.
+func NewGeoDistanceSort(field string, points ...GeoPoint) *GeoDistanceSort {
+	return &GeoDistanceSort{field: field, points: points, params: params{}}
+}
+
+// Asc sorts in ascending order.
+func (s *GeoDistanceSort) Asc() *GeoDistanceSort {
+	s.params["order"] = "asc"
+	return s
+}
+
+// Desc sorts in descending order.
+func (s *GeoDistanceSort) Desc() *GeoDistanceSort {
+	s.params["order"] = "desc"
+	return s
+}
+
+// Unit sets the unit of the sort values, eg. "km".
+func (s *GeoDistanceSort) Unit(unit string) *GeoDistanceSort {
+	s.params["unit"] = unit
+	return s
+}
+
+// Mode sets the distance used for fields with multiple points, eg. "min", "max" or "avg".
+func (s *GeoDistanceSort) Mode(mode string) *GeoDistanceSort {
+	s.params["mode"] = mode
+	return s
+}
+
+// DistanceType sets how the distance is calculated, "arc" or "plane".
+func (s *GeoDistanceSort) DistanceType(typ string) *GeoDistanceSort {
+	s.params["distance_type"] = typ
+	return s
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *GeoDistanceSort) MarshalJSON() ([]byte, error) {
+	if s.field == "" || len(s.points) == 0 {
+		return nil, errors.New("opensearchdsl: geo distance sort requires a field and a point")
+	}
+	p := params{s.field: s.points}
+	for k, v := range s.params {
+		p[k] = v
+	}
+	return clause("_geo_distance", p)
+}
+
+// ScriptSort sorts the hits by the value computed by a script.
+type ScriptSort struct {
+	params params
+}
+
+// NewScriptSort returns a sort by the script, with the type of the values, "number" or "string".
+func NewScriptSort(script *Script, typ string) *ScriptSort {
+	return &ScriptSort{params: params{"script": script, "type": typ}}
+}
+
+// Asc sorts in ascending order.
+func (s *ScriptSort) Asc() *ScriptSort {
+	s.params["order"] = "asc"
+	return s
+}
+
+// Desc sorts in descending order.
+func (s *ScriptSort) Desc() *ScriptSort {
+	s.params["order"] = "desc"
+	return s
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *ScriptSort) MarshalJSON() ([]byte, error) {
+	return clause("_script", s.params)
+}
+
+// Highlight represents the highlighting of the hits.
+type Highlight struct {
+	fields []*HighlightField
+	params params
+}
+
+// NewHighlight returns a highlighting of the fields.
+func NewHighlight(fields ...*HighlightField) *Highlight {
+	return &Highlight{fields: fields, params: params{}}
+}
+
+// Fields adds fields to highlight.
+func (h *Highlight) Fields(fields ...*HighlightField) *Highlight {
+	h.fields = append(h.fields, fields...)
+	return h
+}
+
+// Type sets the highlighter, "unified", "plain" or "fvh".
+func (h *Highlight) Type(typ string) *Highlight {
+	h.params["type"] = typ
+	return h
+}
+
+// Tags sets the tags around the highlighted terms. Default: <em> and </em>.
+func (h *Highlight) Tags(pre, post []string) *Highlight {
+	h.params["pre_tags"] = pre
+	h.params["post_tags"] = post
+	return h
+}
+
+// FragmentSize sets the size of the highlighted fragments in characters.
+func (h *Highlight) FragmentSize(n int) *Highlight {
+	h.params["fragment_size"] = n
+	return h
+}
+
+// NumberOfFragments sets the maximum number of fragments to return.
+func (h *Highlight) NumberOfFragments(n int) *Highlight {
+	h.params["number_of_fragments"] = n
+	return h
+}
+
+// RequireFieldMatch sets whether only the fields matching the query are highlighted.
+func (h *Highlight) RequireFieldMatch(v bool) *Highlight {
+	h.params["require_field_match"] = v
+	return h
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (h *Highlight) MarshalJSON() ([]byte, error) {
+	p := make(params, len(h.params)+1)
+	for k, v := range h.params {
+		p[k] = v
+	}
+
+	// The fields are sent as an array, which keeps their order
+	fields := make([]params, len(h.fields))
+	for i, f := range h.fields {
+		if f.name == "" {
+			return nil, errors.New("opensearchdsl: highlight field requires a name")
+		}
+		fields[i] = params{f.name: f.params}
+	}
+	p["fields"] = fields
+
+	return json.Marshal(p)
+}
+
+// HighlightField represents the highlighting of a field.
+type HighlightField struct {
+	name   string
+	params params
+}
+
+// NewHighlightField returns the highlighting of the field; wildcards are supported.
+func NewHighlightField(name string) *HighlightField {
+	return &HighlightField{name: name, params: params{}}
+}
+
+// Type sets the highlighter, "unified", "plain" or "fvh".
+func (f *HighlightField) Type(typ string) *HighlightField {
+	f.params["type"] = typ
+	return f
+}
+
+// FragmentSize sets the size of the highlighted fragments in characters.
+func (f *HighlightField) FragmentSize(n int) *HighlightField {
+	f.params["fragment_size"] = n
+	return f
+}
+
+// NumberOfFragments sets the maximum number of fragments to return.
+func (f *HighlightField) NumberOfFragments(n int) *HighlightField {
+	f.params["number_of_fragments"] = n
+	return f
+}
+
+// HighlightQuery sets a query for highlighting other than the search query.
+func (f *HighlightField) HighlightQuery(q Query) *HighlightField {
+	f.params["highlight_query"] = q
+	return f
+}
+
+// Collapse represents the collapsing of the hits by the value of a field.
+type Collapse struct {
+	params params
+}
+
+// NewCollapse returns a collapsing by the field, which must be a keyword or numeric field with doc values.
+func NewCollapse(field string) *Collapse {
+	return &Collapse{params: params{"field": field}}
+}
+
+// InnerHits returns the collapsed hits of each group.
+func (c *Collapse) InnerHits(ih ...*InnerHits) *Collapse {
+	c.params["inner_hits"] = ih
+	return c
+}
+
+// MaxConcurrentGroupSearches sets the number of concurrent requests for the inner hits.
+func (c *Collapse) MaxConcurrentGroupSearches(n int) *Collapse {
+	c.params["max_concurrent_group_searches"] = n
+	return c
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c *Collapse) MarshalJSON() ([]byte, error) {
+	if c.params["field"] == "" {
+		return nil, errors.New("opensearchdsl: collapse requires a field")
+	}
+	return json.Marshal(c.params)
+}
+
+// InnerHits represents the inner hits of the nested, has_child and has_parent queries, and of the collapsing.
+type InnerHits struct {
+	sort   []Sorter
+	source *sourceFilter
+	params params
+}
+
+// NewInnerHits returns inner hits.
+func NewInnerHits() *InnerHits {
+	return &InnerHits{params: params{}}
+}
+
+// Name sets the name of the inner hits in the response; it is required with multiple inner hits.
+func (ih *InnerHits) Name(name string) *InnerHits {
+	ih.params["name"] = name
+	return ih
+}
+
+// From sets the offset of the first inner hit.
+func (ih *InnerHits) From(from int) *InnerHits {
+	ih.params["from"] = from
+	return ih
+}
+
+// Size sets the number of inner hits to return.
+func (ih *InnerHits) Size(size int) *InnerHits {
+	ih.params["size"] = size
+	return ih
+}
+
+// Sort adds sort clauses.
+func (ih *InnerHits) Sort(sorts ...Sorter) *InnerHits {
+	ih.sort = append(ih.sort, sorts...)
+	return ih
+}
+
+// SourceIncludes sets the fields of the _source to return.
+func (ih *InnerHits) SourceIncludes(fields ...string) *InnerHits {
+	if ih.source == nil {
+		ih.source = &sourceFilter{}
+	}
+	ih.source.includes = fields
+	return ih
+}
+
+// Highlight sets the highlighting of the inner hits.
+func (ih *InnerHits) Highlight(h *Highlight) *InnerHits {
+	ih.params["highlight"] = h
+	return ih
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (ih *InnerHits) MarshalJSON() ([]byte, error) {
+	p := make(params, len(ih.params)+2)
+	for k, v := range ih.params {
+		p[k] = v
+	}
+	if len(ih.sort) > 0 {
+		p["sort"] = ih.sort
+	}
+	if ih.source != nil {
+		p["_source"] = ih.source
+	}
+	return json.Marshal(p)
+}
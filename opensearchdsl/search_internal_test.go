@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearchdsl
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+type mockTransport struct {
+	requests []*http.Request
+	bodies   []string
+}
+
+func (t *mockTransport) Perform(req *http.Request) (*http.Response, error) {
+	body, _ := io.ReadAll(req.Body)
+	t.requests = append(t.requests, req)
+	t.bodies = append(t.bodies, string(body))
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+}
+
+func TestSearch(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		assertJSON(t, NewSearch(), `{}`)
+	})
+
+	t.Run("Full", func(t *testing.T) {
+		s := NewSearch().
+			Query(NewMatchQuery("title", "moneyball")).
+			PostFilter(NewTermQuery("genre", "drama")).
+			From(10).
+			Size(5).
+			Sort(NewFieldSort("year").Desc().Missing("_last"), NewScoreSort(), NewFieldSort("_id")).
+			SearchAfter(2011, json.RawMessage(`9007199254740993`), "1").
+			SourceIncludes("title", "year").
+			SourceExcludes("plot").
+			Highlight(NewHighlight(NewHighlightField("title"), NewHighlightField("plot").FragmentSize(150)).Tags([]string{"<b>"}, []string{"</b>"})).
+			Collapse(NewCollapse("director").InnerHits(NewInnerHits().Name("latest").Size(1).Sort(NewFieldSort("year").Desc()))).
+			TrackTotalHits(true)
+
+		assertJSON(t, s, `{
+			"_source":{"excludes":["plot"],"includes":["title","year"]},
+			"collapse":{"field":"director","inner_hits":[{"name":"latest","size":1,"sort":[{"year":{"order":"desc"}}]}]},
+			"from":10,
+			"highlight":{"fields":[{"title":{}},{"plot":{"fragment_size":150}}],"post_tags":["\u003c/b\u003e"],"pre_tags":["\u003cb\u003e"]},
+			"post_filter":{"term":{"genre":{"value":"drama"}}},
+			"query":{"match":{"title":{"query":"moneyball"}}},
+			"search_after":[2011,9007199254740993,"1"],
+			"size":5,
+			"sort":[{"year":{"missing":"_last","order":"desc"}},"_score","_id"],
+			"track_total_hits":true
+		}`)
+	})
+
+	t.Run("Disabled source", func(t *testing.T) {
+		assertJSON(t, NewSearch().FetchSource(false), `{"_source":false}`)
+	})
+
+	t.Run("Sorts", func(t *testing.T) {
+		s := NewSearch().Sort(
+			NewGeoDistanceSort("location", GeoPoint{Lat: 40.71, Lon: -74}).Asc().Unit("km"),
+			NewScriptSort(NewScript("doc['likes'].value * params.factor").Params(map[string]interface{}{"factor": 2}), "number").Desc(),
+			NewFieldSort("actors.age").Mode("min").Nested("actors", NewTermQuery("actors.lead", true)),
+		)
+
+		assertJSON(t, s, `{"sort":[
+			{"_geo_distance":{"location":[{"lat":40.71,"lon":-74}],"order":"asc","unit":"km"}},
+			{"_script":{"order":"desc","script":{"params":{"factor":2},"source":"doc['likes'].value * params.factor"},"type":"number"}},
+			{"actors.age":{"mode":"min","nested":{"filter":{"term":{"actors.lead":{"value":true}}},"path":"actors"}}}
+		]}`)
+	})
+
+	t.Run("Reader", func(t *testing.T) {
+		tp := &mockTransport{}
+		body := NewSearch().Query(NewTermQuery("genre", "drama")).Size(1)
+
+		req := opensearchapi.SearchRequest{Index: []string{"movies"}, Body: body.Reader()}
+		if _, err := req.Do(context.Background(), tp); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if tp.bodies[0] != `{"query":{"term":{"genre":{"value":"drama"}}},"size":1}` {
+			t.Errorf("Unexpected body: %s", tp.bodies[0])
+		}
+		if tp.requests[0].GetBody == nil {
+			t.Errorf("Expected the request body to be rewindable")
+		}
+	})
+
+	t.Run("Reader error", func(t *testing.T) {
+		_, err := io.ReadAll(NewSearch().Query(NewMatchQuery("", "moneyball")).Reader())
+		if err == nil || !strings.Contains(err.Error(), "match query requires a field") {
+			t.Errorf("Expected error, got: %v", err)
+		}
+	})
+}
+
+func TestQueryBody(t *testing.T) {
+	tp := &mockTransport{}
+	query := NewTermQuery("genre", "drama")
+
+	requests := []opensearchapi.Request{
+		opensearchapi.CountRequest{Index: []string{"movies"}, Body: NewQueryBody(query).Reader()},
+		opensearchapi.DeleteByQueryRequest{Index: []string{"movies"}, Body: NewQueryBody(query).MaxDocs(10).Reader()},
+		opensearchapi.UpdateByQueryRequest{Index: []string{"movies"}, Body: NewQueryBody(query).Script(NewScript("ctx._source.likes++")).Reader()},
+		opensearchapi.ExplainRequest{Index: "movies", DocumentID: "1", Body: NewQueryBody(query).Reader()},
+	}
+	for _, req := range requests {
+		if _, err := req.Do(context.Background(), tp); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	expected := []string{
+		`{"query":{"term":{"genre":{"value":"drama"}}}}`,
+		`{"max_docs":10,"query":{"term":{"genre":{"value":"drama"}}}}`,
+		`{"query":{"term":{"genre":{"value":"drama"}}},"script":{"source":"ctx._source.likes++"}}`,
+		`{"query":{"term":{"genre":{"value":"drama"}}}}`,
+	}
+	for i, body := range tp.bodies {
+		if body != expected[i] {
+			t.Errorf("Unexpected body for %s: %s, want: %s", tp.requests[i].URL.Path, body, expected[i])
+		}
+	}
+
+	if _, err := json.Marshal(NewQueryBody(nil)); err == nil {
+		t.Errorf("Expected error for a body without a query")
+	}
+}
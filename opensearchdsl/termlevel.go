@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchdsl
+
+// TermQuery matches documents containing the exact term in a field.
+type TermQuery struct {
+	field  string
+	params params
+}
+
+// NewTermQuery returns a term query on the field.
+func NewTermQuery(field string, value interface{}) *TermQuery {
+	return &TermQuery{field: field, params: params{"value": value}}
+}
+
+// CaseInsensitive sets whether to match the value case insensitively.
+func (q *TermQuery) CaseInsensitive(v bool) *TermQuery {
+	q.params["case_insensitive"] = v
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *TermQuery) Boost(boost float64) *TermQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *TermQuery) MarshalJSON() ([]byte, error) {
+	return fieldClause("term", q.field, q.params)
+}
+
+// TermsQuery matches documents containing one or more of the exact terms in a field.
+type TermsQuery struct {
+	field  string
+	values []interface{}
+	boost  *float64
+}
+
+// NewTermsQuery returns a terms query on the field.
+func NewTermsQuery(field string, values ...interface{}) *TermsQuery {
+	if values == nil {
+		values = []interface{}{}
+	}
+	return &TermsQuery{field: field, values: values}
+}
+
+// Boost sets the relevance weight of the query.
+func (q *TermsQuery) Boost(boost float64) *TermsQuery {
+	q.boost = &boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *TermsQuery) MarshalJSON() ([]byte, error) {
+	if q.field == "" {
+		return nil, errNoField("terms")
+	}
+	p := params{q.field: q.values}
+	if q.boost != nil {
+		p["boost"] = *q.boost
+	}
+	return clause("terms", p)
+}
+
+// IDsQuery matches documents with the IDs.
+type IDsQuery struct {
+	ids []string
+}
+
+// NewIDsQuery returns an ids query.
+func NewIDsQuery(ids ...string) *IDsQuery {
+	if ids == nil {
+		ids = []string{}
+	}
+	return &IDsQuery{ids: ids}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *IDsQuery) MarshalJSON() ([]byte, error) {
+	return clause("ids", params{"values": q.ids})
+}
+
+// RangeQuery matches documents with a value of a field within a range.
+type RangeQuery struct {
+	field  string
+	params params
+}
+
+// NewRangeQuery returns a range query on the field.
+func NewRangeQuery(field string) *RangeQuery {
+	return &RangeQuery{field: field, params: params{}}
+}
+
+// Gt sets the exclusive lower bound.
+func (q *RangeQuery) Gt(v interface{}) *RangeQuery {
+	q.params["gt"] = v
+	return q
+}
+
+// Gte sets the inclusive lower bound.
+func (q *RangeQuery) Gte(v interface{}) *RangeQuery {
+	q.params["gte"] = v
+	return q
+}
+
+// Lt sets the exclusive upper bound.
+func (q *RangeQuery) Lt(v interface{}) *RangeQuery {
+	q.params["lt"] = v
+	return q
+}
+
+// Lte sets the inclusive upper bound.
+func (q *RangeQuery) Lte(v interface{}) *RangeQuery {
+	q.params["lte"] = v
+	return q
+}
+
+// Format sets the date format of the bounds.
+func (q *RangeQuery) Format(format string) *RangeQuery {
+	q.params["format"] = format
+	return q
+}
+
+// TimeZone sets the time zone of the date bounds, eg. "+01:00".
+func (q *RangeQuery) TimeZone(tz string) *RangeQuery {
+	q.params["time_zone"] = tz
+	return q
+}
+
+// Relation sets how the range query matches range fields, eg. "intersects", "contains" or "within".
+func (q *RangeQuery) Relation(relation string) *RangeQuery {
+	q.params["relation"] = relation
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *RangeQuery) Boost(boost float64) *RangeQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *RangeQuery) MarshalJSON() ([]byte, error) {
+	return fieldClause("range", q.field, q.params)
+}
+
+// ExistsQuery matches documents with an indexed value for a field.
+type ExistsQuery struct {
+	field string
+}
+
+// NewExistsQuery returns an exists query on the field.
+func NewExistsQuery(field string) *ExistsQuery {
+	return &ExistsQuery{field: field}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *ExistsQuery) MarshalJSON() ([]byte, error) {
+	if q.field == "" {
+		return nil, errNoField("exists")
+	}
+	return clause("exists", params{"field": q.field})
+}
+
+// PrefixQuery matches documents containing a term with the prefix in a field.
+type PrefixQuery struct {
+	field  string
+	params params
+}
+
+// NewPrefixQuery returns a prefix query on the field.
+func NewPrefixQuery(field, prefix string) *PrefixQuery {
+	return &PrefixQuery{field: field, params: params{"value": prefix}}
+}
+
+// CaseInsensitive sets whether to match the prefix case insensitively.
+func (q *PrefixQuery) CaseInsensitive(v bool) *PrefixQuery {
+	q.params["case_insensitive"] = v
+	return q
+}
+
+// Rewrite sets the method used to rewrite the query.
+func (q *PrefixQuery) Rewrite(rewrite string) *PrefixQuery {
+	q.params["rewrite"] = rewrite
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *PrefixQuery) Boost(boost float64) *PrefixQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *PrefixQuery) MarshalJSON() ([]byte, error) {
+	return fieldClause("prefix", q.field, q.params)
+}
+
+// WildcardQuery matches documents containing a term matching the wildcard pattern in a field.
+type WildcardQuery struct {
+	field  string
+	params params
+}
+
+// NewWildcardQuery returns a wildcard query on the field, where * matches any characters and ? a single one.
+func NewWildcardQuery(field, pattern string) *WildcardQuery {
+	return &WildcardQuery{field: field, params: params{"value": pattern}}
+}
+
+// CaseInsensitive sets whether to match the pattern case insensitively.
+func (q *WildcardQuery) CaseInsensitive(v bool) *WildcardQuery {
+	q.params["case_insensitive"] = v
+	return q
+}
+
+// Rewrite sets the method used to rewrite the query.
+func (q *WildcardQuery) Rewrite(rewrite string) *WildcardQuery {
+	q.params["rewrite"] = rewrite
+	return q
+}
+
+// Boost sets the relevance weight of the query.
+func (q *WildcardQuery) Boost(boost float64) *WildcardQuery {
+	q.params["boost"] = boost
+	return q
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q *WildcardQuery) MarshalJSON() ([]byte, error) {
+	return fieldClause("wildcard", q.field, q.params)
+}
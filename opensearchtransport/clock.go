@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock defines the interface for the time source of the connection pool.
+//
+// It allows to replace the wall clock with a fake one, eg. to test how the
+// client behaves when a node is dead for some time and then comes back.
+type Clock interface {
+	Now() time.Time
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer represents a function scheduled with Clock.AfterFunc.
+type Timer interface {
+	Stop() bool
+}
+
+// realClock implements Clock with the functions of the time package.
+type realClock struct{}
+
+// FakeClock is a Clock which only moves forward when Advance is called.
+//
+// It is safe for concurrent use.
+type FakeClock struct {
+	sync.Mutex
+
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	clock *FakeClock
+	when  time.Time
+	f     func()
+}
+
+// Now returns the current time.
+func (realClock) Now() time.Time { return time.Now() }
+
+// AfterFunc calls f in its own goroutine after the duration elapses.
+func (realClock) AfterFunc(d time.Duration, f func()) Timer { return time.AfterFunc(d, f) }
+
+// NewFakeClock creates a fake clock set to t.
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{now: t}
+}
+
+// Now returns the current time of the fake clock.
+func (c *FakeClock) Now() time.Time {
+	c.Lock()
+	defer c.Unlock()
+	return c.now
+}
+
+// AfterFunc schedules f to be called once the clock is advanced by at least d.
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	c.Lock()
+	defer c.Unlock()
+
+	t := &fakeTimer{clock: c, when: c.now.Add(d), f: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the clock forward by d and synchronously calls
+// the functions of all timers which became due, in order of their due time.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.Lock()
+	c.now = c.now.Add(d)
+
+	var due, pending []*fakeTimer
+	for _, t := range c.timers {
+		if t.when.After(c.now) {
+			pending = append(pending, t)
+		} else {
+			due = append(due, t)
+		}
+	}
+	c.timers = pending
+	c.Unlock()
+
+	sort.SliceStable(due, func(i, j int) bool { return due[i].when.Before(due[j].when) })
+	for _, t := range due {
+		t.f()
+	}
+}
+
+// Stop prevents the timer from firing; it returns false when the timer already fired or was stopped.
+func (t *fakeTimer) Stop() bool {
+	t.clock.Lock()
+	defer t.clock.Unlock()
+
+	for i, tt := range t.clock.timers {
+		if tt == t {
+			t.clock.timers = append(t.clock.timers[:i], t.clock.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
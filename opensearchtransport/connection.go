@@ -84,7 +84,9 @@ type statusConnectionPool struct {
 	resurrectTimeoutInitial      time.Duration
 	resurrectTimeoutFactorCutoff int
 
-	metrics *metrics
+	metrics  *metrics
+	clock    Clock
+	listener ConnectionPoolListener
 }
 
 type roundRobinSelector struct {
@@ -129,6 +131,9 @@ func (cp *singleConnectionPool) connections() []*Connection { return []*Connecti
 
 // Next returns a connection from pool, or an error.
 func (cp *statusConnectionPool) Next() (*Connection, error) {
+	var events []ConnectionEvent
+	defer func() { emitConnectionEvents(cp.listener, events) }()
+
 	cp.Lock()
 	defer cp.Unlock()
 
@@ -142,6 +147,7 @@ func (cp *statusConnectionPool) Next() (*Connection, error) {
 		c.Lock()
 		defer c.Unlock()
 		cp.resurrect(c, false)
+		events = append(events, newConnectionEvent(ConnectionResurrected, c, ReasonNoLiveConnection))
 		return c, nil
 	}
 
@@ -150,6 +156,9 @@ func (cp *statusConnectionPool) Next() (*Connection, error) {
 
 // OnSuccess marks the connection as successful.
 func (cp *statusConnectionPool) OnSuccess(c *Connection) {
+	var events []ConnectionEvent
+	defer func() { emitConnectionEvents(cp.listener, events) }()
+
 	c.Lock()
 	defer c.Unlock()
 
@@ -158,6 +167,8 @@ func (cp *statusConnectionPool) OnSuccess(c *Connection) {
 		return
 	}
 
+	// The event reports the failures which led to the resurrection, before they are reset.
+	events = append(events, newConnectionEvent(ConnectionResurrected, c, ReasonRequestSucceeded))
 	c.markAsHealthy()
 
 	cp.Lock()
@@ -167,6 +178,14 @@ func (cp *statusConnectionPool) OnSuccess(c *Connection) {
 
 // OnFailure marks the connection as failed.
 func (cp *statusConnectionPool) OnFailure(c *Connection) error {
+	return cp.onFailure(c, "")
+}
+
+// onFailure marks the connection as failed, reporting the reason to the listener.
+func (cp *statusConnectionPool) onFailure(c *Connection, reason string) error {
+	var events []ConnectionEvent
+	defer func() { emitConnectionEvents(cp.listener, events) }()
+
 	cp.Lock()
 	defer cp.Unlock()
 
@@ -185,8 +204,9 @@ func (cp *statusConnectionPool) OnFailure(c *Connection) error {
 		debugLogger.Logf("Removing %s...\n", c.URL)
 	}
 
-	c.markAsDead()
+	c.markAsDead(cp.now())
 	cp.scheduleResurrect(c)
+	events = append(events, newConnectionEvent(ConnectionDead, c, reason))
 	c.Unlock()
 
 	// Push item to dead list and sort slice by number of failures
@@ -286,11 +306,14 @@ func (cp *statusConnectionPool) scheduleResurrect(c *Connection) {
 			c.Failures,
 			factor,
 			timeout,
-			c.DeadSince.Add(timeout).Sub(time.Now().UTC()).Truncate(time.Second),
+			c.DeadSince.Add(timeout).Sub(cp.now()).Truncate(time.Second),
 		)
 	}
 
-	time.AfterFunc(timeout, func() {
+	cp.afterFunc(timeout, func() {
+		var events []ConnectionEvent
+		defer func() { emitConnectionEvents(cp.listener, events) }()
+
 		cp.Lock()
 		defer cp.Unlock()
 
@@ -305,9 +328,27 @@ func (cp *statusConnectionPool) scheduleResurrect(c *Connection) {
 		}
 
 		cp.resurrect(c, true)
+		events = append(events, newConnectionEvent(ConnectionResurrected, c, ReasonResurrectTimeout))
 	})
 }
 
+// now returns the current time of the pool clock.
+func (cp *statusConnectionPool) now() time.Time {
+	if cp.clock != nil {
+		return cp.clock.Now().UTC()
+	}
+	return time.Now().UTC()
+}
+
+// afterFunc schedules f with the pool clock.
+func (cp *statusConnectionPool) afterFunc(d time.Duration, f func()) {
+	if cp.clock != nil {
+		cp.clock.AfterFunc(d, f)
+		return
+	}
+	time.AfterFunc(d, f)
+}
+
 // Select returns the connection in a round-robin fashion.
 func (s *roundRobinSelector) Select(conns []*Connection) (*Connection, error) {
 	s.Lock()
@@ -318,10 +359,10 @@ func (s *roundRobinSelector) Select(conns []*Connection) (*Connection, error) {
 }
 
 // markAsDead marks the connection as dead.
-func (c *Connection) markAsDead() {
+func (c *Connection) markAsDead(now time.Time) {
 	c.IsDead = true
 	if c.DeadSince.IsZero() {
-		c.DeadSince = time.Now().UTC()
+		c.DeadSince = now
 	}
 	c.Failures++
 }
@@ -43,6 +43,11 @@ type Discoverable interface {
 	DiscoverNodes() error
 }
 
+// ContextDiscoverable defines the interface for transports supporting node discovery with a context.
+type ContextDiscoverable interface {
+	DiscoverNodesWithContext(context.Context) error
+}
+
 // nodeInfo represents the information about node in a cluster.
 type nodeInfo struct {
 	ID         string
@@ -57,17 +62,40 @@ type nodeInfo struct {
 
 // DiscoverNodes reloads the client connections by fetching information from the cluster.
 func (c *Client) DiscoverNodes() error {
-	conns := make([]*Connection, 0)
+	return c.DiscoverNodesWithContext(context.Background())
+}
+
+// NodeSource defines the interface for the sources of nodes used by node discovery.
+//
+// Nodes must return new Connection values on every call, as the connection pool
+// keeps its state in them.
+type NodeSource interface {
+	Nodes(context.Context) ([]*Connection, error)
+}
+
+// NodeWatcher is implemented by the node sources which notify the client when their nodes change.
+//
+// The client starts the watch when it is created, and discovers the nodes again on every call to changed.
+type NodeWatcher interface {
+	Watch(changed func())
+}
 
-	nodes, err := c.getNodesInfo()
+// DiscoverNodesWithContext reloads the client connections by fetching the nodes from the node source.
+// By default, the nodes are fetched from the cluster with the nodes info API.
+//
+// The context is used for the request to the cluster, eg. to set a deadline or an opaque ID.
+func (c *Client) DiscoverNodesWithContext(ctx context.Context) error {
+	nodes, err := c.nodeSource.Nodes(ctx)
 	if err != nil {
 		if debugLogger != nil {
-			debugLogger.Logf("Error getting nodes info: %s\n", err)
+			debugLogger.Logf("Error getting nodes: %s\n", err)
 		}
 
 		return fmt.Errorf("discovery: get nodes: %w", err)
 	}
 
+	conns := make([]*Connection, 0, len(nodes))
+
 	for _, node := range nodes {
 		var isClusterManagerOnlyNode bool
 
@@ -90,15 +118,12 @@ func (c *Client) DiscoverNodes() error {
 			continue
 		}
 
-		conns = append(conns, &Connection{
-			URL:        node.URL,
-			ID:         node.ID,
-			Name:       node.Name,
-			Roles:      node.Roles,
-			Attributes: node.Attributes,
-		})
+		conns = append(conns, node)
 	}
 
+	var events []ConnectionEvent
+	defer func() { emitConnectionEvents(c.listener, events) }()
+
 	c.Lock()
 	defer c.Unlock()
 
@@ -107,20 +132,82 @@ func (c *Client) DiscoverNodes() error {
 		defer lockable.Unlock()
 	}
 
-	if c.poolFunc != nil {
-		c.pool = c.poolFunc(conns, c.selector)
-	} else {
-		// TODO: Replace only live connections, leave dead scheduled for resurrect?
-		c.pool = NewConnectionPool(conns, c.selector)
+	if c.listener != nil {
+		events = diffConnections(c.pool, conns)
 	}
 
+	// TODO: Replace only live connections, leave dead scheduled for resurrect?
+	c.pool = c.newConnectionPool(conns)
+
 	return nil
 }
 
-func (c *Client) getNodesInfo() ([]nodeInfo, error) {
+// diffConnections returns the events for the connections added to and removed from the pool.
+// The calling code is responsible for locking the pool.
+func diffConnections(pool ConnectionPool, conns []*Connection) []ConnectionEvent {
+	var (
+		events []ConnectionEvent
+		prev   []*Connection
+		seen   = make(map[string]bool)
+		curr   = make(map[string]bool, len(conns))
+	)
+
+	if p, ok := pool.(connectionable); ok {
+		prev = p.connections()
+	}
+
+	for _, conn := range prev {
+		seen[conn.URL.String()] = true
+	}
+
+	for _, conn := range conns {
+		curr[conn.URL.String()] = true
+		if !seen[conn.URL.String()] {
+			events = append(events, ConnectionEvent{Type: ConnectionDiscovered, Connection: conn, Reason: ReasonNodeDiscovered})
+		}
+	}
+
+	for _, conn := range prev {
+		if !curr[conn.URL.String()] {
+			conn.Lock()
+			events = append(events, newConnectionEvent(ConnectionRemoved, conn, ReasonNodeRemoved))
+			conn.Unlock()
+		}
+	}
+
+	return events
+}
+
+// sniffNodeSource fetches the nodes from the cluster with the nodes info API.
+type sniffNodeSource struct {
+	client *Client
+}
+
+// Nodes returns a connection for every node in the cluster.
+func (s *sniffNodeSource) Nodes(ctx context.Context) ([]*Connection, error) {
+	nodes, err := s.client.getNodesInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conns := make([]*Connection, 0, len(nodes))
+	for _, node := range nodes {
+		conns = append(conns, &Connection{
+			URL:        node.URL,
+			ID:         node.ID,
+			Name:       node.Name,
+			Roles:      node.Roles,
+			Attributes: node.Attributes,
+		})
+	}
+
+	return conns, nil
+}
+
+func (c *Client) getNodesInfo(ctx context.Context) ([]nodeInfo, error) {
 	scheme := c.urls[0].Scheme
 
-	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/_nodes/http", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/_nodes/http", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -136,10 +223,11 @@ func (c *Client) getNodesInfo() ([]nodeInfo, error) {
 	c.setReqURL(conn.URL, req)
 	c.setReqAuth(conn.URL, req)
 	c.setReqUserAgent(req)
+	c.setReqOpaqueID(req)
 
 	res, err := c.transport.RoundTrip(req)
 	if err != nil {
-		return nil, err
+		return nil, wrapOpaqueIDError(req, err)
 	}
 	defer res.Body.Close()
 
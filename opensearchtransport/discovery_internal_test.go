@@ -30,6 +30,7 @@ package opensearchtransport
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
@@ -76,7 +77,7 @@ func TestDiscovery(t *testing.T) {
 		u, _ := url.Parse("http://" + srv.Addr)
 		tp, _ := New(Config{URLs: []*url.URL{u}})
 
-		nodes, err := tp.getNodesInfo()
+		nodes, err := tp.getNodesInfo(context.Background())
 		if err != nil {
 			t.Fatalf("ERROR: %s", err)
 		}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"strconv"
+	"time"
+)
+
+// ConnectionEventType defines the type of a connection pool event.
+type ConnectionEventType int
+
+// Types of connection pool events.
+const (
+	// ConnectionDead is emitted when a connection is marked as dead after a failed request.
+	ConnectionDead ConnectionEventType = iota + 1
+	// ConnectionResurrected is emitted when a dead connection is moved back to the live connections.
+	ConnectionResurrected
+	// ConnectionDiscovered is emitted when node discovery adds a connection to the pool.
+	ConnectionDiscovered
+	// ConnectionRemoved is emitted when node discovery removes a connection from the pool.
+	ConnectionRemoved
+)
+
+// Reasons for connection pool events.
+const (
+	ReasonResurrectTimeout = "resurrect timeout elapsed"
+	ReasonNoLiveConnection = "no live connection available"
+	ReasonRequestSucceeded = "request succeeded"
+	ReasonNodeDiscovered   = "node discovered"
+	ReasonNodeRemoved      = "node not discovered"
+)
+
+// ConnectionEvent represents a change of the connection pool state.
+//
+// Failures and DeadSince are a snapshot of the connection state at the time of the event;
+// for a resurrected connection, they are the state before it was resurrected.
+type ConnectionEvent struct {
+	Type       ConnectionEventType
+	Connection *Connection
+	Failures   int
+	DeadSince  time.Time
+	Reason     string
+}
+
+// ConnectionPoolListener defines the interface for receiving connection pool events.
+//
+// The listener is called synchronously once the pool has updated its state.
+// It must be safe for concurrent use, should return quickly, and must not
+// perform requests or node discovery with the same client, which could deadlock.
+type ConnectionPoolListener interface {
+	OnConnectionPoolEvent(ConnectionEvent)
+}
+
+// ConnectionPoolListenerFunc is an adapter to allow the use of ordinary functions as a ConnectionPoolListener.
+type ConnectionPoolListenerFunc func(ConnectionEvent)
+
+// OnConnectionPoolEvent calls f(e).
+func (f ConnectionPoolListenerFunc) OnConnectionPoolEvent(e ConnectionEvent) { f(e) }
+
+// String returns a readable name of the event type.
+func (t ConnectionEventType) String() string {
+	switch t {
+	case ConnectionDead:
+		return "dead"
+	case ConnectionResurrected:
+		return "resurrected"
+	case ConnectionDiscovered:
+		return "discovered"
+	case ConnectionRemoved:
+		return "removed"
+	default:
+		return "ConnectionEventType(" + strconv.Itoa(int(t)) + ")"
+	}
+}
+
+// newConnectionEvent returns an event with a snapshot of the connection state.
+// The calling code is responsible for locking the connection.
+func newConnectionEvent(t ConnectionEventType, c *Connection, reason string) ConnectionEvent {
+	return ConnectionEvent{
+		Type:       t,
+		Connection: c,
+		Failures:   c.Failures,
+		DeadSince:  c.DeadSince,
+		Reason:     reason,
+	}
+}
+
+// emitConnectionEvents passes the events to the listener, if any.
+func emitConnectionEvents(l ConnectionPoolListener, events []ConnectionEvent) {
+	if l == nil {
+		return
+	}
+	for _, e := range events {
+		l.OnConnectionPoolEvent(e)
+	}
+}
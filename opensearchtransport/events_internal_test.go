@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearchtransport
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type eventRecorder struct {
+	sync.Mutex
+	events []ConnectionEvent
+}
+
+func (r *eventRecorder) OnConnectionPoolEvent(e ConnectionEvent) {
+	r.Lock()
+	defer r.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) reset() []ConnectionEvent {
+	r.Lock()
+	defer r.Unlock()
+	events := r.events
+	r.events = nil
+	return events
+}
+
+func TestConnectionPoolEvents(t *testing.T) {
+	t.Run("Pool", func(t *testing.T) {
+		var (
+			rec   eventRecorder
+			clock = NewFakeClock(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+			conn1 = &Connection{URL: &url.URL{Scheme: "http", Host: "foo1"}}
+			conn2 = &Connection{URL: &url.URL{Scheme: "http", Host: "foo2"}}
+		)
+
+		pool := NewConnectionPool([]*Connection{conn1, conn2}, nil).(*statusConnectionPool)
+		pool.clock = clock
+		pool.listener = &rec
+
+		if err := pool.onFailure(conn1, "connection refused"); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		events := rec.reset()
+		if len(events) != 1 {
+			t.Fatalf("Expected 1 event, got: %+v", events)
+		}
+		e := events[0]
+		if e.Type != ConnectionDead || e.Connection != conn1 || e.Failures != 1 ||
+			!e.DeadSince.Equal(clock.Now()) || e.Reason != "connection refused" {
+			t.Errorf("Unexpected event: %+v", e)
+		}
+
+		// Failure of a dead connection is not reported again
+		//nolint:errcheck // Only the events are tested
+		pool.OnFailure(conn1)
+		if events := rec.reset(); len(events) != 0 {
+			t.Errorf("Unexpected events: %+v", events)
+		}
+
+		clock.Advance(defaultResurrectTimeoutInitial)
+		events = rec.reset()
+		if len(events) != 1 || events[0].Type != ConnectionResurrected || events[0].Reason != ReasonResurrectTimeout {
+			t.Fatalf("Expected resurrected event, got: %+v", events)
+		}
+
+		//nolint:errcheck // Only the events are tested
+		pool.OnFailure(conn1)
+		//nolint:errcheck // Only the events are tested
+		pool.OnFailure(conn2)
+		events = rec.reset()
+		if len(events) != 2 || events[0].Failures != 2 || events[1].Failures != 1 {
+			t.Fatalf("Unexpected events: %+v", events)
+		}
+
+		c, err := pool.Next()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		events = rec.reset()
+		if len(events) != 1 || events[0].Connection != c || events[0].Reason != ReasonNoLiveConnection {
+			t.Fatalf("Expected forced resurrect event, got: %+v", events)
+		}
+
+		//nolint:errcheck // Only the events are tested
+		pool.OnFailure(c)
+		rec.reset()
+
+		pool.OnSuccess(c)
+		events = rec.reset()
+		if len(events) != 1 || events[0].Type != ConnectionResurrected || events[0].Reason != ReasonRequestSucceeded {
+			t.Fatalf("Expected resurrected event, got: %+v", events)
+		}
+		if events[0].Failures != 2 || events[0].DeadSince.IsZero() {
+			t.Errorf("Expected the failures before the connection was resurrected, got: %+v", events[0])
+		}
+		if c.Failures != 0 || !c.DeadSince.IsZero() {
+			t.Errorf("Expected the connection to be healthy, got: %s", c)
+		}
+	})
+
+	t.Run("Transport", func(t *testing.T) {
+		var rec eventRecorder
+
+		ft := NewFaultTransport(newFaultTestTransport(), nil)
+		ft.AddRule(FaultRule{Host: "foo1", Sequence: []Fault{{Kind: FaultRefuse}}})
+
+		tp, _ := New(Config{
+			URLs:                   []*url.URL{{Scheme: "http", Host: "foo1"}, {Scheme: "http", Host: "foo2"}},
+			Transport:              ft,
+			ConnectionPoolListener: &rec,
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		events := rec.reset()
+		if len(events) != 1 || events[0].Type != ConnectionDead || events[0].Connection.URL.Host != "foo1" {
+			t.Fatalf("Expected dead event, got: %+v", events)
+		}
+		if !strings.Contains(events[0].Reason, "connection refused") {
+			t.Errorf("Unexpected reason: %s", events[0].Reason)
+		}
+	})
+
+	t.Run("Discovery", func(t *testing.T) {
+		pool := NewConnectionPool([]*Connection{
+			{URL: &url.URL{Scheme: "http", Host: "foo1"}},
+			{URL: &url.URL{Scheme: "http", Host: "foo2"}},
+		}, nil)
+
+		events := diffConnections(pool, []*Connection{
+			{URL: &url.URL{Scheme: "http", Host: "foo2"}},
+			{URL: &url.URL{Scheme: "http", Host: "foo3"}},
+		})
+
+		if len(events) != 2 {
+			t.Fatalf("Expected 2 events, got: %+v", events)
+		}
+		if events[0].Type != ConnectionDiscovered || events[0].Connection.URL.Host != "foo3" {
+			t.Errorf("Unexpected event: %+v", events[0])
+		}
+		if events[1].Type != ConnectionRemoved || events[1].Connection.URL.Host != "foo1" {
+			t.Errorf("Unexpected event: %+v", events[1])
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if ConnectionDead.String() != "dead" || ConnectionEventType(42).String() != "ConnectionEventType(42)" {
+			t.Errorf("Unexpected string: %s, %s", ConnectionDead, ConnectionEventType(42))
+		}
+	})
+}
@@ -0,0 +1,316 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FaultKind defines the kind of failure injected by FaultTransport.
+type FaultKind int
+
+// Kinds of injected failures.
+const (
+	// FaultNone lets the request pass through to the wrapped transport.
+	FaultNone FaultKind = iota
+	// FaultRefuse fails the request with a "connection refused" network error.
+	FaultRefuse
+	// FaultTimeout fails the request with a network timeout error.
+	FaultTimeout
+	// FaultLatency delays the request by Fault.Latency of the transport clock before passing it through.
+	FaultLatency
+	// FaultStatus responds with Fault.StatusCode without passing the request through.
+	FaultStatus
+	// FaultTruncate passes the request through and cuts the response body after Fault.TruncateAt bytes.
+	FaultTruncate
+	// FaultEOF fails the request with io.EOF, as when the server closes the connection.
+	FaultEOF
+)
+
+// Fault describes a single injected failure.
+type Fault struct {
+	Kind       FaultKind
+	StatusCode int           // Status code for FaultStatus, eg. 429, 503 or 504.
+	Latency    time.Duration // Delay for FaultLatency.
+	TruncateAt int           // Number of body bytes returned before the error for FaultTruncate.
+}
+
+// FaultRule defines which requests get a fault injected.
+//
+// When Sequence is set, every matching request consumes one fault from it,
+// and the rule is exhausted at the end of the sequence. Otherwise, Fault is
+// injected with the given Probability; a zero probability means always.
+//
+// From and Until limit the rule to a time window of the transport clock.
+type FaultRule struct {
+	Host string // Node address (host:port) to match; empty matches every node.
+
+	Fault       Fault
+	Probability float64
+	Sequence    []Fault
+
+	From  time.Time
+	Until time.Time
+}
+
+// FaultTransport is an http.RoundTripper which injects failures into requests,
+// to test the retry and connection pool configuration of the client.
+//
+// Pass it as Config.Transport, together with the same Clock as Config.Clock
+// to control the timing of the rules and of the resurrection of dead connections.
+//
+// It is safe for concurrent use.
+type FaultTransport struct {
+	sync.Mutex
+
+	transport http.RoundTripper
+	clock     Clock
+	rand      *rand.Rand
+	rules     []*faultRule
+	counts    map[FaultKind]int
+}
+
+type faultRule struct {
+	FaultRule
+	next int // Index of the next fault in the sequence
+}
+
+// faultError is a net.Error returned for injected timeouts.
+type faultError struct {
+	host    string
+	timeout bool
+}
+
+// truncatedBody returns an error after a number of bytes have been read.
+type truncatedBody struct {
+	body      io.ReadCloser
+	remaining int
+}
+
+// NewFaultTransport creates a fault injecting transport wrapping t.
+//
+// http.DefaultTransport is used when t is nil, and the wall clock when clock is nil.
+func NewFaultTransport(t http.RoundTripper, clock Clock) *FaultTransport {
+	if t == nil {
+		t = http.DefaultTransport
+	}
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	return &FaultTransport{
+		transport: t,
+		clock:     clock,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec // not used for security
+		counts:    make(map[FaultKind]int),
+	}
+}
+
+// Seed initializes the random source for probabilistic rules, to make them reproducible.
+func (t *FaultTransport) Seed(seed int64) {
+	t.Lock()
+	defer t.Unlock()
+	t.rand = rand.New(rand.NewSource(seed)) //nolint:gosec // not used for security
+}
+
+// AddRule adds a rule; rules are evaluated in the order they were added, and the first match wins.
+func (t *FaultTransport) AddRule(r FaultRule) {
+	t.Lock()
+	defer t.Unlock()
+	t.rules = append(t.rules, &faultRule{FaultRule: r})
+}
+
+// NodeDown refuses connections to the node at host for the duration d, starting now.
+func (t *FaultTransport) NodeDown(host string, d time.Duration) {
+	now := t.clock.Now()
+	t.AddRule(FaultRule{
+		Host:  host,
+		Fault: Fault{Kind: FaultRefuse},
+		From:  now,
+		Until: now.Add(d),
+	})
+}
+
+// Reset removes all rules and counters.
+func (t *FaultTransport) Reset() {
+	t.Lock()
+	defer t.Unlock()
+	t.rules = nil
+	t.counts = make(map[FaultKind]int)
+}
+
+// Injected returns the number of injected faults of the given kind.
+func (t *FaultTransport) Injected(kind FaultKind) int {
+	t.Lock()
+	defer t.Unlock()
+	return t.counts[kind]
+}
+
+// RoundTrip executes the request, injecting a fault when a rule matches.
+func (t *FaultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fault := t.nextFault(req.URL.Host)
+
+	switch fault.Kind {
+	case FaultRefuse:
+		return nil, &net.OpError{
+			Op:  "dial",
+			Net: "tcp",
+			Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED},
+		}
+	case FaultTimeout:
+		return nil, &faultError{host: req.URL.Host, timeout: true}
+	case FaultEOF:
+		return nil, io.EOF
+	case FaultStatus:
+		return newFaultResponse(req, fault.StatusCode), nil
+	case FaultLatency:
+		done := make(chan struct{})
+		timer := t.clock.AfterFunc(fault.Latency, func() { close(done) })
+		select {
+		case <-done:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	if fault.Kind == FaultTruncate && res.Body != nil {
+		res.Body = &truncatedBody{body: res.Body, remaining: fault.TruncateAt}
+	}
+
+	return res, nil
+}
+
+// nextFault returns the fault for a request to host, or a fault of kind FaultNone.
+func (t *FaultTransport) nextFault(host string) Fault {
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.clock.Now()
+
+	for _, r := range t.rules {
+		if r.Host != "" && r.Host != host {
+			continue
+		}
+		if !r.From.IsZero() && now.Before(r.From) {
+			continue
+		}
+		if !r.Until.IsZero() && !now.Before(r.Until) {
+			continue
+		}
+
+		var fault Fault
+		if len(r.Sequence) > 0 {
+			if r.next >= len(r.Sequence) {
+				continue
+			}
+			fault = r.Sequence[r.next]
+			r.next++
+		} else {
+			if r.Probability > 0 && t.rand.Float64() >= r.Probability {
+				continue
+			}
+			fault = r.Fault
+		}
+
+		if fault.Kind != FaultNone {
+			t.counts[fault.Kind]++
+		}
+		return fault
+	}
+
+	return Fault{Kind: FaultNone}
+}
+
+func newFaultResponse(req *http.Request, code int) *http.Response {
+	body := fmt.Sprintf(
+		`{"error":{"type":"fault_injection_exception","reason":"injected status %d"},"status":%d}`,
+		code,
+		code,
+	)
+
+	return &http.Response{
+		Status:        strconv.Itoa(code) + " " + http.StatusText(code),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json; charset=UTF-8"}},
+		Body:          io.NopCloser(bytes.NewBufferString(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// String returns a readable name of the fault kind.
+func (k FaultKind) String() string {
+	switch k {
+	case FaultNone:
+		return "none"
+	case FaultRefuse:
+		return "refuse"
+	case FaultTimeout:
+		return "timeout"
+	case FaultLatency:
+		return "latency"
+	case FaultStatus:
+		return "status"
+	case FaultTruncate:
+		return "truncate"
+	case FaultEOF:
+		return "eof"
+	default:
+		return "FaultKind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Error returns the error message.
+func (e *faultError) Error() string {
+	return fmt.Sprintf("injected fault: %s: i/o timeout", e.host)
+}
+
+// Timeout returns true for injected timeouts.
+func (e *faultError) Timeout() bool { return e.timeout }
+
+// Temporary returns true for injected timeouts.
+func (e *faultError) Temporary() bool { return e.timeout }
+
+// Read reads from the body until the limit is reached, then returns io.ErrUnexpectedEOF.
+func (b *truncatedBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if len(p) > b.remaining {
+		p = p[:b.remaining]
+	}
+	n, err := b.body.Read(p)
+	b.remaining -= n
+	return n, err
+}
+
+// Close closes the underlying body.
+func (b *truncatedBody) Close() error {
+	return b.body.Close()
+}
@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearchtransport
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newFaultTestTransport() *mockTransp {
+	return &mockTransp{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Body:       io.NopCloser(strings.NewReader(`{"host":"` + req.URL.Host + `"}`)),
+			}, nil
+		},
+	}
+}
+
+func TestFaultTransport(t *testing.T) {
+	t.Run("Node dies and comes back", func(t *testing.T) {
+		clock := NewFakeClock(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+		ft := NewFaultTransport(newFaultTestTransport(), clock)
+
+		tp, _ := New(Config{
+			URLs: []*url.URL{
+				{Scheme: "http", Host: "foo1"},
+				{Scheme: "http", Host: "foo2"},
+				{Scheme: "http", Host: "foo3"},
+			},
+			Transport: ft,
+			Clock:     clock,
+		})
+
+		ft.NodeDown("foo2", 30*time.Second)
+
+		for i := 0; i < 6; i++ {
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			res, err := tp.Perform(req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if res.StatusCode != http.StatusOK {
+				t.Errorf("Unexpected status: %d", res.StatusCode)
+			}
+		}
+
+		if n := ft.Injected(FaultRefuse); n != 1 {
+			t.Errorf("Expected 1 refused connection, got: %d", n)
+		}
+
+		pool := tp.pool.(*statusConnectionPool)
+		if len(pool.live) != 2 || len(pool.dead) != 1 || pool.dead[0].URL.Host != "foo2" {
+			t.Fatalf("Expected foo2 to be dead, got: live=%s dead=%s", pool.live, pool.dead)
+		}
+		if !pool.dead[0].DeadSince.Equal(clock.Now()) {
+			t.Errorf("Unexpected dead since: %s", pool.dead[0].DeadSince)
+		}
+
+		clock.Advance(30 * time.Second)
+		if len(pool.dead) != 1 {
+			t.Errorf("Expected foo2 to be dead until the resurrect timeout, got: dead=%s", pool.dead)
+		}
+
+		clock.Advance(30 * time.Second)
+		if len(pool.live) != 3 || len(pool.dead) != 0 {
+			t.Fatalf("Expected foo2 to be resurrected, got: live=%s dead=%s", pool.live, pool.dead)
+		}
+
+		for i := 0; i < 3; i++ {
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			if _, err := tp.Perform(req); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+
+		if n := ft.Injected(FaultRefuse); n != 1 {
+			t.Errorf("Expected no more refused connections, got: %d", n)
+		}
+		if len(pool.live) != 3 {
+			t.Errorf("Expected all connections to be live, got: %s", pool.live)
+		}
+	})
+
+	t.Run("Sequence", func(t *testing.T) {
+		ft := NewFaultTransport(newFaultTestTransport(), nil)
+		ft.AddRule(FaultRule{Sequence: []Fault{
+			{Kind: FaultStatus, StatusCode: http.StatusTooManyRequests},
+			{Kind: FaultNone},
+			{Kind: FaultEOF},
+			{Kind: FaultTimeout},
+			{Kind: FaultRefuse},
+		}})
+
+		req, _ := http.NewRequest(http.MethodGet, "http://foo1/", nil)
+
+		res, err := ft.RoundTrip(req)
+		if err != nil || res.StatusCode != http.StatusTooManyRequests {
+			t.Fatalf("Expected 429 response, got: %v, %v", res, err)
+		}
+		res.Body.Close()
+
+		res, err = ft.RoundTrip(req)
+		if err != nil || res.StatusCode != http.StatusOK {
+			t.Fatalf("Expected 200 response, got: %v, %v", res, err)
+		}
+		res.Body.Close()
+
+		//nolint:bodyclose // No response is returned on error
+		if _, err = ft.RoundTrip(req); !errors.Is(err, io.EOF) {
+			t.Errorf("Expected io.EOF, got: %v", err)
+		}
+
+		var netErr net.Error
+		//nolint:bodyclose // No response is returned on error
+		if _, err = ft.RoundTrip(req); !errors.As(err, &netErr) || !netErr.Timeout() {
+			t.Errorf("Expected timeout error, got: %v", err)
+		}
+
+		//nolint:bodyclose // No response is returned on error
+		if _, err = ft.RoundTrip(req); !errors.As(err, &netErr) || netErr.Timeout() || !strings.Contains(err.Error(), "refused") {
+			t.Errorf("Expected connection refused error, got: %v", err)
+		}
+
+		res, err = ft.RoundTrip(req)
+		if err != nil || res.StatusCode != http.StatusOK {
+			t.Fatalf("Expected exhausted sequence to pass through, got: %v, %v", res, err)
+		}
+		res.Body.Close()
+	})
+
+	t.Run("Probability", func(t *testing.T) {
+		ft := NewFaultTransport(newFaultTestTransport(), nil)
+		ft.Seed(42)
+		ft.AddRule(FaultRule{Fault: Fault{Kind: FaultStatus, StatusCode: http.StatusServiceUnavailable}, Probability: 0.5})
+
+		var failed int
+		for i := 0; i < 1000; i++ {
+			req, _ := http.NewRequest(http.MethodGet, "http://foo1/", nil)
+			res, _ := ft.RoundTrip(req)
+			if res.StatusCode == http.StatusServiceUnavailable {
+				failed++
+			}
+			res.Body.Close()
+		}
+
+		if failed < 400 || failed > 600 {
+			t.Errorf("Unexpected number of injected faults: %d", failed)
+		}
+		if ft.Injected(FaultStatus) != failed {
+			t.Errorf("Unexpected counter: %d, want: %d", ft.Injected(FaultStatus), failed)
+		}
+	})
+
+	t.Run("Host", func(t *testing.T) {
+		ft := NewFaultTransport(newFaultTestTransport(), nil)
+		ft.AddRule(FaultRule{Host: "foo2", Fault: Fault{Kind: FaultStatus, StatusCode: http.StatusGatewayTimeout}})
+
+		for host, code := range map[string]int{"foo1": http.StatusOK, "foo2": http.StatusGatewayTimeout} {
+			req, _ := http.NewRequest(http.MethodGet, "http://"+host+"/", nil)
+			res, err := ft.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if res.StatusCode != code {
+				t.Errorf("Unexpected status for %s: %d, want: %d", host, res.StatusCode, code)
+			}
+			res.Body.Close()
+		}
+	})
+
+	t.Run("Truncate", func(t *testing.T) {
+		ft := NewFaultTransport(newFaultTestTransport(), nil)
+		ft.AddRule(FaultRule{Fault: Fault{Kind: FaultTruncate, TruncateAt: 5}})
+
+		req, _ := http.NewRequest(http.MethodGet, "http://foo1/", nil)
+		res, err := ft.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("Expected io.ErrUnexpectedEOF, got: %v", err)
+		}
+		if string(body) != `{"hos` {
+			t.Errorf("Unexpected body: %s", body)
+		}
+	})
+
+	t.Run("Latency", func(t *testing.T) {
+		ft := NewFaultTransport(newFaultTestTransport(), nil)
+		ft.AddRule(FaultRule{Fault: Fault{Kind: FaultLatency, Latency: 20 * time.Millisecond}})
+
+		req, _ := http.NewRequest(http.MethodGet, "http://foo1/", nil)
+		start := time.Now()
+		res, err := ft.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		res.Body.Close()
+
+		if time.Since(start) < 20*time.Millisecond {
+			t.Errorf("Expected the request to be delayed, took: %s", time.Since(start))
+		}
+	})
+
+	t.Run("Latency with clock", func(t *testing.T) {
+		clock := NewFakeClock(time.Unix(0, 0))
+		ft := NewFaultTransport(newFaultTestTransport(), clock)
+		ft.AddRule(FaultRule{Fault: Fault{Kind: FaultLatency, Latency: time.Hour}})
+
+		errs := make(chan error, 1)
+		go func() {
+			req, _ := http.NewRequest(http.MethodGet, "http://foo1/", nil)
+			res, err := ft.RoundTrip(req)
+			if err == nil {
+				res.Body.Close()
+			}
+			errs <- err
+		}()
+
+		for {
+			clock.Lock()
+			n := len(clock.timers)
+			clock.Unlock()
+			if n > 0 {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+
+		select {
+		case err := <-errs:
+			t.Fatalf("Expected the request to wait for the clock, got: %v", err)
+		default:
+		}
+
+		clock.Advance(time.Hour)
+		if err := <-errs; err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	})
+
+	t.Run("Retry on injected status", func(t *testing.T) {
+		ft := NewFaultTransport(newFaultTestTransport(), nil)
+		ft.AddRule(FaultRule{Sequence: []Fault{
+			{Kind: FaultStatus, StatusCode: http.StatusServiceUnavailable},
+			{Kind: FaultStatus, StatusCode: http.StatusBadGateway},
+		}})
+
+		tp, _ := New(Config{URLs: []*url.URL{{Scheme: "http", Host: "foo1"}}, Transport: ft})
+
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		res, err := tp.Perform(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("Unexpected status: %d", res.StatusCode)
+		}
+	})
+}
+
+func TestFakeClock(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+	var fired []string
+	clock.AfterFunc(2*time.Second, func() { fired = append(fired, "b") })
+	clock.AfterFunc(time.Second, func() { fired = append(fired, "a") })
+	stopped := clock.AfterFunc(time.Second, func() { fired = append(fired, "c") })
+
+	if !stopped.Stop() {
+		t.Errorf("Expected timer to be stopped")
+	}
+
+	clock.Advance(time.Second)
+	if strings.Join(fired, ",") != "a" {
+		t.Errorf("Unexpected timers fired: %v", fired)
+	}
+
+	clock.Advance(time.Second)
+	if strings.Join(fired, ",") != "a,b" {
+		t.Errorf("Unexpected timers fired: %v", fired)
+	}
+
+	if stopped.Stop() {
+		t.Errorf("Expected stopped timer to not be stopped again")
+	}
+}
@@ -93,12 +93,17 @@ type debuggingLogger struct {
 
 // LogRoundTrip prints the information about request and response.
 func (l *TextLogger) LogRoundTrip(req *http.Request, res *http.Response, err error, start time.Time, dur time.Duration) error {
-	fmt.Fprintf(l.Output, "%s %s %s [status:%d request:%s]\n",
+	var opaqueID string
+	if id := req.Header.Get(HeaderOpaqueID); id != "" {
+		opaqueID = " opaque_id:" + id
+	}
+	fmt.Fprintf(l.Output, "%s %s %s [status:%d request:%s%s]\n",
 		start.Format(time.RFC3339),
 		req.Method,
 		req.URL.String(),
 		resStatusCode(res),
 		dur.Truncate(time.Millisecond),
+		opaqueID,
 	)
 	if l.RequestBodyEnabled() && req != nil && req.Body != nil && req.Body != http.NoBody {
 		var buf bytes.Buffer
@@ -153,7 +158,12 @@ func (l *ColorLogger) LogRoundTrip(req *http.Request, res *http.Response, err er
 		color = "\x1b[31;4m"
 	}
 
-	fmt.Fprintf(l.Output, "%6s \x1b[1;4m%s://%s%s\x1b[0m%s %s%s\x1b[0m \x1b[2m%s\x1b[0m\n",
+	var opaqueID string
+	if id := req.Header.Get(HeaderOpaqueID); id != "" {
+		opaqueID = " [" + id + "]"
+	}
+
+	fmt.Fprintf(l.Output, "%6s \x1b[1;4m%s://%s%s\x1b[0m%s %s%s\x1b[0m \x1b[2m%s%s\x1b[0m\n",
 		req.Method,
 		req.URL.Scheme,
 		req.URL.Host,
@@ -162,6 +172,7 @@ func (l *ColorLogger) LogRoundTrip(req *http.Request, res *http.Response, err er
 		color,
 		status,
 		dur.Truncate(time.Millisecond),
+		opaqueID,
 	)
 
 	if l.RequestBodyEnabled() && req != nil && req.Body != nil && req.Body != http.NoBody {
@@ -351,6 +362,10 @@ func (l *JSONLogger) LogRoundTrip(req *http.Request, res *http.Response, err err
 	b.WriteString(`{"request":{`)
 	b.WriteString(`"method":`)
 	appendQuote(req.Method)
+	if id := req.Header.Get(HeaderOpaqueID); id != "" {
+		b.WriteString(`,"opaque_id":`)
+		appendQuote(id)
+	}
 	if l.RequestBodyEnabled() && req != nil && req.Body != nil && req.Body != http.NoBody {
 		var buf bytes.Buffer
 		if req.GetBody != nil {
@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileNodeSource reads the nodes from a file.
+//
+// The file contains either a JSON array of nodes, eg.:
+//
+//	[{"url": "https://node1:9200", "name": "node1", "roles": ["data", "ingest"], "attributes": {"zone": "a"}}]
+//
+// or a node URL per line, with blank lines and lines starting with # ignored.
+// A line without a scheme uses the Scheme of the source.
+//
+// The file is watched by the client, which discovers the nodes again when the file changes.
+// It is parsed again only when its modification time or size has changed.
+type FileNodeSource struct {
+	Path          string
+	Scheme        string        // Default: http.
+	WatchInterval time.Duration // Interval to check the file for changes. Default: 1s.
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	nodes   []fileNode
+	done    chan struct{}
+}
+
+const defaultFileWatchInterval = time.Second
+
+// fileNode represents a node in the JSON format of the file.
+type fileNode struct {
+	URL        string                 `json:"url"`
+	ID         string                 `json:"id,omitempty"`
+	Name       string                 `json:"name,omitempty"`
+	Roles      []string               `json:"roles,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
+	u *url.URL
+}
+
+// NewFileNodeSource returns a node source reading the nodes from the file at path.
+func NewFileNodeSource(path string) *FileNodeSource {
+	return &FileNodeSource{Path: path}
+}
+
+// Nodes returns a connection for every node in the file.
+func (s *FileNodeSource) Nodes(ctx context.Context) ([]*Connection, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fi, err := os.Stat(s.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.nodes == nil || !fi.ModTime().Equal(s.modTime) || fi.Size() != s.size {
+		b, err := os.ReadFile(s.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes, err := s.parse(b)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %s: %w", s.Path, err)
+		}
+
+		s.nodes, s.modTime, s.size = nodes, fi.ModTime(), fi.Size()
+	}
+
+	conns := make([]*Connection, 0, len(s.nodes))
+	for _, node := range s.nodes {
+		u := *node.u
+		conns = append(conns, &Connection{
+			URL:        &u,
+			ID:         node.ID,
+			Name:       node.Name,
+			Roles:      node.Roles,
+			Attributes: node.Attributes,
+		})
+	}
+
+	return conns, nil
+}
+
+// Watch checks the file for changes every WatchInterval and calls changed when it has changed,
+// until Close is called.
+func (s *FileNodeSource) Watch(changed func()) {
+	s.mu.Lock()
+	if s.done != nil {
+		s.mu.Unlock()
+		return
+	}
+	done := make(chan struct{})
+	s.done = done
+	s.mu.Unlock()
+
+	interval := s.WatchInterval
+	if interval <= 0 {
+		interval = defaultFileWatchInterval
+	}
+
+	var modTime time.Time
+	var size int64
+	if fi, err := os.Stat(s.Path); err == nil {
+		modTime, size = fi.ModTime(), fi.Size()
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			fi, err := os.Stat(s.Path)
+			if err != nil || (fi.ModTime().Equal(modTime) && fi.Size() == size) {
+				continue
+			}
+			modTime, size = fi.ModTime(), fi.Size()
+			changed()
+		}
+	}()
+}
+
+// Close stops watching the file.
+func (s *FileNodeSource) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.done != nil {
+		close(s.done)
+		s.done = nil
+	}
+	return nil
+}
+
+func (s *FileNodeSource) parse(b []byte) ([]fileNode, error) {
+	var nodes []fileNode
+
+	if b = bytes.TrimSpace(b); bytes.HasPrefix(b, []byte("[")) {
+		if err := json.Unmarshal(b, &nodes); err != nil {
+			return nil, err
+		}
+	} else {
+		scanner := bufio.NewScanner(bytes.NewReader(b))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			nodes = append(nodes, fileNode{URL: line})
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	scheme := s.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+
+	for i := range nodes {
+		addr := nodes[i].URL
+		if addr == "" {
+			return nil, fmt.Errorf("node %d: missing url", i)
+		}
+		if !strings.Contains(addr, "://") {
+			addr = scheme + "://" + addr
+		}
+
+		u, err := url.Parse(strings.TrimRight(addr, "/"))
+		if err != nil {
+			return nil, fmt.Errorf("node %d: %w", i, err)
+		}
+		nodes[i].u = u
+	}
+
+	if nodes == nil {
+		nodes = []fileNode{}
+	}
+
+	return nodes, nil
+}
+
+// resolver defines the DNS lookups used by DNSNodeSource; it's implemented by net.Resolver.
+type resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// DNSNodeSource resolves the nodes from DNS records on every discovery,
+// eg. for a headless service in Kubernetes.
+//
+// By default, the A and AAAA records of Host are resolved, and the nodes are addressed by IP with Port.
+// Note that addressing the nodes by IP requires certificates valid for the IP addresses when using TLS.
+//
+// When SRV is set, the SRV records of _Service._Proto.Host are resolved instead,
+// or the records of Host when both Service and Proto are empty;
+// the nodes are addressed by the target host name and port of the records.
+type DNSNodeSource struct {
+	Host   string
+	Port   int    // Default: 9200; ignored for SRV records.
+	Scheme string // Default: http.
+
+	SRV     bool
+	Service string
+	Proto   string
+
+	Resolver *net.Resolver // Default: net.DefaultResolver.
+
+	lookup resolver
+}
+
+// Nodes returns a connection for every resolved address.
+func (s *DNSNodeSource) Nodes(ctx context.Context) ([]*Connection, error) {
+	var (
+		r     resolver
+		addrs []string
+	)
+
+	switch {
+	case s.lookup != nil:
+		r = s.lookup
+	case s.Resolver != nil:
+		r = s.Resolver
+	default:
+		r = net.DefaultResolver
+	}
+
+	scheme := s.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+
+	if s.SRV {
+		_, records, err := r.LookupSRV(ctx, s.Service, s.Proto, s.Host)
+		if err != nil {
+			return nil, err
+		}
+		for _, rec := range records {
+			addrs = append(addrs, net.JoinHostPort(strings.TrimSuffix(rec.Target, "."), strconv.Itoa(int(rec.Port))))
+		}
+	} else {
+		port := s.Port
+		if port == 0 {
+			port = 9200
+		}
+
+		ips, err := r.LookupIPAddr(ctx, s.Host)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			addrs = append(addrs, net.JoinHostPort(ip.IP.String(), strconv.Itoa(port)))
+		}
+	}
+
+	sort.Strings(addrs)
+
+	conns := make([]*Connection, 0, len(addrs))
+	for _, addr := range addrs {
+		host, _, _ := net.SplitHostPort(addr)
+		conns = append(conns, &Connection{
+			URL:  &url.URL{Scheme: scheme, Host: addr},
+			Name: host,
+		})
+	}
+
+	return conns, nil
+}
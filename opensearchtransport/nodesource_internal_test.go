@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearchtransport
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type mockResolver struct {
+	ips     []net.IPAddr
+	records []*net.SRV
+	err     error
+
+	srv []string
+}
+
+func (r *mockResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	return r.ips, r.err
+}
+
+func (r *mockResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	r.srv = []string{service, proto, name}
+	return "", r.records, r.err
+}
+
+type mockNodeSource struct {
+	conns []*Connection
+	err   error
+}
+
+func (s *mockNodeSource) Nodes(ctx context.Context) ([]*Connection, error) {
+	return s.conns, s.err
+}
+
+func connURLs(conns []*Connection) []string {
+	var out []string
+	for _, c := range conns {
+		out = append(out, c.URL.String())
+	}
+	return out
+}
+
+func TestFileNodeSource(t *testing.T) {
+	write := func(t *testing.T, path, content string, mtime time.Time) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	t.Run("URL lines", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "nodes.txt")
+		write(t, path, "# nodes\nhttps://node1:9200/\n\nnode2:9200\n", time.Unix(1, 0))
+
+		s := NewFileNodeSource(path)
+		conns, err := s.Nodes(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if want := []string{"https://node1:9200", "http://node2:9200"}; !reflect.DeepEqual(connURLs(conns), want) {
+			t.Errorf("Unexpected nodes: %v, want: %v", connURLs(conns), want)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "nodes.json")
+		write(t, path, `[
+			{"url": "http://node1:9200", "id": "abc", "name": "node1", "roles": ["data", "ingest"], "attributes": {"zone": "a"}},
+			{"url": "http://node2:9200", "roles": ["cluster_manager"]}
+		]`, time.Unix(1, 0))
+
+		conns, err := NewFileNodeSource(path).Nodes(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if len(conns) != 2 {
+			t.Fatalf("Unexpected nodes: %v", conns)
+		}
+		c := conns[0]
+		if c.URL.String() != "http://node1:9200" || c.ID != "abc" || c.Name != "node1" ||
+			!reflect.DeepEqual(c.Roles, []string{"data", "ingest"}) || c.Attributes["zone"] != "a" {
+			t.Errorf("Unexpected connection: %+v", c)
+		}
+	})
+
+	t.Run("Changes", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "nodes.txt")
+		write(t, path, "http://node1:9200\n", time.Unix(1, 0))
+
+		s := NewFileNodeSource(path)
+		first, _ := s.Nodes(context.Background())
+		second, _ := s.Nodes(context.Background())
+
+		if first[0] == second[0] || first[0].URL == second[0].URL {
+			t.Errorf("Expected new connections on every call")
+		}
+
+		write(t, path, "http://node1:9200\nhttp://node2:9200\n", time.Unix(2, 0))
+		conns, err := s.Nodes(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(conns) != 2 {
+			t.Errorf("Expected the changed file to be read, got: %v", connURLs(conns))
+		}
+	})
+
+	t.Run("Watch", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "nodes.txt")
+		write(t, path, "http://node1:9200\n", time.Unix(1, 0))
+
+		s := &FileNodeSource{Path: path, WatchInterval: 5 * time.Millisecond}
+		defer s.Close()
+
+		tp, _ := New(Config{URLs: []*url.URL{{Scheme: "http", Host: "foo"}}, NodeSource: s})
+
+		write(t, path, "http://node1:9200\nhttp://node2:9200\n", time.Unix(2, 0))
+
+		var urls []string
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(5 * time.Millisecond) {
+			urls = urls[:0]
+			for _, u := range tp.URLs() {
+				urls = append(urls, u.String())
+			}
+			if len(urls) == 2 {
+				break
+			}
+		}
+		if want := []string{"http://node1:9200", "http://node2:9200"}; !reflect.DeepEqual(urls, want) {
+			t.Errorf("Expected the nodes to be discovered when the file changes, got: %v", urls)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		dir := t.TempDir()
+
+		if _, err := NewFileNodeSource(filepath.Join(dir, "missing")).Nodes(context.Background()); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("Expected not exist error, got: %v", err)
+		}
+
+		path := filepath.Join(dir, "nodes.json")
+		write(t, path, `[{"name": "node1"}]`, time.Unix(1, 0))
+		if _, err := NewFileNodeSource(path).Nodes(context.Background()); err == nil {
+			t.Errorf("Expected error for missing URL")
+		}
+	})
+}
+
+func TestDNSNodeSource(t *testing.T) {
+	t.Run("A records", func(t *testing.T) {
+		s := &DNSNodeSource{
+			Host:   "opensearch.default.svc",
+			Scheme: "https",
+			lookup: &mockResolver{ips: []net.IPAddr{{IP: net.ParseIP("10.0.0.2")}, {IP: net.ParseIP("10.0.0.1")}, {IP: net.ParseIP("fd00::1")}}},
+		}
+
+		conns, err := s.Nodes(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		want := []string{"https://10.0.0.1:9200", "https://10.0.0.2:9200", "https://[fd00::1]:9200"}
+		if !reflect.DeepEqual(connURLs(conns), want) {
+			t.Errorf("Unexpected nodes: %v, want: %v", connURLs(conns), want)
+		}
+		if conns[2].Name != "fd00::1" {
+			t.Errorf("Unexpected name: %s", conns[2].Name)
+		}
+	})
+
+	t.Run("SRV records", func(t *testing.T) {
+		r := &mockResolver{records: []*net.SRV{
+			{Target: "opensearch-1.opensearch.default.svc.", Port: 9200},
+			{Target: "opensearch-0.opensearch.default.svc.", Port: 9201},
+		}}
+		s := &DNSNodeSource{Host: "opensearch.default.svc", SRV: true, Service: "http", Proto: "tcp", lookup: r}
+
+		conns, err := s.Nodes(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		want := []string{"http://opensearch-0.opensearch.default.svc:9201", "http://opensearch-1.opensearch.default.svc:9200"}
+		if !reflect.DeepEqual(connURLs(conns), want) {
+			t.Errorf("Unexpected nodes: %v, want: %v", connURLs(conns), want)
+		}
+		if !reflect.DeepEqual(r.srv, []string{"http", "tcp", "opensearch.default.svc"}) {
+			t.Errorf("Unexpected lookup: %v", r.srv)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockErr := errors.New("MOCK ERROR")
+		if _, err := (&DNSNodeSource{Host: "foo", lookup: &mockResolver{err: mockErr}}).Nodes(context.Background()); !errors.Is(err, mockErr) {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
+
+func TestDiscoverNodesWithNodeSource(t *testing.T) {
+	t.Run("Nodes", func(t *testing.T) {
+		source := &mockNodeSource{conns: []*Connection{
+			{URL: &url.URL{Scheme: "http", Host: "node1:9200"}, Roles: []string{"data"}},
+			{URL: &url.URL{Scheme: "http", Host: "node2:9200"}, Roles: []string{"cluster_manager"}},
+			{URL: &url.URL{Scheme: "http", Host: "node3:9200"}},
+		}}
+
+		tp, _ := New(Config{URLs: []*url.URL{{Scheme: "http", Host: "foo"}}, NodeSource: source})
+		if err := tp.DiscoverNodes(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		var urls []string
+		for _, u := range tp.URLs() {
+			urls = append(urls, u.String())
+		}
+		if want := []string{"http://node1:9200", "http://node3:9200"}; !reflect.DeepEqual(urls, want) {
+			t.Errorf("Unexpected URLs: %v, want: %v", urls, want)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockErr := errors.New("MOCK ERROR")
+		tp, _ := New(Config{URLs: []*url.URL{{Scheme: "http", Host: "foo"}}, NodeSource: &mockNodeSource{err: mockErr}})
+
+		if err := tp.DiscoverNodes(); !errors.Is(err, mockErr) {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
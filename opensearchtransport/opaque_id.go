@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchtransport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// HeaderOpaqueID is the name of the header used to correlate requests
+// with tasks and slow log entries in OpenSearch.
+const HeaderOpaqueID = "X-Opaque-Id"
+
+type opaqueIDKey struct{}
+
+// ContextWithOpaqueID returns a copy of ctx which carries the opaque ID.
+//
+// The client sets the X-Opaque-Id header to this value for every request performed
+// with the returned context, unless the request already has the header set.
+func ContextWithOpaqueID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, opaqueIDKey{}, id)
+}
+
+// OpaqueIDFromContext returns the opaque ID carried by ctx, if any.
+func OpaqueIDFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	id, ok := ctx.Value(opaqueIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// setReqOpaqueID sets the X-Opaque-Id header from the request context.
+func (c *Client) setReqOpaqueID(req *http.Request) {
+	if req.Header.Get(HeaderOpaqueID) != "" {
+		return
+	}
+	if id, ok := OpaqueIDFromContext(req.Context()); ok {
+		req.Header.Set(HeaderOpaqueID, id)
+	}
+}
+
+// wrapOpaqueIDError adds the opaque ID of the request, if any, to err.
+func wrapOpaqueIDError(req *http.Request, err error) error {
+	if err == nil {
+		return nil
+	}
+	if id := req.Header.Get(HeaderOpaqueID); id != "" {
+		return fmt.Errorf("%w (opaque_id: %s)", err, id)
+	}
+	return err
+}
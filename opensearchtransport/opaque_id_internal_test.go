@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearchtransport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestTransportOpaqueID(t *testing.T) {
+	newTransport := func(headers *[]string, err error) *mockTransp {
+		return &mockTransp{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				*headers = append(*headers, req.Header.Get(HeaderOpaqueID))
+				if err != nil {
+					return nil, err
+				}
+				return &http.Response{StatusCode: http.StatusOK, Status: "200 OK", Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+			},
+		}
+	}
+
+	t.Run("Context", func(t *testing.T) {
+		ctx := ContextWithOpaqueID(context.Background(), "req-1")
+		if id, ok := OpaqueIDFromContext(ctx); !ok || id != "req-1" {
+			t.Errorf("Unexpected opaque ID: %q", id)
+		}
+
+		if _, ok := OpaqueIDFromContext(context.Background()); ok {
+			t.Errorf("Expected no opaque ID")
+		}
+
+		if _, ok := OpaqueIDFromContext(ContextWithOpaqueID(context.Background(), "")); ok {
+			t.Errorf("Expected empty opaque ID to be ignored")
+		}
+	})
+
+	t.Run("Perform", func(t *testing.T) {
+		var headers []string
+		tp, _ := New(Config{URLs: []*url.URL{{Scheme: "http", Host: "foo"}}, Transport: newTransport(&headers, nil)})
+
+		req, _ := http.NewRequestWithContext(ContextWithOpaqueID(context.Background(), "req-1"), http.MethodGet, "/", nil)
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		req, _ = http.NewRequestWithContext(ContextWithOpaqueID(context.Background(), "req-2"), http.MethodGet, "/", nil)
+		req.Header.Set(HeaderOpaqueID, "explicit")
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		req, _ = http.NewRequest(http.MethodGet, "/", nil)
+		if _, err := tp.Perform(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if strings.Join(headers, ",") != "req-1,explicit," {
+			t.Errorf("Unexpected headers: %q", headers)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		var (
+			headers []string
+			mockErr = errors.New("MOCK ERROR")
+		)
+		tp, _ := New(Config{
+			URLs:         []*url.URL{{Scheme: "http", Host: "foo"}},
+			Transport:    newTransport(&headers, mockErr),
+			DisableRetry: true,
+		})
+
+		req, _ := http.NewRequestWithContext(ContextWithOpaqueID(context.Background(), "req-1"), http.MethodGet, "/", nil)
+		//nolint:bodyclose // No response is returned on error
+		_, err := tp.Perform(req)
+		if !errors.Is(err, mockErr) {
+			t.Fatalf("Expected the original error to be wrapped, got: %v", err)
+		}
+		if !strings.Contains(err.Error(), "opaque_id: req-1") {
+			t.Errorf("Expected the error to contain the opaque ID, got: %s", err)
+		}
+	})
+
+	t.Run("Logger", func(t *testing.T) {
+		var (
+			headers []string
+			text    strings.Builder
+			js      strings.Builder
+		)
+
+		for _, logger := range []Logger{&TextLogger{Output: &text}, &JSONLogger{Output: &js}} {
+			tp, _ := New(Config{
+				URLs:      []*url.URL{{Scheme: "http", Host: "foo"}},
+				Transport: newTransport(&headers, nil),
+				Logger:    logger,
+			})
+
+			req, _ := http.NewRequestWithContext(ContextWithOpaqueID(context.Background(), "req-1"), http.MethodGet, "/", nil)
+			if _, err := tp.Perform(req); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+
+		if !strings.Contains(text.String(), "opaque_id:req-1]") {
+			t.Errorf("Unexpected text output: %s", text.String())
+		}
+
+		var j struct {
+			HTTP struct {
+				Request struct {
+					OpaqueID string `json:"opaque_id"`
+				} `json:"request"`
+			} `json:"http"`
+		}
+		if err := json.Unmarshal([]byte(js.String()), &j); err != nil {
+			t.Fatalf("Error decoding JSON: %s", err)
+		}
+		if j.HTTP.Request.OpaqueID != "req-1" {
+			t.Errorf("Unexpected JSON output: %s", js.String())
+		}
+	})
+}
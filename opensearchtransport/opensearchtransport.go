@@ -84,10 +84,22 @@ type Config struct {
 
 	DiscoverNodesInterval time.Duration
 
+	// NodeSource is the source of nodes for node discovery.
+	// Defaults to fetching the nodes from the cluster with the nodes info API.
+	NodeSource NodeSource
+
 	Transport http.RoundTripper
 	Logger    Logger
 	Selector  Selector
 
+	// Clock is the time source of the connection pool, eg. for scheduling the resurrection of dead connections.
+	// Defaults to the wall clock.
+	Clock Clock
+
+	// ConnectionPoolListener receives the events of the connection pool, such as dead, resurrected,
+	// discovered and removed connections.
+	ConnectionPoolListener ConnectionPoolListener
+
 	ConnectionPoolFunc func([]*Connection, Selector) ConnectionPool
 }
 
@@ -110,6 +122,7 @@ type Client struct {
 	retryBackoff          func(attempt int) time.Duration
 	discoverNodesInterval time.Duration
 	discoverNodesTimer    *time.Timer
+	nodeSource            NodeSource
 
 	compressRequestBody bool
 
@@ -118,6 +131,8 @@ type Client struct {
 	transport http.RoundTripper
 	logger    Logger
 	selector  Selector
+	clock     Clock
+	listener  ConnectionPoolListener
 	pool      ConnectionPool
 	poolFunc  func([]*Connection, Selector) ConnectionPool
 }
@@ -173,36 +188,39 @@ func New(cfg Config) (*Client, error) {
 		maxRetries:            cfg.MaxRetries,
 		retryBackoff:          cfg.RetryBackoff,
 		discoverNodesInterval: cfg.DiscoverNodesInterval,
+		nodeSource:            cfg.NodeSource,
 
 		compressRequestBody: cfg.CompressRequestBody,
 
 		transport: cfg.Transport,
 		logger:    cfg.Logger,
 		selector:  cfg.Selector,
+		clock:     cfg.Clock,
+		listener:  cfg.ConnectionPoolListener,
 		poolFunc:  cfg.ConnectionPoolFunc,
 	}
 
 	client.userAgent = initUserAgent()
 
-	if client.poolFunc != nil {
-		client.pool = client.poolFunc(conns, client.selector)
-	} else {
-		client.pool = NewConnectionPool(conns, client.selector)
-	}
-
 	if cfg.EnableDebugLogger {
 		debugLogger = &debuggingLogger{Output: os.Stdout}
 	}
 
 	if cfg.EnableMetrics {
 		client.metrics = &metrics{responses: make(map[int]int)}
-		// TODO(karmi): Type assertion to interface
-		if pool, ok := client.pool.(*singleConnectionPool); ok {
-			pool.metrics = client.metrics
-		}
-		if pool, ok := client.pool.(*statusConnectionPool); ok {
-			pool.metrics = client.metrics
-		}
+	}
+
+	if client.nodeSource == nil {
+		client.nodeSource = &sniffNodeSource{client: &client}
+	}
+
+	client.pool = client.newConnectionPool(conns)
+
+	if w, ok := client.nodeSource.(NodeWatcher); ok {
+		w.Watch(func() {
+			//nolint:errcheck // errors are logged inside the function
+			client.DiscoverNodes()
+		})
 	}
 
 	if client.discoverNodesInterval > 0 {
@@ -231,16 +249,17 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 	// Update request
 	c.setReqUserAgent(req)
 	c.setReqGlobalHeader(req)
+	c.setReqOpaqueID(req)
 
 	if req.Body != nil && req.Body != http.NoBody {
 		if c.compressRequestBody {
 			var buf bytes.Buffer
 			zw := gzip.NewWriter(&buf)
 			if _, err := io.Copy(zw, req.Body); err != nil {
-				return nil, fmt.Errorf("failed to compress request body: %w", err)
+				return nil, wrapOpaqueIDError(req, fmt.Errorf("failed to compress request body: %w", err))
 			}
 			if err := zw.Close(); err != nil {
-				return nil, fmt.Errorf("failed to compress request body (during close): %w", err)
+				return nil, wrapOpaqueIDError(req, fmt.Errorf("failed to compress request body (during close): %w", err))
 			}
 
 			req.GetBody = func() (io.ReadCloser, error) {
@@ -282,7 +301,7 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 			if c.logger != nil {
 				c.logRoundTrip(req, nil, err, time.Time{}, time.Duration(0))
 			}
-			return nil, fmt.Errorf("cannot get connection: %w", err)
+			return nil, wrapOpaqueIDError(req, fmt.Errorf("cannot get connection: %w", err))
 		}
 
 		// Update request
@@ -290,13 +309,13 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 		c.setReqAuth(conn.URL, req)
 
 		if err = c.signRequest(req); err != nil {
-			return nil, fmt.Errorf("failed to sign request: %w", err)
+			return nil, wrapOpaqueIDError(req, fmt.Errorf("failed to sign request: %w", err))
 		}
 
 		if !c.disableRetry && i > 0 && req.Body != nil && req.Body != http.NoBody {
 			body, err := req.GetBody()
 			if err != nil {
-				return nil, fmt.Errorf("cannot get request body: %w", err)
+				return nil, wrapOpaqueIDError(req, fmt.Errorf("cannot get request body: %w", err))
 			}
 			req.Body = body
 		}
@@ -326,7 +345,11 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 			// Report the connection as unsuccessful
 			c.Lock()
 			//nolint:errcheck // Questionable if the function even returns an error
-			c.pool.OnFailure(conn)
+			if pool, ok := c.pool.(*statusConnectionPool); ok {
+				pool.onFailure(conn, err.Error())
+			} else {
+				c.pool.OnFailure(conn)
+			}
 			c.Unlock()
 
 			// Retry on EOF errors
@@ -393,11 +416,36 @@ func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 	}
 
 	// TODO(karmi): Wrap error
-	return res, err
+	return res, wrapOpaqueIDError(req, err)
+}
+
+// newConnectionPool creates the connection pool for conns and wires it to the client.
+func (c *Client) newConnectionPool(conns []*Connection) ConnectionPool {
+	var pool ConnectionPool
+	if c.poolFunc != nil {
+		pool = c.poolFunc(conns, c.selector)
+	} else {
+		pool = NewConnectionPool(conns, c.selector)
+	}
+
+	// TODO(karmi): Type assertion to interface
+	switch p := pool.(type) {
+	case *singleConnectionPool:
+		p.metrics = c.metrics
+	case *statusConnectionPool:
+		p.metrics = c.metrics
+		p.clock = c.clock
+		p.listener = c.listener
+	}
+
+	return pool
 }
 
 // URLs returns a list of transport URLs.
 func (c *Client) URLs() []*url.URL {
+	c.Lock()
+	defer c.Unlock()
+
 	return c.pool.URLs()
 }
 
@@ -430,6 +478,9 @@ func (c *Client) setReqAuth(u *url.URL, req *http.Request) {
 }
 
 func (c *Client) signRequest(req *http.Request) error {
+	if cs, ok := c.signer.(signer.ContextSigner); ok {
+		return cs.SignRequestWithContext(req.Context(), req)
+	}
 	if c.signer != nil {
 		return c.signer.SignRequest(req)
 	}
@@ -31,6 +31,7 @@ package opensearchtransport
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"fmt"
 	"io"
 	"math/rand"
@@ -72,6 +73,16 @@ func (m *mockSigner) SignRequest(req *http.Request) error {
 	return nil
 }
 
+type mockContextSigner struct {
+	mockSigner
+	ctx context.Context
+}
+
+func (m *mockContextSigner) SignRequestWithContext(ctx context.Context, req *http.Request) error {
+	m.ctx = ctx
+	return m.SignRequest(req)
+}
+
 func (e *mockNetError) Timeout() bool   { return false }
 func (e *mockNetError) Temporary() bool { return false }
 
@@ -420,6 +431,26 @@ func TestTransportPerform(t *testing.T) {
 		}
 	})
 
+	t.Run("Sign request with context", func(t *testing.T) {
+		type ctxKey struct{}
+
+		signer := &mockContextSigner{mockSigner: mockSigner{SampleKey: "sign-status", SampleValue: "success"}}
+		tp, _ := New(Config{URLs: []*url.URL{{Scheme: "https", Host: "example.com"}}, Signer: signer})
+
+		ctx := context.WithValue(context.Background(), ctxKey{}, "foo")
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+		if err := tp.signRequest(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if signer.ctx == nil || signer.ctx.Value(ctxKey{}) != "foo" {
+			t.Error("Expected the request context to be passed to the signer")
+		}
+		if _, ok := req.Header["Sign-Status"]; !ok {
+			t.Error("Signature is not added")
+		}
+	})
+
 	t.Run("Error No URL", func(t *testing.T) {
 		tp, _ := New(
 			Config{
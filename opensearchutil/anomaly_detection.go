@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+const defaultAnomalyDetectorPollInterval = time.Second
+
+// StartAnomalyDetector starts the real-time job of the detector, and waits for it to be running,
+// see WaitForAnomalyDetector.
+func StartAnomalyDetector(ctx context.Context, client *opensearch.Client, detectorID string, interval time.Duration) (*opensearchapi.AnomalyDetectorProfileResp, error) {
+	res, _, err := opensearchapi.AnomalyDetectionStartDetectorRequest{DetectorID: detectorID}.Do(ctx, client)
+	if res != nil {
+		defer res.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("anomaly detection: cannot start detector %s: %w", detectorID, err)
+	}
+	return WaitForAnomalyDetector(ctx, client, detectorID, interval)
+}
+
+// WaitForAnomalyDetector polls the profile of the detector every interval, 1s by default,
+// until its real-time job is RUNNING, and returns the last profile.
+//
+// It returns an error when the job is DISABLED with an error, eg. when the initialization failed,
+// or when the context is done.
+func WaitForAnomalyDetector(ctx context.Context, client *opensearch.Client, detectorID string, interval time.Duration) (*opensearchapi.AnomalyDetectorProfileResp, error) {
+	if interval <= 0 {
+		interval = defaultAnomalyDetectorPollInterval
+	}
+
+	for {
+		profile, err := anomalyDetectorProfile(ctx, client, detectorID)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case profile.State == opensearchapi.AnomalyDetectorStateRunning:
+			return profile, nil
+		case profile.State == opensearchapi.AnomalyDetectorStateDisabled && profile.Error != "":
+			return profile, fmt.Errorf("anomaly detection: detector %s is disabled: %s", detectorID, profile.Error)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return profile, fmt.Errorf("anomaly detection: detector %s is %s: %w", detectorID, profile.State, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+func anomalyDetectorProfile(ctx context.Context, client *opensearch.Client, detectorID string) (*opensearchapi.AnomalyDetectorProfileResp, error) {
+	req := opensearchapi.AnomalyDetectionProfileRequest{DetectorID: detectorID, Type: []string{"state", "error", "init_progress"}}
+	res, profile, err := req.Do(ctx, client)
+	if res != nil {
+		defer res.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("anomaly detection: cannot get profile of detector %s: %w", detectorID, err)
+	}
+	return profile, nil
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+)
+
+// detectorServer fakes the Anomaly Detection APIs; each profile request returns the next profile,
+// the last one repeatedly.
+type detectorServer struct {
+	profiles []string
+
+	started  int
+	profiled int
+}
+
+func (s *detectorServer) client(t *testing.T) *opensearch.Client {
+	client, err := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{RoundTripFunc: s.roundTrip}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return client
+}
+
+func (s *detectorServer) roundTrip(req *http.Request) (*http.Response, error) {
+	switch req.URL.Path {
+	case "/":
+		return response(200, infoBody), nil
+	case "/_plugins/_anomaly_detection/detectors/d1/_start":
+		s.started++
+		return response(200, `{"_id":"d1","_version":1,"_seq_no":0,"_primary_term":1}`), nil
+	case "/_plugins/_anomaly_detection/detectors/d1/_profile/state,error,init_progress":
+		i := s.profiled
+		if i >= len(s.profiles) {
+			i = len(s.profiles) - 1
+		}
+		s.profiled++
+		return response(200, s.profiles[i]), nil
+	}
+	return response(404, `{"error":"not found","status":404}`), nil
+}
+
+func TestStartAnomalyDetector(t *testing.T) {
+	t.Run("Running", func(t *testing.T) {
+		s := &detectorServer{profiles: []string{
+			`{"state":"INIT","init_progress":{"percentage":"10%","estimated_minutes_left":30,"needed_shingles":6}}`,
+			`{"state":"INIT","init_progress":{"percentage":"90%","estimated_minutes_left":1,"needed_shingles":1}}`,
+			`{"state":"RUNNING"}`,
+		}}
+
+		profile, err := StartAnomalyDetector(context.Background(), s.client(t), "d1", time.Millisecond)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if profile.State != "RUNNING" || s.started != 1 || s.profiled != 3 {
+			t.Errorf("Unexpected profile: %+v, started %d, profiled %d", profile, s.started, s.profiled)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		s := &detectorServer{profiles: []string{`{"state":"DISABLED","error":"No data in the past 7 days"}`}}
+
+		profile, err := WaitForAnomalyDetector(context.Background(), s.client(t), "d1", time.Millisecond)
+		if err == nil || !strings.Contains(err.Error(), "No data in the past 7 days") {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if profile == nil || profile.State != "DISABLED" || s.started != 0 {
+			t.Errorf("Unexpected profile: %+v", profile)
+		}
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		s := &detectorServer{profiles: []string{`{"state":"INIT"}`}}
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := WaitForAnomalyDetector(ctx, s.client(t), "d1", time.Millisecond)
+		if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "is INIT") {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		s := &detectorServer{}
+
+		_, err := StartAnomalyDetector(context.Background(), s.client(t), "d2", time.Millisecond)
+		if err == nil || !strings.Contains(err.Error(), "cannot start detector d2") {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+const (
+	defaultAsyncSearchMinBackoff = 100 * time.Millisecond
+	defaultAsyncSearchMaxBackoff = 5 * time.Second
+)
+
+// AsyncSearchConfig represents the configuration of AsyncSearch and WaitForAsyncSearch.
+type AsyncSearchConfig struct {
+	Client *opensearch.Client // The OpenSearch client.
+
+	// The search to submit. KeepOnCompletion is always set, so that the results can be fetched
+	// after the search completes between two polls. FilterPath isn't supported, as the state of the
+	// search is needed to poll it.
+	Request opensearchapi.AsyncSearchSubmitRequest
+
+	// The duration to wait before each poll. Defaults to an exponential backoff from 100ms to 5s.
+	Backoff func(attempt int) time.Duration
+
+	// Called with the partial results of each poll of a running search, when there are any.
+	OnPartial func(*opensearchapi.AsyncSearchResp)
+
+	// Keeps the stored search after it completes, eg. to fetch it again by ID. It is deleted by default.
+	Keep bool
+}
+
+// AsyncSearch submits the search of the configuration, and polls it until it completes.
+// It returns the final response of the search, with its results.
+//
+// The stored search is deleted when the context is done or on error, and after it completes
+// unless Keep is set.
+//
+//	res, err := opensearchutil.AsyncSearch(ctx, opensearchutil.AsyncSearchConfig{
+//		Client:    client,
+//		Request:   opensearchapi.AsyncSearchSubmitRequest{Index: []string{"logs-*"}, Body: strings.NewReader(`{"size":0,"aggs":{...}}`)},
+//		OnPartial: func(r *opensearchapi.AsyncSearchResp) { log.Printf("%d hits so far", r.Response.Hits.Total.Value) },
+//	})
+func AsyncSearch(ctx context.Context, cfg AsyncSearchConfig) (*opensearchapi.AsyncSearchResp, error) {
+	if len(cfg.Request.FilterPath) > 0 {
+		return nil, errors.New("async search: filter path is not supported")
+	}
+
+	req := cfg.Request
+	req.KeepOnCompletion = true
+	res, data, err := req.Do(ctx, cfg.Client)
+	if res != nil {
+		defer res.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("async search: %w", err)
+	}
+	if data == nil {
+		return nil, errors.New("async search: empty response")
+	}
+	return waitForAsyncSearch(ctx, cfg, data)
+}
+
+// WaitForAsyncSearch polls the submitted search with the ID until it completes, eg. to resume a search
+// submitted by another request; see AsyncSearch.
+func WaitForAsyncSearch(ctx context.Context, cfg AsyncSearchConfig, id string) (*opensearchapi.AsyncSearchResp, error) {
+	data, err := getAsyncSearch(ctx, cfg.Client, id)
+	if err != nil {
+		return nil, deleteAsyncSearch(cfg.Client, id, err)
+	}
+	return waitForAsyncSearch(ctx, cfg, data)
+}
+
+func waitForAsyncSearch(ctx context.Context, cfg AsyncSearchConfig, data *opensearchapi.AsyncSearchResp) (*opensearchapi.AsyncSearchResp, error) {
+	backoff := cfg.Backoff
+	if backoff == nil {
+		backoff = defaultAsyncSearchBackoff
+	}
+
+	for attempt := 1; data.IsRunning(); attempt++ {
+		if data.Response != nil && cfg.OnPartial != nil {
+			cfg.OnPartial(data)
+		}
+
+		timer := time.NewTimer(backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, deleteAsyncSearch(cfg.Client, data.ID, fmt.Errorf("async search: %w", ctx.Err()))
+		case <-timer.C:
+		}
+
+		next, err := getAsyncSearch(ctx, cfg.Client, data.ID)
+		if err != nil {
+			return nil, deleteAsyncSearch(cfg.Client, data.ID, err)
+		}
+		data = next
+	}
+
+	if len(data.Error) > 0 && string(data.Error) != "null" {
+		return data, deleteAsyncSearch(cfg.Client, data.ID, fmt.Errorf("async search: %s failed: %s", data.ID, data.Error))
+	}
+	if !cfg.Keep {
+		if err := deleteAsyncSearch(cfg.Client, data.ID, nil); err != nil {
+			return data, err
+		}
+	}
+	return data, nil
+}
+
+func getAsyncSearch(ctx context.Context, client *opensearch.Client, id string) (*opensearchapi.AsyncSearchResp, error) {
+	res, data, err := opensearchapi.AsyncSearchGetRequest{ID: id}.Do(ctx, client)
+	if res != nil {
+		defer res.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("async search: %w", err)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("async search: empty response for %s", id)
+	}
+	return data, nil
+}
+
+// deleteAsyncSearch deletes the stored search, and returns the error with the deletion error if any.
+func deleteAsyncSearch(client *opensearch.Client, id string, err error) error {
+	// The context of the search may be canceled, delete the search regardless.
+	res, _, deleteErr := opensearchapi.AsyncSearchDeleteRequest{ID: id}.Do(context.Background(), client)
+	if res != nil {
+		defer res.Body.Close()
+	}
+	if deleteErr != nil {
+		deleteErr = fmt.Errorf("cannot delete async search %s: %w", id, deleteErr)
+		if err == nil {
+			return fmt.Errorf("async search: %w", deleteErr)
+		}
+		return fmt.Errorf("%w (%s)", err, deleteErr)
+	}
+	return err
+}
+
+// defaultAsyncSearchBackoff doubles the backoff from 100ms on each attempt, up to 5s.
+func defaultAsyncSearchBackoff(attempt int) time.Duration {
+	d := defaultAsyncSearchMinBackoff
+	for i := 1; i < attempt && d < defaultAsyncSearchMaxBackoff; i++ {
+		d *= 2
+	}
+	if d > defaultAsyncSearchMaxBackoff {
+		d = defaultAsyncSearchMaxBackoff
+	}
+	return d
+}
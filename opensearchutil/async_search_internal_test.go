@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// asyncSearchServer fakes the Asynchronous Search APIs; the search is running with a growing number
+// of hits for the given number of polls, then it succeeds.
+type asyncSearchServer struct {
+	polls  int
+	failed bool
+
+	submits []string
+	gets    int
+	deleted []string
+}
+
+func (s *asyncSearchServer) client(t *testing.T) *opensearch.Client {
+	client, err := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{RoundTripFunc: s.roundTrip}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return client
+}
+
+func (s *asyncSearchServer) roundTrip(req *http.Request) (*http.Response, error) {
+	switch {
+	case req.URL.Path == "/":
+		return response(200, infoBody), nil
+	case req.Method == "POST" && req.URL.Path == "/_plugins/_asynchronous_search":
+		s.submits = append(s.submits, req.URL.RawQuery)
+		return response(200, s.state()), nil
+	case req.Method == "GET" && req.URL.Path == "/_plugins/_asynchronous_search/s1":
+		s.gets++
+		return response(200, s.state()), nil
+	case req.Method == "DELETE" && req.URL.Path == "/_plugins/_asynchronous_search/s1":
+		s.deleted = append(s.deleted, "s1")
+		return response(200, `{"acknowledged":true}`), nil
+	}
+	return response(404, `{"error":"not found","status":404}`), nil
+}
+
+func (s *asyncSearchServer) state() string {
+	hits := fmt.Sprintf(`{"took":1,"timed_out":false,"_shards":{"total":2,"successful":%d,"skipped":0,"failed":0},"hits":{"total":{"value":%d,"relation":"eq"},"hits":[]}}`, s.gets, 10*s.gets)
+	switch {
+	case s.gets < s.polls:
+		return `{"id":"s1","state":"RUNNING","start_time_in_millis":1,"expiration_time_in_millis":2,"response":` + hits + `}`
+	case s.failed:
+		return `{"id":"s1","state":"FAILED","start_time_in_millis":1,"expiration_time_in_millis":2,"error":{"type":"search_phase_execution_exception","reason":"all shards failed"}}`
+	}
+	return `{"id":"s1","state":"PERSIST_SUCCEEDED","start_time_in_millis":1,"expiration_time_in_millis":2,"response":` + hits + `}`
+}
+
+func TestAsyncSearch(t *testing.T) {
+	noBackoff := func(int) time.Duration { return time.Millisecond }
+
+	t.Run("Completed", func(t *testing.T) {
+		s := &asyncSearchServer{polls: 3}
+		var partial []int64
+
+		res, err := AsyncSearch(context.Background(), AsyncSearchConfig{
+			Client:    s.client(t),
+			Request:   opensearchapi.AsyncSearchSubmitRequest{Index: []string{"logs"}, Body: strings.NewReader(`{}`)},
+			Backoff:   noBackoff,
+			OnPartial: func(r *opensearchapi.AsyncSearchResp) { partial = append(partial, r.Response.Hits.Total.Value) },
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if res.State != "PERSIST_SUCCEEDED" || res.Response.Hits.Total.Value != 30 {
+			t.Errorf("Unexpected response: %+v", res)
+		}
+		if fmt.Sprint(partial) != "[0 10 20]" {
+			t.Errorf("Unexpected partial results: %v", partial)
+		}
+		if len(s.submits) != 1 || !strings.Contains(s.submits[0], "keep_on_completion=true") || !strings.Contains(s.submits[0], "index=logs") {
+			t.Errorf("Unexpected submit: %v", s.submits)
+		}
+		if len(s.deleted) != 1 {
+			t.Errorf("Expected the search to be deleted, got %v", s.deleted)
+		}
+	})
+
+	t.Run("Keep", func(t *testing.T) {
+		s := &asyncSearchServer{polls: 2}
+
+		res, err := WaitForAsyncSearch(context.Background(), AsyncSearchConfig{Client: s.client(t), Backoff: noBackoff, Keep: true}, "s1")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if res.IsRunning() || s.gets != 2 || len(s.deleted) != 0 {
+			t.Errorf("Unexpected response: %+v, gets %d, deleted %v", res, s.gets, s.deleted)
+		}
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		s := &asyncSearchServer{polls: 1, failed: true}
+
+		res, err := AsyncSearch(context.Background(), AsyncSearchConfig{Client: s.client(t), Backoff: noBackoff})
+		if err == nil || !strings.Contains(err.Error(), "all shards failed") {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if res == nil || res.State != "FAILED" || len(s.deleted) != 1 {
+			t.Errorf("Unexpected response: %+v, deleted %v", res, s.deleted)
+		}
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		s := &asyncSearchServer{polls: 1 << 30}
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := AsyncSearch(ctx, AsyncSearchConfig{Client: s.client(t), Backoff: noBackoff})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(s.deleted) != 1 {
+			t.Errorf("Expected the search to be deleted on cancel, got %v", s.deleted)
+		}
+	})
+
+	t.Run("Filter path", func(t *testing.T) {
+		s := &asyncSearchServer{}
+
+		_, err := AsyncSearch(context.Background(), AsyncSearchConfig{
+			Client:  s.client(t),
+			Request: opensearchapi.AsyncSearchSubmitRequest{FilterPath: []string{"id"}},
+		})
+		if err == nil || !strings.Contains(err.Error(), "filter path is not supported") {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(s.submits) != 0 {
+			t.Errorf("Expected no search to be submitted, got %v", s.submits)
+		}
+	})
+
+	t.Run("Backoff", func(t *testing.T) {
+		var got []time.Duration
+		for attempt := 1; attempt <= 8; attempt++ {
+			got = append(got, defaultAsyncSearchBackoff(attempt))
+		}
+		if fmt.Sprint(got) != "[100ms 200ms 400ms 800ms 1.6s 3.2s 5s 5s]" {
+			t.Errorf("Unexpected backoff: %v", got)
+		}
+	})
+}
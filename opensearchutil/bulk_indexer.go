@@ -52,6 +52,9 @@ type BulkIndexer interface {
 	//
 	// It is safe for concurrent use. When it's called from goroutines,
 	// they must finish before the call to Close, eg. using sync.WaitGroup.
+	//
+	// The context is only used to add the item: as a flush sends the items of many calls,
+	// an opaque ID set with opensearch.WithOpaqueID isn't sent; see BulkIndexerConfig.OpaqueID.
 	Add(context.Context, BulkIndexerItem) error
 
 	// Close waits until all added items are flushed and closes the indexer.
@@ -72,10 +75,18 @@ type BulkIndexerConfig struct {
 	Decoder     BulkResponseJSONDecoder // A custom JSON decoder.
 	DebugLogger BulkIndexerDebugLogger  // An optional logger for debugging.
 
+	// The X-Opaque-Id of the flushes with no opaque ID in their context, which is the context
+	// returned by OnFlushStart, or the context of Close for the final flush.
+	OpaqueID string
+
 	OnError      func(context.Context, error)          // Called for indexer errors.
 	OnFlushStart func(context.Context) context.Context // Called when the flush starts.
 	OnFlushEnd   func(context.Context)                 // Called when the flush ends.
 
+	// Do not send the document IDs of index and create actions, as the collection generates them.
+	// Defaults to true for clients in serverless mode with a time series collection, and to false otherwise.
+	OmitDocumentIDs *bool
+
 	// Parameters of the Bulk API.
 	Index               string
 	ErrorTrace          bool
@@ -245,6 +256,11 @@ func NewBulkIndexer(cfg BulkIndexerConfig) (BulkIndexer, error) {
 		cfg.FlushInterval = 30 * time.Second
 	}
 
+	if cfg.OmitDocumentIDs == nil && cfg.Client.IsServerless() && cfg.Client.CollectionType() == opensearch.CollectionTimeSeries {
+		omit := true
+		cfg.OmitDocumentIDs = &omit
+	}
+
 	bi := bulkIndexer{
 		config: cfg,
 		done:   make(chan bool),
@@ -450,6 +466,12 @@ func (w *worker) writeMeta(item BulkIndexerItem) error {
 		RequireAlias:        item.RequireAlias,
 		RetryOnConflict:     item.RetryOnConflict,
 	}
+	// Time series collections reject explicit document IDs
+	if w.bi != nil && w.bi.config.OmitDocumentIDs != nil && *w.bi.config.OmitDocumentIDs && (item.Action == "index" || item.Action == "create") {
+		meta.DocumentID = ""
+		meta.IfSeqNum = nil
+		meta.IfPrimaryTerm = nil
+	}
 	// Can not specify version or seq num if no document ID is passed
 	if meta.DocumentID == "" {
 		meta.Version = nil
@@ -503,6 +525,10 @@ func (w *worker) flush(ctx context.Context) error {
 		ctx = w.bi.config.OnFlushStart(ctx)
 	}
 
+	if _, ok := opensearch.OpaqueIDFromContext(ctx); !ok && w.bi.config.OpaqueID != "" {
+		ctx = opensearch.WithOpaqueID(ctx, w.bi.config.OpaqueID)
+	}
+
 	if w.bi.config.OnFlushEnd != nil {
 		defer func() { w.bi.config.OnFlushEnd(ctx) }()
 	}
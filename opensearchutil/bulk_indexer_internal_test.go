@@ -457,6 +457,107 @@ func TestBulkIndexer(t *testing.T) {
 		}
 	})
 
+	t.Run("Opaque ID", func(t *testing.T) {
+		var (
+			mu      sync.Mutex
+			headers []string
+		)
+
+		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				headers = append(headers, req.Header.Get("X-Opaque-Id"))
+				mu.Unlock()
+				return defaultRoundTripFunc(req)
+			},
+		}})
+
+		for _, tc := range []struct {
+			name     string
+			ctx      context.Context
+			expected string
+		}{
+			{name: "Config", ctx: context.Background(), expected: "indexer-1"},
+			{name: "Context", ctx: opensearch.WithOpaqueID(context.Background(), "flush-1"), expected: "flush-1"},
+		} {
+			headers = nil
+
+			bi, _ := NewBulkIndexer(BulkIndexerConfig{
+				Client:        client,
+				Index:         "foo",
+				NumWorkers:    1,
+				FlushInterval: time.Hour,
+				OpaqueID:      "indexer-1",
+			})
+
+			if err := bi.Add(context.Background(), BulkIndexerItem{Action: "index", Body: strings.NewReader(`{"title":"foo"}`)}); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if err := bi.Close(tc.ctx); err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			}
+
+			mu.Lock()
+			if len(headers) != 1 || headers[0] != tc.expected {
+				t.Errorf("%s: unexpected X-Opaque-Id headers: %q, want: %q", tc.name, headers, tc.expected)
+			}
+			mu.Unlock()
+		}
+	})
+
+	t.Run("Serverless time series", func(t *testing.T) {
+		keep := false
+		tests := []struct {
+			name     string
+			omit     *bool
+			expected string
+		}{
+			{"Default", nil, `{"index":{}}` + "\n" + `{}` + "\n" +
+				`{"create":{}}` + "\n" + `{}` + "\n" +
+				`{"delete":{"_id":"1"}}` + "\n"},
+			{"Keep document IDs", &keep, `{"index":{"_id":"1"}}` + "\n" + `{}` + "\n" +
+				`{"create":{"_id":"1"}}` + "\n" + `{}` + "\n" +
+				`{"delete":{"_id":"1"}}` + "\n"},
+		}
+
+		for _, tt := range tests {
+			var body string
+
+			client, _ := opensearch.NewClient(opensearch.Config{
+				Serverless:     true,
+				CollectionType: opensearch.CollectionTimeSeries,
+				Transport: &mockTransport{
+					RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+						b, _ := ioutil.ReadAll(req.Body)
+						body = string(b)
+						return defaultRoundTripFunc(req)
+					},
+				},
+			})
+
+			bi, _ := NewBulkIndexer(BulkIndexerConfig{
+				Client: client, Index: "logs", NumWorkers: 1, FlushInterval: time.Hour, OmitDocumentIDs: tt.omit,
+			})
+
+			for _, item := range []BulkIndexerItem{
+				{Action: "index", DocumentID: "1", Body: strings.NewReader(`{}`)},
+				{Action: "create", DocumentID: "1", Body: strings.NewReader(`{}`)},
+				{Action: "delete", DocumentID: "1"},
+			} {
+				if err := bi.Add(context.Background(), item); err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+			}
+			if err := bi.Close(context.Background()); err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			}
+
+			if body != tt.expected {
+				t.Errorf("%s: unexpected body: %s, want: %s", tt.name, body, tt.expected)
+			}
+		}
+	})
+
 	t.Run("Automatic flush", func(t *testing.T) {
 		client, _ := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{
 			RoundTripFunc: func(request *http.Request) (*http.Response, error) {
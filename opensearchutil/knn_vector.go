@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// KNNVector is the value of a knn_vector field, encoded in JSON with the shortest representation
+// of each float32 value, eg. [0.1,-2.5e-7]. The knn_vector fields store float32 values, so that
+// the documents of the BulkIndexer are not inflated with the float64 digits of []float64 vectors.
+//
+//	type Document struct {
+//		Title     string                  `json:"title"`
+//		Embedding opensearchutil.KNNVector `json:"embedding"`
+//	}
+//
+//	indexer.Add(ctx, opensearchutil.BulkIndexerItem{
+//		Action: "index",
+//		Body:   opensearchutil.NewJSONReader(Document{Title: title, Embedding: opensearchutil.NewKNNVector(embedding)}),
+//	})
+type KNNVector []float32
+
+// NewKNNVector returns the vector with the values rounded to float32.
+func NewKNNVector(v []float64) KNNVector {
+	vector := make(KNNVector, len(v))
+	for i, f := range v {
+		vector[i] = float32(f)
+	}
+	return vector
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v KNNVector) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	b := make([]byte, 0, 2+len(v)*12)
+	b = append(b, '[')
+	for i, f := range v {
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			return nil, fmt.Errorf("knn vector: unsupported value %v at position %d", f, i)
+		}
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = strconv.AppendFloat(b, float64(f), 'g', -1, 32)
+	}
+	return append(b, ']'), nil
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestKNNVector(t *testing.T) {
+	t.Run("Encode", func(t *testing.T) {
+		doc := struct {
+			Embedding KNNVector `json:"embedding"`
+			Missing   KNNVector `json:"missing"`
+		}{Embedding: NewKNNVector([]float64{0.1, -2.5e-7, 3, 1.0 / 3})}
+
+		b, err := ioutil.ReadAll(NewJSONReader(doc))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if expected := `{"embedding":[0.1,-2.5e-07,3,0.33333334],"missing":null}` + "\n"; string(b) != expected {
+			t.Errorf("Unexpected JSON: %s, want: %s", b, expected)
+		}
+
+		var decoded struct {
+			Embedding []float32 `json:"embedding"`
+		}
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(KNNVector(decoded.Embedding), doc.Embedding) {
+			t.Errorf("Unexpected vector: %v", decoded.Embedding)
+		}
+	})
+
+	t.Run("Invalid value", func(t *testing.T) {
+		_, err := json.Marshal(KNNVector{1, float32(math.NaN())})
+		if err == nil || !strings.Contains(err.Error(), "unsupported value NaN at position 1") {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// PointInTimeConfig represents the configuration of the PointInTimeIterator.
+type PointInTimeConfig struct {
+	Client *opensearch.Client // The OpenSearch client.
+
+	// The search request. The Index, Preference, Routing and ExpandWildcards are used to create
+	// the point in time; the pit and search_after of the Body are set for each page.
+	Request opensearchapi.SearchRequest
+
+	KeepAlive time.Duration // How long the point in time is kept alive between pages. Defaults to 1min.
+
+	// A field with unique values, appended to the sort of the Body unless it's already there,
+	// so that no hits are skipped between pages. Required, as the field depends on the mapping of the indices.
+	TieBreaker string
+}
+
+// PointInTimeIterator pages through the results of a search with a point in time and search_after.
+//
+// The point in time is deleted when the results are exhausted or on error.
+// Call Close when the iteration stops early.
+//
+//	it := opensearchutil.NewPointInTimeIterator(opensearchutil.PointInTimeConfig{
+//		Client:     client,
+//		Request:    opensearchapi.SearchRequest{Index: []string{"movies-*"}, Body: strings.NewReader(`{"size":1000,"sort":["year"]}`)},
+//		TieBreaker: "movie_id",
+//	})
+//	defer it.Close(context.Background())
+//
+//	for it.Next(ctx) {
+//		for _, hit := range it.Page().Hits.Hits {
+//			// ...
+//		}
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type PointInTimeIterator struct {
+	client     *opensearch.Client
+	req        opensearchapi.SearchRequest
+	keepAlive  time.Duration
+	tieBreaker string
+
+	body        map[string]json.RawMessage
+	pitID       string
+	searchAfter []json.RawMessage
+	page        *opensearchapi.SearchResponse
+	err         error
+	started     bool
+	done        bool
+}
+
+// NewPointInTimeIterator returns an iterator for the search request of the configuration.
+// The point in time is created with the first call to Next.
+func NewPointInTimeIterator(cfg PointInTimeConfig) *PointInTimeIterator {
+	it := &PointInTimeIterator{
+		client:     cfg.Client,
+		req:        cfg.Request,
+		keepAlive:  cfg.KeepAlive,
+		tieBreaker: cfg.TieBreaker,
+	}
+	if it.keepAlive <= 0 {
+		it.keepAlive = defaultKeepAlive
+	}
+	return it
+}
+
+// Next fetches the next page of results. It returns false when the results are exhausted or on error;
+// call Err to tell them apart.
+func (it *PointInTimeIterator) Next(ctx context.Context) bool {
+	if it.done {
+		return false
+	}
+	it.page = nil
+
+	if err := ctx.Err(); err != nil {
+		return it.fail(err)
+	}
+
+	if !it.started {
+		it.started = true
+		if err := it.start(ctx); err != nil {
+			return it.fail(err)
+		}
+	}
+
+	it.body["pit"], _ = json.Marshal(map[string]string{"id": it.pitID, "keep_alive": formatKeepAlive(it.keepAlive)})
+	if it.searchAfter != nil {
+		it.body["search_after"], _ = json.Marshal(it.searchAfter)
+	}
+	body, err := json.Marshal(it.body)
+	if err != nil {
+		return it.fail(fmt.Errorf("pit: cannot encode body: %w", err))
+	}
+
+	req := it.req
+	req.Body = bytes.NewReader(body)
+	res, err := req.Do(ctx, it.client)
+	if err != nil {
+		return it.fail(fmt.Errorf("pit: %w", err))
+	}
+
+	page, err := opensearchapi.DecodeSearchResponse(res)
+	if err != nil {
+		return it.fail(fmt.Errorf("pit: %w", err))
+	}
+	// The ID of the point in time may change between pages, the latest must be used.
+	if page.PitID != "" {
+		it.pitID = page.PitID
+	}
+
+	hits := page.Hits.Hits
+	if len(hits) == 0 {
+		it.done = true
+		it.err = it.delete(context.Background())
+		return false
+	}
+	if len(hits[len(hits)-1].Sort) == 0 {
+		return it.fail(errors.New("pit: hits have no sort values"))
+	}
+	it.searchAfter = hits[len(hits)-1].Sort
+
+	it.page = page
+	return true
+}
+
+// Page returns the current page of results.
+func (it *PointInTimeIterator) Page() *opensearchapi.SearchResponse {
+	return it.page
+}
+
+// PitID returns the current ID of the point in time, or an empty string once it is deleted.
+func (it *PointInTimeIterator) PitID() string {
+	return it.pitID
+}
+
+// Err returns the error which stopped the iteration, or nil when the results are exhausted.
+func (it *PointInTimeIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration and deletes the point in time if it still exists.
+// It is safe to call Close more than once.
+func (it *PointInTimeIterator) Close(ctx context.Context) error {
+	it.done = true
+	it.page = nil
+	return it.delete(ctx)
+}
+
+// start prepares the search body and creates the point in time.
+func (it *PointInTimeIterator) start(ctx context.Context) error {
+	if it.tieBreaker == "" {
+		return errors.New("pit: a tie-breaker is required")
+	}
+
+	it.body = make(map[string]json.RawMessage)
+	if it.req.Body != nil {
+		b, err := io.ReadAll(it.req.Body)
+		if err != nil {
+			return fmt.Errorf("pit: cannot read body: %w", err)
+		}
+		if len(bytes.TrimSpace(b)) > 0 {
+			if err := json.Unmarshal(b, &it.body); err != nil {
+				return fmt.Errorf("pit: cannot decode body: %w", err)
+			}
+		}
+	}
+
+	sort, err := appendTieBreaker(it.body["sort"], it.tieBreaker)
+	if err != nil {
+		return err
+	}
+	it.body["sort"] = sort
+
+	res, data, err := opensearchapi.PointInTimeCreateRequest{
+		Index:           it.req.Index,
+		KeepAlive:       it.keepAlive,
+		Preference:      it.req.Preference,
+		Routing:         strings.Join(it.req.Routing, ","),
+		ExpandWildcards: it.req.ExpandWildcards,
+	}.Do(ctx, it.client)
+	if res != nil {
+		defer res.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("pit: cannot create point in time: %w", err)
+	}
+	if data == nil || data.PitID == "" {
+		return errors.New("pit: cannot create point in time: empty ID")
+	}
+	it.pitID = data.PitID
+
+	// The search with a point in time cannot target indices.
+	it.req.Index = nil
+	it.req.Preference = ""
+	it.req.Routing = nil
+	it.req.ExpandWildcards = ""
+	return nil
+}
+
+func (it *PointInTimeIterator) fail(err error) bool {
+	it.done = true
+	it.err = err
+	// The context of the iteration may be canceled, delete the point in time regardless.
+	if deleteErr := it.delete(context.Background()); deleteErr != nil {
+		it.err = fmt.Errorf("%w (%s)", err, deleteErr)
+	}
+	return false
+}
+
+func (it *PointInTimeIterator) delete(ctx context.Context) error {
+	if it.pitID == "" {
+		return nil
+	}
+	pitID := it.pitID
+	it.pitID = ""
+
+	res, _, err := opensearchapi.PointInTimeDeleteRequest{PitID: []string{pitID}}.Do(ctx, it.client)
+	if res != nil {
+		defer res.Body.Close()
+		// The point in time has already expired.
+		if res.StatusCode == 404 {
+			return nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("cannot delete point in time: %w", err)
+	}
+	return nil
+}
+
+// appendTieBreaker returns the sort with the tie-breaker field appended, unless it's already sorted on.
+func appendTieBreaker(raw json.RawMessage, field string) (json.RawMessage, error) {
+	var sorts []json.RawMessage
+	raw = bytes.TrimSpace(raw)
+	switch {
+	case len(raw) == 0:
+	case raw[0] == '[':
+		if err := json.Unmarshal(raw, &sorts); err != nil {
+			return nil, fmt.Errorf("pit: cannot decode sort: %w", err)
+		}
+	default:
+		sorts = []json.RawMessage{raw}
+	}
+
+	for _, s := range sorts {
+		var name string
+		if json.Unmarshal(s, &name) == nil && name == field {
+			return json.Marshal(sorts)
+		}
+		var clause map[string]json.RawMessage
+		if json.Unmarshal(s, &clause) == nil {
+			if _, ok := clause[field]; ok {
+				return json.Marshal(sorts)
+			}
+		}
+	}
+
+	tieBreaker, _ := json.Marshal(map[string]string{field: "asc"})
+	return json.Marshal(append(sorts, tieBreaker))
+}
+
+// formatKeepAlive formats the duration like the parameters of the API.
+func formatKeepAlive(d time.Duration) string {
+	if d < time.Millisecond {
+		return fmt.Sprintf("%dnanos", int64(d))
+	}
+	return fmt.Sprintf("%dms", d.Milliseconds())
+}
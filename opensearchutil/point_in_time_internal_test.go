@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// pitServer fakes the Point In Time and Search APIs; each search returns a page with a single hit,
+// sorted by a long value, and a new ID of the point in time.
+type pitServer struct {
+	pages      int
+	failSearch bool
+
+	created  []string
+	searches []string
+	deleted  []string
+}
+
+func (s *pitServer) client(t *testing.T) *opensearch.Client {
+	client, err := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{RoundTripFunc: s.roundTrip}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return client
+}
+
+func (s *pitServer) roundTrip(req *http.Request) (*http.Response, error) {
+	var raw []byte
+	if req.Body != nil {
+		raw, _ = io.ReadAll(req.Body)
+	}
+
+	switch {
+	case req.URL.Path == "/":
+		return response(200, infoBody), nil
+	case req.Method == http.MethodDelete:
+		var body struct {
+			PitID []string `json:"pit_id"`
+		}
+		_ = json.Unmarshal(raw, &body)
+		s.deleted = append(s.deleted, body.PitID...)
+		return response(200, `{"pits":[{"pit_id":"`+body.PitID[0]+`","successful":true}]}`), nil
+	case strings.HasSuffix(req.URL.Path, "/_search/point_in_time"):
+		s.created = append(s.created, req.URL.Path+"?"+req.URL.RawQuery)
+		return response(200, `{"pit_id":"pit-0","_shards":{"total":1,"successful":1},"creation_time":1}`), nil
+	}
+
+	s.searches = append(s.searches, req.URL.Path+" "+string(raw))
+	if s.failSearch {
+		return response(400, `{"error":{"type":"illegal_argument_exception","reason":"invalid sort"},"status":400}`), nil
+	}
+
+	page := len(s.searches)
+	hits := `[]`
+	if page <= s.pages {
+		hits = fmt.Sprintf(`[{"_index":"movies","_id":"%d","_score":null,"sort":[900719925474099%d,"%d"]}]`, page, page, page)
+	}
+	return response(200, fmt.Sprintf(`{"pit_id":"pit-%d","took":1,"hits":{"hits":%s}}`, page, hits)), nil
+}
+
+func TestPointInTimeIterator(t *testing.T) {
+	t.Run("Pages", func(t *testing.T) {
+		s := &pitServer{pages: 2}
+		it := NewPointInTimeIterator(PointInTimeConfig{
+			Client: s.client(t),
+			Request: opensearchapi.SearchRequest{
+				Index:   []string{"movies-*"},
+				Routing: []string{"a", "b"},
+				Body:    strings.NewReader(`{"size":1,"sort":[{"year":"desc"}]}`),
+			},
+			TieBreaker: "_id",
+		})
+
+		var ids []string
+		for it.Next(context.Background()) {
+			ids = append(ids, it.Page().Hits.Hits[0].ID)
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if strings.Join(ids, ",") != "1,2" {
+			t.Errorf("Unexpected hits: %v", ids)
+		}
+		if len(s.created) != 1 || s.created[0] != "/movies-*/_search/point_in_time?keep_alive=60000ms&routing=a%2Cb" {
+			t.Errorf("Unexpected create requests: %v", s.created)
+		}
+
+		expected := []string{
+			`/_search {"pit":{"id":"pit-0","keep_alive":"60000ms"},"size":1,"sort":[{"year":"desc"},{"_id":"asc"}]}`,
+			`/_search {"pit":{"id":"pit-1","keep_alive":"60000ms"},"search_after":[9007199254740991,"1"],"size":1,"sort":[{"year":"desc"},{"_id":"asc"}]}`,
+			`/_search {"pit":{"id":"pit-2","keep_alive":"60000ms"},"search_after":[9007199254740992,"2"],"size":1,"sort":[{"year":"desc"},{"_id":"asc"}]}`,
+		}
+		if strings.Join(s.searches, "\n") != strings.Join(expected, "\n") {
+			t.Errorf("Unexpected searches:\n%s\nwant:\n%s", strings.Join(s.searches, "\n"), strings.Join(expected, "\n"))
+		}
+		if strings.Join(s.deleted, ",") != "pit-3" {
+			t.Errorf("Unexpected deleted: %v", s.deleted)
+		}
+
+		if err := it.Close(context.Background()); err != nil || len(s.deleted) != 1 {
+			t.Errorf("Expected the point in time to be deleted once, got: %v, %v", s.deleted, err)
+		}
+	})
+
+	t.Run("Tie-breaker", func(t *testing.T) {
+		tests := []struct {
+			sort     string
+			expected string
+		}{
+			{``, `[{"_id":"asc"}]`},
+			{`"year"`, `["year",{"_id":"asc"}]`},
+			{`["year","_id"]`, `["year","_id"]`},
+			{`[{"_id":{"order":"desc"}}]`, `[{"_id":{"order":"desc"}}]`},
+		}
+		for _, tt := range tests {
+			sort, err := appendTieBreaker(json.RawMessage(tt.sort), "_id")
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if string(sort) != tt.expected {
+				t.Errorf("Unexpected sort for %s: %s, want: %s", tt.sort, sort, tt.expected)
+			}
+		}
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		s := &pitServer{pages: 5}
+		it := NewPointInTimeIterator(PointInTimeConfig{Client: s.client(t), Request: opensearchapi.SearchRequest{Index: []string{"movies"}}, TieBreaker: "uuid"})
+
+		if !it.Next(context.Background()) {
+			t.Fatalf("Unexpected error: %v", it.Err())
+		}
+		if it.PitID() != "pit-1" {
+			t.Errorf("Unexpected ID: %s", it.PitID())
+		}
+		if err := it.Close(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if it.Next(context.Background()) || it.Page() != nil || it.PitID() != "" {
+			t.Errorf("Expected the iteration to be done")
+		}
+		if strings.Join(s.deleted, ",") != "pit-1" || !strings.Contains(s.searches[0], `"sort":[{"uuid":"asc"}]`) {
+			t.Errorf("Unexpected requests: %v, %v", s.deleted, s.searches)
+		}
+	})
+
+	t.Run("Search error", func(t *testing.T) {
+		s := &pitServer{pages: 5, failSearch: true}
+		it := NewPointInTimeIterator(PointInTimeConfig{Client: s.client(t), Request: opensearchapi.SearchRequest{Index: []string{"movies"}}, TieBreaker: "uuid"})
+
+		if it.Next(context.Background()) {
+			t.Fatalf("Expected the search to fail")
+		}
+		if err := it.Err(); !opensearchapi.HasErrorType(err, "illegal_argument_exception") {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if strings.Join(s.deleted, ",") != "pit-0" {
+			t.Errorf("Expected the point in time to be deleted, got: %v", s.deleted)
+		}
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		s := &pitServer{pages: 5}
+		it := NewPointInTimeIterator(PointInTimeConfig{Client: s.client(t), Request: opensearchapi.SearchRequest{Index: []string{"movies"}}, TieBreaker: "uuid"})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if it.Next(ctx) || !errors.Is(it.Err(), context.Canceled) {
+			t.Errorf("Unexpected error: %v", it.Err())
+		}
+		if len(s.created) != 0 || len(s.deleted) != 0 {
+			t.Errorf("Unexpected requests: %v, %v", s.created, s.deleted)
+		}
+	})
+
+	t.Run("No tie-breaker", func(t *testing.T) {
+		s := &pitServer{pages: 5}
+		it := NewPointInTimeIterator(PointInTimeConfig{Client: s.client(t), Request: opensearchapi.SearchRequest{Index: []string{"movies"}}})
+
+		if it.Next(context.Background()) || it.Err() == nil || !strings.Contains(it.Err().Error(), "tie-breaker is required") {
+			t.Errorf("Unexpected error: %v", it.Err())
+		}
+		if len(s.created) != 0 {
+			t.Errorf("Unexpected requests: %v", s.created)
+		}
+	})
+
+	t.Run("Invalid body", func(t *testing.T) {
+		s := &pitServer{pages: 5}
+		it := NewPointInTimeIterator(PointInTimeConfig{Client: s.client(t), Request: opensearchapi.SearchRequest{Body: strings.NewReader(`[]`)}, TieBreaker: "uuid"})
+
+		if it.Next(context.Background()) || it.Err() == nil || !strings.Contains(it.Err().Error(), "cannot decode body") {
+			t.Errorf("Unexpected error: %v", it.Err())
+		}
+		if len(s.created) != 0 {
+			t.Errorf("Unexpected requests: %v", s.created)
+		}
+	})
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// ReplicationConfig represents the configuration of StartReplication.
+type ReplicationConfig struct {
+	Client *opensearch.Client // The client of the follower cluster.
+
+	LeaderAlias string   // The name of the leader cluster in the remote cluster settings.
+	LeaderSeeds []string // The transport addresses of seed nodes of the leader cluster, eg. "leader-1:9300".
+	LeaderIndex string   // The index to replicate.
+
+	FollowerIndex string // The index of the follower cluster. Defaults to the leader index.
+
+	UseRoles *opensearchapi.ReplicationRoles // The roles used to replicate, when the security plugin is enabled.
+}
+
+// StartReplication sets the seed nodes of the leader cluster in the persistent remote cluster settings
+// of the follower cluster, then starts replicating the leader index to the follower index.
+//
+// The remote cluster settings are kept when the replication cannot be started.
+func StartReplication(ctx context.Context, cfg ReplicationConfig) (*opensearchapi.ReplicationAcknowledgedResp, error) {
+	switch {
+	case cfg.Client == nil:
+		return nil, errors.New("replication: client is required")
+	case cfg.LeaderAlias == "":
+		return nil, errors.New("replication: leader alias is required")
+	case len(cfg.LeaderSeeds) == 0:
+		return nil, errors.New("replication: leader seeds are required")
+	case cfg.LeaderIndex == "":
+		return nil, errors.New("replication: leader index is required")
+	}
+	followerIndex := cfg.FollowerIndex
+	if followerIndex == "" {
+		followerIndex = cfg.LeaderIndex
+	}
+
+	if err := putRemoteSeeds(ctx, cfg.Client, cfg.LeaderAlias, cfg.LeaderSeeds); err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(opensearchapi.ReplicationStartBody{
+		LeaderAlias: cfg.LeaderAlias,
+		LeaderIndex: cfg.LeaderIndex,
+		UseRoles:    cfg.UseRoles,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("replication: cannot encode body: %w", err)
+	}
+	res, data, err := opensearchapi.ReplicationStartRequest{Index: followerIndex, Body: bytes.NewReader(body)}.Do(ctx, cfg.Client)
+	if res != nil {
+		defer res.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("replication: cannot start replication of %s: %w", followerIndex, err)
+	}
+	return data, nil
+}
+
+// putRemoteSeeds sets the seed nodes of the remote cluster with the Cluster Put Settings API.
+func putRemoteSeeds(ctx context.Context, client *opensearch.Client, alias string, seeds []string) error {
+	settings := map[string]interface{}{
+		"persistent": map[string]interface{}{
+			"cluster": map[string]interface{}{
+				"remote": map[string]interface{}{
+					alias: map[string]interface{}{"seeds": seeds},
+				},
+			},
+		},
+	}
+	body, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("replication: cannot encode remote cluster settings: %w", err)
+	}
+
+	res, err := opensearchapi.ClusterPutSettingsRequest{Body: bytes.NewReader(body)}.Do(ctx, client)
+	if err != nil {
+		return fmt.Errorf("replication: cannot set remote cluster %s: %w", alias, err)
+	}
+	defer res.Body.Close()
+
+	if err := res.Err(); err != nil {
+		return fmt.Errorf("replication: cannot set remote cluster %s: %w", alias, err)
+	}
+	return nil
+}
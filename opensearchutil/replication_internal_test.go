@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// replicationServer fakes the Cluster Put Settings and Replication Start APIs, and records the requests.
+type replicationServer struct {
+	failSettings bool
+	failStart    bool
+
+	requests []string
+}
+
+func (s *replicationServer) client(t *testing.T) *opensearch.Client {
+	client, err := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{RoundTripFunc: s.roundTrip}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return client
+}
+
+func (s *replicationServer) roundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Path == "/" {
+		return response(200, infoBody), nil
+	}
+	var raw []byte
+	if req.Body != nil {
+		raw, _ = io.ReadAll(req.Body)
+	}
+	s.requests = append(s.requests, req.Method+" "+req.URL.Path+" "+string(raw))
+
+	switch {
+	case req.URL.Path == "/_cluster/settings" && s.failSettings:
+		return response(400, `{"error":{"type":"illegal_argument_exception","reason":"invalid seed"},"status":400}`), nil
+	case req.URL.Path == "/_cluster/settings":
+		return response(200, `{"acknowledged":true,"persistent":{},"transient":{}}`), nil
+	case s.failStart:
+		return response(400, `{"error":{"type":"resource_already_exists_exception","reason":"index [logs] already exists"},"status":400}`), nil
+	}
+	return response(200, `{"acknowledged":true}`), nil
+}
+
+func TestStartReplication(t *testing.T) {
+	t.Run("Start", func(t *testing.T) {
+		s := &replicationServer{}
+
+		res, err := StartReplication(context.Background(), ReplicationConfig{
+			Client:      s.client(t),
+			LeaderAlias: "leader",
+			LeaderSeeds: []string{"leader-1:9300", "leader-2:9300"},
+			LeaderIndex: "logs",
+			UseRoles:    &opensearchapi.ReplicationRoles{LeaderClusterRole: "all_access", FollowerClusterRole: "all_access"},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expected := []string{
+			`PUT /_cluster/settings {"persistent":{"cluster":{"remote":{"leader":{"seeds":["leader-1:9300","leader-2:9300"]}}}}}`,
+			`PUT /_plugins/_replication/logs/_start {"leader_alias":"leader","leader_index":"logs",` +
+				`"use_roles":{"leader_cluster_role":"all_access","follower_cluster_role":"all_access"}}`,
+		}
+		if !res.Acknowledged || strings.Join(s.requests, "\n") != strings.Join(expected, "\n") {
+			t.Errorf("Unexpected requests:\n%s\nwant:\n%s", strings.Join(s.requests, "\n"), strings.Join(expected, "\n"))
+		}
+	})
+
+	t.Run("Follower index", func(t *testing.T) {
+		s := &replicationServer{}
+
+		_, err := StartReplication(context.Background(), ReplicationConfig{
+			Client: s.client(t), LeaderAlias: "leader", LeaderSeeds: []string{"leader-1:9300"}, LeaderIndex: "logs", FollowerIndex: "logs-copy",
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(s.requests) != 2 || !strings.HasPrefix(s.requests[1], "PUT /_plugins/_replication/logs-copy/_start ") {
+			t.Errorf("Unexpected requests: %v", s.requests)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			server *replicationServer
+			cfg    ReplicationConfig
+			err    string
+		}{
+			{"Missing seeds", &replicationServer{}, ReplicationConfig{LeaderAlias: "leader", LeaderIndex: "logs"}, "leader seeds are required"},
+			{"Settings", &replicationServer{failSettings: true}, ReplicationConfig{LeaderAlias: "leader", LeaderSeeds: []string{"x"}, LeaderIndex: "logs"}, "invalid seed"},
+			{"Start", &replicationServer{failStart: true}, ReplicationConfig{LeaderAlias: "leader", LeaderSeeds: []string{"x"}, LeaderIndex: "logs"}, "already exists"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				tt.cfg.Client = tt.server.client(t)
+				_, err := StartReplication(context.Background(), tt.cfg)
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Errorf("Unexpected error: %v, want: %s", err, tt.err)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+const defaultKeepAlive = time.Minute
+
+// ScrollIterator pages through the results of a search with the Scroll API.
+//
+// The scroll context is cleared when the results are exhausted or on error.
+// Call Close when the iteration stops early.
+//
+//	it := opensearchutil.NewScrollIterator(client, opensearchapi.SearchRequest{Index: []string{"movies"}}, time.Minute)
+//	defer it.Close(context.Background())
+//
+//	for it.Next(ctx) {
+//		for _, hit := range it.Page().Hits.Hits {
+//			// ...
+//		}
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type ScrollIterator struct {
+	client    *opensearch.Client
+	req       opensearchapi.SearchRequest
+	keepAlive time.Duration
+
+	scrollID string
+	page     *opensearchapi.SearchResponse
+	err      error
+	started  bool
+	done     bool
+}
+
+// NewScrollIterator returns an iterator for the search request, which keeps the scroll context
+// alive for the duration between the pages. The duration defaults to one minute.
+func NewScrollIterator(client *opensearch.Client, req opensearchapi.SearchRequest, keepAlive time.Duration) *ScrollIterator {
+	if keepAlive <= 0 {
+		keepAlive = defaultKeepAlive
+	}
+	req.Scroll = keepAlive
+	return &ScrollIterator{client: client, req: req, keepAlive: keepAlive}
+}
+
+// Next fetches the next page of results. It returns false when the results are exhausted or on error;
+// call Err to tell them apart.
+func (it *ScrollIterator) Next(ctx context.Context) bool {
+	if it.done {
+		return false
+	}
+	it.page = nil
+
+	if err := ctx.Err(); err != nil {
+		return it.fail(err)
+	}
+
+	var (
+		res *opensearchapi.Response
+		err error
+	)
+	if !it.started {
+		it.started = true
+		res, err = it.req.Do(ctx, it.client)
+	} else {
+		res, err = opensearchapi.ScrollRequest{
+			Body:   scrollIDBody(it.scrollID),
+			Scroll: it.keepAlive,
+		}.Do(ctx, it.client)
+	}
+	if err != nil {
+		return it.fail(fmt.Errorf("scroll: %w", err))
+	}
+
+	page, err := opensearchapi.DecodeSearchResponse(res)
+	if err != nil {
+		return it.fail(fmt.Errorf("scroll: %w", err))
+	}
+	if page.ScrollID != "" {
+		it.scrollID = page.ScrollID
+	}
+
+	if len(page.Hits.Hits) == 0 {
+		it.done = true
+		it.err = it.clear(context.Background())
+		return false
+	}
+
+	it.page = page
+	return true
+}
+
+// Page returns the current page of results.
+func (it *ScrollIterator) Page() *opensearchapi.SearchResponse {
+	return it.page
+}
+
+// Err returns the error which stopped the iteration, or nil when the results are exhausted.
+func (it *ScrollIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration and clears the scroll context if it is still open.
+// It is safe to call Close more than once.
+func (it *ScrollIterator) Close(ctx context.Context) error {
+	it.done = true
+	it.page = nil
+	return it.clear(ctx)
+}
+
+func (it *ScrollIterator) fail(err error) bool {
+	it.done = true
+	it.err = err
+	// The context of the iteration may be canceled, clear the scroll regardless.
+	if clearErr := it.clear(context.Background()); clearErr != nil {
+		it.err = fmt.Errorf("%w (%s)", err, clearErr)
+	}
+	return false
+}
+
+func (it *ScrollIterator) clear(ctx context.Context) error {
+	if it.scrollID == "" {
+		return nil
+	}
+	scrollID := it.scrollID
+	it.scrollID = ""
+
+	res, err := opensearchapi.ClearScrollRequest{Body: scrollIDBody(scrollID)}.Do(ctx, it.client)
+	if err != nil {
+		return fmt.Errorf("cannot clear scroll: %w", err)
+	}
+	defer res.Body.Close()
+
+	// The scroll context has already expired.
+	if res.StatusCode == 404 {
+		return nil
+	}
+	if err := res.Err(); err != nil {
+		return fmt.Errorf("cannot clear scroll: %w", err)
+	}
+	return nil
+}
+
+// ScrollConfig represents the configuration of Scroll.
+type ScrollConfig struct {
+	Client *opensearch.Client // The OpenSearch client.
+
+	// The search request; the Body may not contain a slice when Slices is greater than one.
+	Request opensearchapi.SearchRequest
+
+	KeepAlive time.Duration // How long the scroll contexts are kept alive between pages. Defaults to 1min.
+
+	// The number of slices scrolled in parallel, each by its own worker.
+	// Defaults to 1, ie. a single scroll without slicing.
+	Slices int
+}
+
+// Scroll pages through the results of the search request and calls fn for each page,
+// until the results are exhausted, fn returns an error or the context is canceled.
+//
+// With more than one slice, fn is called concurrently from the workers and must be safe for concurrent use.
+// The first error stops all workers. The scroll contexts are always cleared.
+func Scroll(ctx context.Context, cfg ScrollConfig, fn func(context.Context, *opensearchapi.SearchResponse) error) error {
+	if cfg.Client == nil {
+		return errors.New("scroll: client is required")
+	}
+	if cfg.Slices <= 1 {
+		return scrollPages(ctx, NewScrollIterator(cfg.Client, cfg.Request, cfg.KeepAlive), fn)
+	}
+
+	bodies, err := sliceBodies(cfg.Request.Body, cfg.Slices)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for i, body := range bodies {
+		req := cfg.Request
+		req.Body = body
+
+		wg.Add(1)
+		go func(i int, it *ScrollIterator) {
+			defer wg.Done()
+			if err := scrollPages(ctx, it, fn); err != nil {
+				errOnce.Do(func() {
+					firstErr = fmt.Errorf("slice %d: %w", i, err)
+					cancel()
+				})
+			}
+		}(i, NewScrollIterator(cfg.Client, req, cfg.KeepAlive))
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// ScrollHits pages through the results of the search request like Scroll, and calls fn for each hit.
+func ScrollHits(ctx context.Context, cfg ScrollConfig, fn func(context.Context, opensearchapi.SearchHit) error) error {
+	return Scroll(ctx, cfg, func(ctx context.Context, page *opensearchapi.SearchResponse) error {
+		for _, hit := range page.Hits.Hits {
+			if err := fn(ctx, hit); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func scrollPages(ctx context.Context, it *ScrollIterator, fn func(context.Context, *opensearchapi.SearchResponse) error) error {
+	for it.Next(ctx) {
+		if err := fn(ctx, it.Page()); err != nil {
+			if clearErr := it.Close(context.Background()); clearErr != nil {
+				return fmt.Errorf("%w (%s)", err, clearErr)
+			}
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// sliceBodies returns the search body with each slice of the sliced scroll.
+func sliceBodies(body io.Reader, slices int) ([]io.Reader, error) {
+	search := make(map[string]json.RawMessage)
+	if body != nil {
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("scroll: cannot read body: %w", err)
+		}
+		if len(bytes.TrimSpace(b)) > 0 {
+			if err := json.Unmarshal(b, &search); err != nil {
+				return nil, fmt.Errorf("scroll: cannot decode body: %w", err)
+			}
+		}
+	}
+	if _, ok := search["slice"]; ok {
+		return nil, errors.New("scroll: body cannot contain a slice with more than one slice")
+	}
+
+	bodies := make([]io.Reader, slices)
+	for i := range bodies {
+		search["slice"], _ = json.Marshal(map[string]int{"id": i, "max": slices})
+		b, err := json.Marshal(search)
+		if err != nil {
+			return nil, fmt.Errorf("scroll: cannot encode body: %w", err)
+		}
+		bodies[i] = bytes.NewReader(b)
+	}
+	return bodies, nil
+}
+
+// scrollIDBody returns a body with the scroll ID, which is sent in the body as it can exceed the maximum URL length.
+func scrollIDBody(id string) io.Reader {
+	b, _ := json.Marshal(map[string]string{"scroll_id": id})
+	return bytes.NewReader(b)
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// scrollServer fakes the Search, Scroll and ClearScroll APIs; each scroll returns a number of pages
+// with a single hit, with the scroll ID "<slice>-<page>".
+type scrollServer struct {
+	pages      int
+	failScroll bool
+
+	mu       sync.Mutex
+	searches []string
+	scrolls  []string
+	cleared  []string
+	params   []string
+}
+
+func (s *scrollServer) client(t *testing.T) *opensearch.Client {
+	client, err := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{RoundTripFunc: s.roundTrip}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return client
+}
+
+func (s *scrollServer) roundTrip(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var body struct {
+		ScrollID string `json:"scroll_id"`
+		Slice    struct {
+			ID int `json:"id"`
+		} `json:"slice"`
+	}
+	var raw []byte
+	if req.Body != nil {
+		raw, _ = io.ReadAll(req.Body)
+		_ = json.Unmarshal(raw, &body)
+	}
+
+	var slice, page int
+	switch {
+	case req.URL.Path == "/":
+		return response(200, infoBody), nil
+	case req.Method == http.MethodDelete:
+		s.cleared = append(s.cleared, body.ScrollID)
+		return response(200, `{"succeeded":true,"num_freed":1}`), nil
+	case req.URL.Path == "/_search/scroll":
+		s.scrolls = append(s.scrolls, body.ScrollID)
+		if s.failScroll {
+			return response(500, `{"error":{"type":"search_context_missing_exception","reason":"no search context"},"status":500}`), nil
+		}
+		fmt.Sscanf(body.ScrollID, "%d-%d", &slice, &page)
+		page++
+	default:
+		s.searches = append(s.searches, string(raw))
+		slice, page = body.Slice.ID, 1
+	}
+	s.params = append(s.params, req.URL.Query().Get("scroll"))
+
+	hits := `[]`
+	if page <= s.pages {
+		hits = fmt.Sprintf(`[{"_index":"movies","_id":"%d-%d","_score":1}]`, slice, page)
+	}
+	return response(200, fmt.Sprintf(`{"_scroll_id":"%d-%d","took":1,"hits":{"hits":%s}}`, slice, page, hits)), nil
+}
+
+func response(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestScrollIterator(t *testing.T) {
+	t.Run("Pages", func(t *testing.T) {
+		s := &scrollServer{pages: 2}
+		it := NewScrollIterator(s.client(t), opensearchapi.SearchRequest{Index: []string{"movies"}}, 0)
+
+		var ids []string
+		for it.Next(context.Background()) {
+			ids = append(ids, it.Page().Hits.Hits[0].ID)
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if strings.Join(ids, ",") != "0-1,0-2" {
+			t.Errorf("Unexpected hits: %v", ids)
+		}
+		if strings.Join(s.scrolls, ",") != "0-1,0-2" {
+			t.Errorf("Unexpected scroll IDs: %v", s.scrolls)
+		}
+		if strings.Join(s.params, ",") != "60000ms,60000ms,60000ms" {
+			t.Errorf("Unexpected keep alive: %v", s.params)
+		}
+		if strings.Join(s.cleared, ",") != "0-3" {
+			t.Errorf("Unexpected cleared scrolls: %v", s.cleared)
+		}
+
+		if it.Next(context.Background()) {
+			t.Errorf("Expected the iteration to be done")
+		}
+		if err := it.Close(context.Background()); err != nil || len(s.cleared) != 1 {
+			t.Errorf("Expected the scroll to be cleared once, got: %v, %v", s.cleared, err)
+		}
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		s := &scrollServer{pages: 5}
+		it := NewScrollIterator(s.client(t), opensearchapi.SearchRequest{}, 5*time.Minute)
+
+		if !it.Next(context.Background()) {
+			t.Fatalf("Unexpected error: %v", it.Err())
+		}
+		if err := it.Close(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if it.Next(context.Background()) || it.Page() != nil {
+			t.Errorf("Expected the iteration to be done")
+		}
+		if strings.Join(s.cleared, ",") != "0-1" || s.params[0] != "300000ms" {
+			t.Errorf("Unexpected requests: %v, %v", s.cleared, s.params)
+		}
+	})
+
+	t.Run("Scroll error", func(t *testing.T) {
+		s := &scrollServer{pages: 5, failScroll: true}
+		it := NewScrollIterator(s.client(t), opensearchapi.SearchRequest{}, 0)
+
+		for it.Next(context.Background()) {
+		}
+		if err := it.Err(); err == nil || !opensearchapi.HasErrorType(err, "search_context_missing_exception") {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if strings.Join(s.cleared, ",") != "0-1" {
+			t.Errorf("Expected the scroll to be cleared, got: %v", s.cleared)
+		}
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		s := &scrollServer{pages: 5}
+		it := NewScrollIterator(s.client(t), opensearchapi.SearchRequest{}, 0)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		if !it.Next(ctx) {
+			t.Fatalf("Unexpected error: %v", it.Err())
+		}
+		cancel()
+
+		if it.Next(ctx) || !errors.Is(it.Err(), context.Canceled) {
+			t.Errorf("Unexpected error: %v", it.Err())
+		}
+		if strings.Join(s.cleared, ",") != "0-1" {
+			t.Errorf("Expected the scroll to be cleared, got: %v", s.cleared)
+		}
+	})
+}
+
+func TestScroll(t *testing.T) {
+	t.Run("Callback error", func(t *testing.T) {
+		s := &scrollServer{pages: 5}
+		errStop := errors.New("stop")
+
+		err := ScrollHits(context.Background(), ScrollConfig{Client: s.client(t)}, func(context.Context, opensearchapi.SearchHit) error {
+			return errStop
+		})
+		if !errors.Is(err, errStop) {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if strings.Join(s.cleared, ",") != "0-1" {
+			t.Errorf("Expected the scroll to be cleared, got: %v", s.cleared)
+		}
+	})
+
+	t.Run("Slices", func(t *testing.T) {
+		s := &scrollServer{pages: 2}
+
+		var (
+			mu  sync.Mutex
+			ids []string
+		)
+		err := ScrollHits(context.Background(), ScrollConfig{
+			Client:  s.client(t),
+			Request: opensearchapi.SearchRequest{Index: []string{"movies"}, Body: strings.NewReader(`{"query":{"match_all":{}}}`)},
+			Slices:  3,
+		}, func(_ context.Context, hit opensearchapi.SearchHit) error {
+			mu.Lock()
+			defer mu.Unlock()
+			ids = append(ids, hit.ID)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		sort.Strings(ids)
+		if strings.Join(ids, ",") != "0-1,0-2,1-1,1-2,2-1,2-2" {
+			t.Errorf("Unexpected hits: %v", ids)
+		}
+
+		sort.Strings(s.searches)
+		for i, body := range s.searches {
+			expected := fmt.Sprintf(`{"query":{"match_all":{}},"slice":{"id":%d,"max":3}}`, i)
+			if body != expected {
+				t.Errorf("Unexpected body: %s, want: %s", body, expected)
+			}
+		}
+
+		sort.Strings(s.cleared)
+		if strings.Join(s.cleared, ",") != "0-3,1-3,2-3" {
+			t.Errorf("Expected all scrolls to be cleared, got: %v", s.cleared)
+		}
+	})
+
+	t.Run("Slice error", func(t *testing.T) {
+		s := &scrollServer{pages: 5}
+		errStop := errors.New("stop")
+
+		err := Scroll(context.Background(), ScrollConfig{Client: s.client(t), Slices: 2}, func(_ context.Context, page *opensearchapi.SearchResponse) error {
+			if page.Hits.Hits[0].ID == "1-2" {
+				return errStop
+			}
+			return nil
+		})
+		if !errors.Is(err, errStop) || !strings.HasPrefix(err.Error(), "slice 1:") {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		// The other slice may be canceled before its first page.
+		if s.cleared[0] != "1-2" && s.cleared[len(s.cleared)-1] != "1-2" {
+			t.Errorf("Expected the failed scroll to be cleared, got: %v", s.cleared)
+		}
+	})
+
+	t.Run("Body with slice", func(t *testing.T) {
+		err := Scroll(context.Background(), ScrollConfig{
+			Client:  &opensearch.Client{},
+			Request: opensearchapi.SearchRequest{Body: strings.NewReader(`{"slice":{"id":0,"max":2}}`)},
+			Slices:  2,
+		}, nil)
+		if err == nil || !strings.Contains(err.Error(), "cannot contain a slice") {
+			t.Errorf("Expected error, got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearchutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// SQLConfig represents the configuration of the SQLIterator.
+type SQLConfig struct {
+	Client *opensearch.Client // The OpenSearch client.
+
+	Query string // The SQL query, or the PPL query when PPL is set.
+	PPL   bool   // Runs the query with the PPL API.
+
+	// The number of rows of each page of a SQL query; the results are paginated with a cursor when set.
+	// The PPL queries return a single page.
+	FetchSize int
+
+	Filter interface{} // A query DSL clause to filter the documents, eg. an opensearchdsl.Query.
+}
+
+// SQLIterator iterates over the rows of a SQL or PPL query in the jdbc format,
+// following the cursor of the results until the last page.
+//
+// The cursor is closed on error; call Close when the iteration stops early.
+//
+//	it := opensearchutil.NewSQLIterator(opensearchutil.SQLConfig{
+//		Client:    client,
+//		Query:     "SELECT title, year FROM movies WHERE year > 2000",
+//		FetchSize: 500,
+//	})
+//	defer it.Close(context.Background())
+//
+//	for it.Next(ctx) {
+//		var movie struct {
+//			Title string `json:"title"`
+//			Year  int    `json:"year"`
+//		}
+//		if err := it.Scan(&movie); err != nil {
+//			// ...
+//		}
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type SQLIterator struct {
+	client *opensearch.Client
+	cfg    SQLConfig
+
+	columns []opensearchapi.SQLColumn
+	rows    [][]json.RawMessage
+	row     []json.RawMessage
+	cursor  string
+	err     error
+	started bool
+	done    bool
+}
+
+// NewSQLIterator returns an iterator for the query of the configuration.
+// The query is run with the first call to Next.
+func NewSQLIterator(cfg SQLConfig) *SQLIterator {
+	return &SQLIterator{client: cfg.Client, cfg: cfg}
+}
+
+// Next advances to the next row, fetching the next page when needed. It returns false
+// when the rows are exhausted or on error; call Err to tell them apart.
+func (it *SQLIterator) Next(ctx context.Context) bool {
+	it.row = nil
+	if it.done {
+		return false
+	}
+
+	for len(it.rows) == 0 {
+		if it.started && it.cursor == "" {
+			it.done = true
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			return it.fail(err)
+		}
+		if err := it.fetch(ctx); err != nil {
+			return it.fail(err)
+		}
+	}
+
+	it.row, it.rows = it.rows[0], it.rows[1:]
+	return true
+}
+
+// Columns returns the columns of the results, once Next has been called.
+func (it *SQLIterator) Columns() []opensearchapi.SQLColumn {
+	return it.columns
+}
+
+// Row returns the values of the current row, by column. The numbers are decoded as int64 or float64
+// according to the type of the column, the objects as map[string]interface{} and the arrays as []interface{}.
+func (it *SQLIterator) Row() ([]interface{}, error) {
+	if it.row == nil {
+		return nil, errors.New("sql: no current row")
+	}
+
+	values := make([]interface{}, len(it.row))
+	for i, raw := range it.row {
+		var typ string
+		if i < len(it.columns) {
+			typ = it.columns[i].Type
+		}
+		v, err := decodeSQLValue(raw, typ)
+		if err != nil {
+			return nil, fmt.Errorf("sql: cannot decode column %d: %w", i, err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// Scan decodes the current row into dest, a pointer to a struct or a map, with the column labels
+// as JSON keys: the struct fields are matched like with json.Unmarshal, by their json tag or name.
+func (it *SQLIterator) Scan(dest interface{}) error {
+	if it.row == nil {
+		return errors.New("sql: no current row")
+	}
+	if len(it.row) > len(it.columns) {
+		return fmt.Errorf("sql: row has %d values for %d columns", len(it.row), len(it.columns))
+	}
+
+	obj := make(map[string]json.RawMessage, len(it.row))
+	for i, raw := range it.row {
+		obj[it.columns[i].Label()] = raw
+	}
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("sql: %w", err)
+	}
+	if err := json.Unmarshal(b, dest); err != nil {
+		return fmt.Errorf("sql: cannot decode row: %w", err)
+	}
+	return nil
+}
+
+// Err returns the error which stopped the iteration, or nil when the rows are exhausted.
+func (it *SQLIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration and closes the cursor if there are more pages.
+// It is safe to call Close more than once.
+func (it *SQLIterator) Close(ctx context.Context) error {
+	it.done = true
+	it.row = nil
+	it.rows = nil
+	return it.closeCursor(ctx)
+}
+
+// fetch runs the query, or fetches the next page of the cursor.
+func (it *SQLIterator) fetch(ctx context.Context) error {
+	body := opensearchapi.SQLQueryBody{Cursor: it.cursor}
+	if !it.started {
+		body = opensearchapi.SQLQueryBody{Query: it.cfg.Query, Filter: it.cfg.Filter}
+		if !it.cfg.PPL {
+			body.FetchSize = it.cfg.FetchSize
+		}
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("sql: cannot encode body: %w", err)
+	}
+
+	var res *opensearchapi.Response
+	if it.cfg.PPL && !it.started {
+		res, err = opensearchapi.PPLQueryRequest{Body: bytes.NewReader(b), Format: opensearchapi.SQLFormatJDBC}.Do(ctx, it.client)
+	} else {
+		// The pages of a cursor are fetched with the SQL API.
+		res, err = opensearchapi.SQLQueryRequest{Body: bytes.NewReader(b), Format: opensearchapi.SQLFormatJDBC}.Do(ctx, it.client)
+	}
+	if err != nil {
+		return fmt.Errorf("sql: %w", err)
+	}
+
+	page, err := opensearchapi.DecodeSQLResponse(res)
+	if err != nil {
+		return fmt.Errorf("sql: %w", err)
+	}
+	if !it.started {
+		it.started = true
+		it.columns = page.Schema
+	}
+	it.cursor = page.Cursor
+	it.rows = page.DataRows
+	return nil
+}
+
+func (it *SQLIterator) fail(err error) bool {
+	it.done = true
+	it.err = err
+	// The context of the iteration may be canceled, close the cursor regardless.
+	if closeErr := it.closeCursor(context.Background()); closeErr != nil {
+		it.err = fmt.Errorf("%w (%s)", err, closeErr)
+	}
+	return false
+}
+
+func (it *SQLIterator) closeCursor(ctx context.Context) error {
+	if it.cursor == "" {
+		return nil
+	}
+	cursor := it.cursor
+	it.cursor = ""
+
+	b, _ := json.Marshal(opensearchapi.SQLCloseBody{Cursor: cursor})
+	res, _, err := opensearchapi.SQLCloseRequest{Body: bytes.NewReader(b)}.Do(ctx, it.client)
+	if res != nil {
+		defer res.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("cannot close cursor: %w", err)
+	}
+	return nil
+}
+
+// decodeSQLValue decodes a value of the datarows, with the numbers converted by the type of the column.
+func decodeSQLValue(raw json.RawMessage, typ string) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	n, ok := v.(json.Number)
+	if !ok {
+		return v, nil
+	}
+	switch typ {
+	case "long", "integer", "short", "byte":
+		return n.Int64()
+	case "float", "double", "half_float", "scaled_float":
+		return n.Float64()
+	default:
+		return n, nil
+	}
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+// +build !integration
+
+package opensearchutil
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+)
+
+// sqlServer fakes the SQL and PPL APIs; the query returns the first page with the schema,
+// and each cursor the next page, the last one without a cursor.
+type sqlServer struct {
+	pages     []string
+	failQuery bool
+
+	queries []string
+	closed  []string
+}
+
+func (s *sqlServer) client(t *testing.T) *opensearch.Client {
+	client, err := opensearch.NewClient(opensearch.Config{Transport: &mockTransport{RoundTripFunc: s.roundTrip}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return client
+}
+
+func (s *sqlServer) roundTrip(req *http.Request) (*http.Response, error) {
+	var raw []byte
+	if req.Body != nil {
+		raw, _ = io.ReadAll(req.Body)
+	}
+
+	switch req.URL.Path {
+	case "/":
+		return response(200, infoBody), nil
+	case "/_plugins/_sql/close":
+		var body struct {
+			Cursor string `json:"cursor"`
+		}
+		_ = json.Unmarshal(raw, &body)
+		s.closed = append(s.closed, body.Cursor)
+		return response(200, `{"succeeded":true}`), nil
+	}
+
+	s.queries = append(s.queries, req.URL.Path+"?"+req.URL.RawQuery+" "+string(raw))
+	if s.failQuery {
+		return response(400, `{"error":{"type":"SemanticCheckException","reason":"Invalid SQL query","details":"no such field"},"status":400}`), nil
+	}
+	page := s.pages[len(s.queries)-1]
+	return response(200, page), nil
+}
+
+var sqlPages = []string{
+	`{"schema":[{"name":"title","type":"text"},{"name":"year","type":"long"},{"name":"AVG(rating)","alias":"rating","type":"double"}],
+	  "datarows":[["Moneyball",2011,7.6],["Her",2013,8]],"total":3,"size":2,"status":200,"cursor":"c1"}`,
+	`{"datarows":[["Arrival",2016,null]],"cursor":"c2"}`,
+	`{"datarows":[]}`,
+}
+
+func TestSQLIterator(t *testing.T) {
+	t.Run("Rows", func(t *testing.T) {
+		s := &sqlServer{pages: sqlPages}
+		it := NewSQLIterator(SQLConfig{Client: s.client(t), Query: "SELECT title, year, AVG(rating) AS rating FROM movies", FetchSize: 2})
+
+		var rows [][]interface{}
+		for it.Next(context.Background()) {
+			row, err := it.Row()
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			rows = append(rows, row)
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		expected := [][]interface{}{
+			{"Moneyball", int64(2011), 7.6},
+			{"Her", int64(2013), float64(8)},
+			{"Arrival", int64(2016), nil},
+		}
+		if !reflect.DeepEqual(rows, expected) {
+			t.Errorf("Unexpected rows: %v", rows)
+		}
+		if len(it.Columns()) != 3 || it.Columns()[2].Label() != "rating" {
+			t.Errorf("Unexpected columns: %v", it.Columns())
+		}
+
+		expectedQueries := []string{
+			`/_plugins/_sql?format=jdbc {"query":"SELECT title, year, AVG(rating) AS rating FROM movies","fetch_size":2}`,
+			`/_plugins/_sql?format=jdbc {"cursor":"c1"}`,
+			`/_plugins/_sql?format=jdbc {"cursor":"c2"}`,
+		}
+		if strings.Join(s.queries, "\n") != strings.Join(expectedQueries, "\n") {
+			t.Errorf("Unexpected queries:\n%s\nwant:\n%s", strings.Join(s.queries, "\n"), strings.Join(expectedQueries, "\n"))
+		}
+		if len(s.closed) != 0 {
+			t.Errorf("Unexpected closed cursors: %v", s.closed)
+		}
+	})
+
+	t.Run("Scan", func(t *testing.T) {
+		s := &sqlServer{pages: sqlPages}
+		it := NewSQLIterator(SQLConfig{Client: s.client(t), Query: "source=movies | fields title, year, rating", PPL: true})
+
+		type movie struct {
+			Title  string   `json:"title"`
+			Year   int      `json:"year"`
+			Rating *float64 `json:"rating"`
+		}
+		var movies []movie
+		for it.Next(context.Background()) {
+			var m movie
+			if err := it.Scan(&m); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			movies = append(movies, m)
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if len(movies) != 3 || movies[0].Title != "Moneyball" || *movies[1].Rating != 8 || movies[2].Rating != nil {
+			t.Errorf("Unexpected movies: %+v", movies)
+		}
+		if !strings.HasPrefix(s.queries[0], `/_plugins/_ppl?format=jdbc {"query":"source=movies`) ||
+			s.queries[1] != `/_plugins/_sql?format=jdbc {"cursor":"c1"}` {
+			t.Errorf("Unexpected queries: %v", s.queries)
+		}
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		s := &sqlServer{pages: sqlPages}
+		it := NewSQLIterator(SQLConfig{Client: s.client(t), Query: "SELECT * FROM movies", FetchSize: 2})
+
+		if !it.Next(context.Background()) {
+			t.Fatalf("Unexpected error: %v", it.Err())
+		}
+		if err := it.Close(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := it.Close(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if it.Next(context.Background()) {
+			t.Errorf("Expected no row after Close")
+		}
+		if strings.Join(s.closed, ",") != "c1" {
+			t.Errorf("Unexpected closed cursors: %v", s.closed)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		s := &sqlServer{failQuery: true}
+		it := NewSQLIterator(SQLConfig{Client: s.client(t), Query: "SELECT nope FROM movies"})
+
+		if it.Next(context.Background()) {
+			t.Fatalf("Expected no row")
+		}
+		if err := it.Err(); err == nil || !strings.Contains(err.Error(), "Invalid SQL query") {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if _, err := it.Row(); err == nil {
+			t.Errorf("Expected error without a current row")
+		}
+	})
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package opensearch
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// CollectionType is the type of an Amazon OpenSearch Serverless collection.
+type CollectionType string
+
+// Collection types of Amazon OpenSearch Serverless.
+const (
+	CollectionSearch       CollectionType = "SEARCH"
+	CollectionTimeSeries   CollectionType = "TIMESERIES"
+	CollectionVectorSearch CollectionType = "VECTORSEARCH"
+)
+
+// ServerlessUnsupportedError is returned for requests to APIs which are not supported
+// by Amazon OpenSearch Serverless, without sending them to the collection.
+type ServerlessUnsupportedError struct {
+	Method string
+	Path   string
+}
+
+// Error implements the error interface.
+func (e *ServerlessUnsupportedError) Error() string {
+	return fmt.Sprintf("serverless: %s %s is not supported", e.Method, e.Path)
+}
+
+// serverlessDiscoveryError returns the error of the node discovery in serverless mode.
+func serverlessDiscoveryError() error {
+	return &ServerlessUnsupportedError{Method: http.MethodGet, Path: "/_nodes/http"}
+}
+
+// serverlessUnsupportedPath returns true for the APIs which are not available in serverless collections,
+// by the first path segment.
+func serverlessUnsupportedPath(segment string) bool {
+	switch segment {
+	case "_nodes", "_cluster", "_snapshot", "_tasks", "_dangling", "_remote", "_scripts",
+		"_stats", "_flush", "_refresh", "_forcemerge", "_cache", "_segments", "_recovery",
+		"_shard_stores", "_upgrade", "_opendistro":
+		return true
+	}
+	return false
+}
+
+// serverlessUnsupportedIndexPath returns true for the index level APIs which are not available
+// in serverless collections, by the second path segment.
+func serverlessUnsupportedIndexPath(segment string) bool {
+	switch segment {
+	case "_close", "_open", "_shrink", "_split", "_clone", "_flush", "_refresh", "_forcemerge",
+		"_cache", "_stats", "_segments", "_recovery", "_shard_stores", "_upgrade":
+		return true
+	}
+	return false
+}
+
+// serverlessUnsupportedPlugin returns true for the plugins which are not available in serverless collections.
+func serverlessUnsupportedPlugin(name string) bool {
+	switch name {
+	case "_ism", "_security", "_replication", "_rollup", "_transform", "_alerting",
+		"_anomaly_detection", "_asynchronous_search":
+		return true
+	}
+	return false
+}
+
+// serverlessSupported returns false when the request targets an API which is not available in serverless collections.
+func serverlessSupported(path string) bool {
+	parts := strings.SplitN(strings.Trim(path, "/"), "/", 3)
+
+	switch {
+	case serverlessUnsupportedPath(parts[0]):
+		return false
+	case parts[0] == "_cat":
+		return len(parts) > 1 && parts[1] == "indices"
+	case parts[0] == "_plugins":
+		return len(parts) < 2 || !serverlessUnsupportedPlugin(parts[1])
+	case len(parts) > 1 && !strings.HasPrefix(parts[0], "_"):
+		return !serverlessUnsupportedIndexPath(parts[1])
+	}
+
+	return true
+}
+
+// serverlessUnsupportedOption returns the name of the first option of cfg which can't be used
+// in serverless mode, or an empty string. Collections have no nodes to discover or mark dead.
+func serverlessUnsupportedOption(cfg *Config) string {
+	switch {
+	case cfg.DiscoverNodesOnStart:
+		return "DiscoverNodesOnStart"
+	case cfg.DiscoverNodesInterval != 0:
+		return "DiscoverNodesInterval"
+	case cfg.NodeSource != nil:
+		return "NodeSource"
+	case cfg.Selector != nil:
+		return "Selector"
+	case cfg.ConnectionPoolFunc != nil:
+		return "ConnectionPoolFunc"
+	case cfg.ConnectionPoolListener != nil:
+		return "ConnectionPoolListener"
+	}
+	return ""
+}
+
+// checkServerless returns a ServerlessUnsupportedError when the request is not supported in serverless mode.
+func (c *Client) checkServerless(req *http.Request) error {
+	if !c.serverless || serverlessSupported(req.URL.Path) {
+		return nil
+	}
+	return &ServerlessUnsupportedError{Method: req.Method, Path: req.URL.Path}
+}
+
+// IsServerless returns true when the client was configured for Amazon OpenSearch Serverless.
+func (c *Client) IsServerless() bool {
+	return c.serverless
+}
+
+// CollectionType returns the type of the serverless collection, or an empty string
+// when the client is not in serverless mode or the type was not configured.
+func (c *Client) CollectionType() CollectionType {
+	return c.collectionType
+}
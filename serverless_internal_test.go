@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+//go:build !integration
+
+package opensearch
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2/opensearchtransport"
+)
+
+type mockSelector struct{}
+
+func (mockSelector) Select(conns []*opensearchtransport.Connection) (*opensearchtransport.Connection, error) {
+	return conns[0], nil
+}
+
+func TestServerlessSupported(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/", true},
+		{"/_bulk", true},
+		{"/_search", true},
+		{"/test/_doc/1", true},
+		{"/test/_search", true},
+		{"/test/_mapping", true},
+		{"/_cat/indices", true},
+		{"/_cat/indices/test", true},
+		{"/_plugins/_knn/stats", true},
+		{"/_plugins/_sql", true},
+
+		{"/_nodes/http", false},
+		{"/_cluster/health", false},
+		{"/_snapshot/repo", false},
+		{"/_tasks", false},
+		{"/_refresh", false},
+		{"/_cat/nodes", false},
+		{"/_cat", false},
+		{"/test/_close", false},
+		{"/test/_forcemerge", false},
+		{"/_plugins/_ism/policies/policy", false},
+		{"/_plugins/_security/api/roles", false},
+		{"/_opendistro/_security/api/roles", false},
+	}
+
+	for _, tt := range tests {
+		if got := serverlessSupported(tt.path); got != tt.want {
+			t.Errorf("serverlessSupported(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestClientServerless(t *testing.T) {
+	t.Run("Multiple addresses", func(t *testing.T) {
+		_, err := NewClient(Config{Serverless: true, Addresses: []string{"http://localhost:9200", "http://localhost:9201"}})
+		if err == nil {
+			t.Fatal("Expected error")
+		}
+	})
+
+	t.Run("Unsupported options", func(t *testing.T) {
+		for _, tt := range []struct {
+			name string
+			cfg  Config
+		}{
+			{"DiscoverNodesOnStart", Config{DiscoverNodesOnStart: true}},
+			{"DiscoverNodesInterval", Config{DiscoverNodesInterval: time.Minute}},
+			{"NodeSource", Config{NodeSource: opensearchtransport.NewFileNodeSource("nodes.json")}},
+			{"Selector", Config{Selector: mockSelector{}}},
+			{"ConnectionPoolFunc", Config{ConnectionPoolFunc: func(
+				conns []*opensearchtransport.Connection, s opensearchtransport.Selector,
+			) opensearchtransport.ConnectionPool {
+				return nil
+			}}},
+			{"ConnectionPoolListener", Config{ConnectionPoolListener: opensearchtransport.ConnectionPoolListenerFunc(
+				func(opensearchtransport.ConnectionEvent) {},
+			)}},
+		} {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				tt.cfg.Serverless = true
+				_, err := NewClient(tt.cfg)
+				if err == nil || !strings.Contains(err.Error(), tt.name) {
+					t.Errorf("Expected error for %s, got: %v", tt.name, err)
+				}
+			})
+		}
+	})
+
+	t.Run("Unsupported API", func(t *testing.T) {
+		var calls int
+		c, err := NewClient(Config{
+			Serverless:     true,
+			CollectionType: CollectionTimeSeries,
+			Transport: &mockTransp{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					calls++
+					return defaultRoundTripFunc(req)
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if !c.IsServerless() || c.CollectionType() != CollectionTimeSeries {
+			t.Errorf("Unexpected serverless configuration: %v, %q", c.IsServerless(), c.CollectionType())
+		}
+
+		_, err = c.Cluster.Health()
+		var serr *ServerlessUnsupportedError
+		if !errors.As(err, &serr) {
+			t.Fatalf("Expected ServerlessUnsupportedError, got: %v", err)
+		}
+		if serr.Method != http.MethodGet || serr.Path != "/_cluster/health" {
+			t.Errorf("Unexpected error: %s", serr)
+		}
+		if calls != 0 {
+			t.Errorf("Expected no requests, got: %d", calls)
+		}
+
+		if _, err := c.Search(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if calls != 1 {
+			t.Errorf("Expected 1 request, got: %d", calls)
+		}
+
+		if err := c.DiscoverNodes(); !errors.As(err, &serr) {
+			t.Errorf("Expected ServerlessUnsupportedError, got: %v", err)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		c, _ := NewClient(Config{CollectionType: CollectionTimeSeries, Transport: &mockTransp{}})
+
+		if c.IsServerless() || c.CollectionType() != "" {
+			t.Errorf("Unexpected serverless configuration: %v, %q", c.IsServerless(), c.CollectionType())
+		}
+
+		req, _ := http.NewRequest(http.MethodGet, "/_cluster/health", nil)
+		if _, err := c.Perform(req); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	})
+}
@@ -10,18 +10,18 @@
 package aws
 
 import (
-	"bytes"
-	"crypto/sha256"
-	"encoding/hex"
+	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+
+	"github.com/opensearch-project/opensearch-go/v2/signer/internal/payload"
 )
 
 // OpenSearchService Amazon OpenSearch Service Name
@@ -30,22 +30,31 @@ const OpenSearchService = "es"
 // OpenSearchServerless Amazon OpenSearch Serverless Name
 const OpenSearchServerless = "aoss"
 
-const emptyBodySHA256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
-
 // Signer is an interface that will implement opensearchtransport.Signer
 type Signer struct {
-	session session.Session
-	service string
+	session         session.Session
+	service         string
+	signer          *v4.Signer
+	unsignedPayload bool
+}
+
+// Option configures the Signer.
+type Option func(*Signer)
+
+// WithUnsignedPayload signs HTTPS requests with UNSIGNED-PAYLOAD instead of the hash of the body.
+// Use it only with services which accept unsigned payloads.
+func WithUnsignedPayload() Option {
+	return func(s *Signer) { s.unsignedPayload = true }
 }
 
 // NewSigner returns an instance of Signer for configured for Amazon OpenSearch Service.
 // Use NewSignerWithService to configure it for another service such as Amazon OpenSearch Serverless.
-func NewSigner(opts session.Options) (*Signer, error) {
-	return NewSignerWithService(opts, OpenSearchService)
+func NewSigner(opts session.Options, options ...Option) (*Signer, error) {
+	return NewSignerWithService(opts, OpenSearchService, options...)
 }
 
 // NewSignerWithService returns an instance of Signer for a given service.
-func NewSignerWithService(opts session.Options, service string) (*Signer, error) {
+func NewSignerWithService(opts session.Options, service string, options ...Option) (*Signer, error) {
 	if len(strings.TrimSpace(service)) < 1 {
 		return nil, errors.New("service cannot be empty")
 	}
@@ -55,59 +64,62 @@ func NewSignerWithService(opts session.Options, service string) (*Signer, error)
 		return nil, fmt.Errorf("failed to get session from given options %v: %w", opts, err)
 	}
 
-	return &Signer{
+	s := &Signer{
 		session: *awsSession,
 		service: service,
-	}, nil
+		signer:  newV4Signer(awsSession.Config.Credentials),
+	}
+	for _, o := range options {
+		o(s)
+	}
+
+	return s, nil
 }
 
 // SignRequest signs the request using SigV4.
 func (s Signer) SignRequest(req *http.Request) error {
-	return sign(req, s.session.Config.Region, s.service, v4.NewSigner(s.session.Config.Credentials))
+	return s.SignRequestWithContext(req.Context(), req)
 }
 
-func sign(req *http.Request, region *string, serviceName string, signer *v4.Signer) error {
-	if region == nil || len(*region) == 0 {
-		return fmt.Errorf("aws region cannot be empty")
+// SignRequestWithContext signs the request using SigV4.
+// The context is used to retrieve the credentials, which are cached until they expire.
+func (s Signer) SignRequestWithContext(ctx context.Context, req *http.Request) error {
+	signer := s.signer
+	if signer == nil {
+		signer = newV4Signer(s.session.Config.Credentials)
 	}
+	return sign(ctx, req, s.session.Config.Region, s.service, signer, s.unsignedPayload)
+}
 
-	var body io.ReadSeeker
-
-	contentSha256Hash := emptyBodySHA256
-
-	if req.Body != nil {
-		b, err := io.ReadAll(req.Body)
-		if err != nil {
-			return fmt.Errorf("failed to read request body: %w", err)
-		}
+// newV4Signer returns a signer which keeps the body of the request: the body is passed as nil to Sign,
+// which would otherwise replace it with nil.
+func newV4Signer(creds *credentials.Credentials) *v4.Signer {
+	return v4.NewSigner(creds, func(s *v4.Signer) {
+		s.DisableRequestBodyOverwrite = true
+	})
+}
 
-		body = bytes.NewReader(b)
+func sign(ctx context.Context, req *http.Request, region *string, serviceName string, signer *v4.Signer, unsignedPayload bool) error {
+	if region == nil || len(*region) == 0 {
+		return fmt.Errorf("aws region cannot be empty")
+	}
 
-		hash, err := hexEncodedSha256(b)
-		if err != nil {
-			return fmt.Errorf("failed to calculate hash of request body: %w", err)
+	// Retrieve the credentials with the context, the signer then uses the cached values
+	if signer.Credentials != nil {
+		if _, err := signer.Credentials.GetWithContext(ctx); err != nil {
+			return fmt.Errorf("failed to retrieve credentials: %w", err)
 		}
-
-		contentSha256Hash = hash
 	}
-	// Add the "X-Amz-Content-Sha256" header as required by Amazon OpenSearch Serverless.
-	req.Header.Set("X-Amz-Content-Sha256", contentSha256Hash)
 
-	if _, err := signer.Sign(req, body, serviceName, *region, time.Now().UTC()); err != nil {
+	// Add the "X-Amz-Content-Sha256" header as required by Amazon OpenSearch Serverless.
+	// The signer uses the header value rather than reading the body.
+	if _, err := payload.Hash(req, unsignedPayload); err != nil {
 		return err
 	}
 
-	return nil
-}
-
-func hexEncodedSha256(b []byte) (string, error) {
-	hasher := sha256.New()
-
-	if _, err := hasher.Write(b); err != nil {
-		return "", fmt.Errorf("failed to write: %w", err)
+	if _, err := signer.Sign(req, nil, serviceName, *region, time.Now().UTC()); err != nil {
+		return err
 	}
 
-	digest := hasher.Sum(nil)
-
-	return hex.EncodeToString(digest), nil
+	return nil
 }
@@ -11,10 +11,12 @@ package aws_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -65,7 +67,7 @@ func TestV4Signer(t *testing.T) {
 		q := req.Header
 		assert.NotEmpty(t, q.Get("Authorization"))
 		assert.NotEmpty(t, q.Get("X-Amz-Date"))
-		assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", q.Get("X-Amz-Content-Sha256"))
+		assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", q.Get("X-Amz-Content-Sha256"))
 	})
 
 	t.Run("sign request success with body", func(t *testing.T) {
@@ -92,6 +94,29 @@ func TestV4Signer(t *testing.T) {
 		assert.Equal(t, "1307990e6ba5ca145eb35e99182a9bec46531bc54ddf656a602c780fa0240dee", q.Get("X-Amz-Content-Sha256"))
 	})
 
+	t.Run("sign request keeps the body", func(t *testing.T) {
+		sessionOptions := session.Options{
+			Config: aws.Config{
+				Region:      aws.String("us-west-2"),
+				Credentials: credentials.NewStaticCredentials("AKID", "SECRET_KEY", "TOKEN"),
+			},
+		}
+		signer, err := osaws.NewSigner(sessionOptions)
+		assert.NoError(t, err)
+
+		for _, method := range []string{http.MethodPost, http.MethodPut} {
+			req, err := http.NewRequest(method, "https://localhost:9200/_bulk", bytes.NewBufferString("some data"))
+			assert.NoError(t, err)
+
+			assert.NoError(t, signer.SignRequest(req))
+			if assert.NotNil(t, req.Body, method) {
+				body, err := io.ReadAll(req.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "some data", string(body), method)
+			}
+		}
+	})
+
 	t.Run("sign request success with body for OpenSearch Service Serverless", func(t *testing.T) {
 		req, err := http.NewRequest(
 			http.MethodPost, "https://localhost:9200",
@@ -138,6 +163,51 @@ func TestV4Signer(t *testing.T) {
 		assert.EqualError(t, err, "service cannot be empty")
 	})
 
+	t.Run("sign request with context", func(t *testing.T) {
+		provider := &contextProvider{}
+		sessionOptions := session.Options{
+			Config: aws.Config{
+				Region:      aws.String("us-west-2"),
+				Credentials: credentials.NewCredentials(provider),
+			},
+		}
+		signer, err := osaws.NewSigner(sessionOptions)
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		req, _ := http.NewRequest(http.MethodGet, "https://localhost:9200", nil)
+		err = signer.SignRequestWithContext(ctx, req)
+		assert.ErrorContains(t, err, "context canceled")
+		assert.Empty(t, req.Header.Get("Authorization"))
+
+		for i := 0; i < 3; i++ {
+			req, _ := http.NewRequest(http.MethodGet, "https://localhost:9200", nil)
+			assert.NoError(t, signer.SignRequestWithContext(context.Background(), req))
+			assert.NotEmpty(t, req.Header.Get("Authorization"))
+		}
+		assert.Equal(t, 1, provider.retrieved, "Expected the credentials to be cached")
+	})
+
+	t.Run("sign request with unsigned payload", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "https://localhost:9200", bytes.NewBufferString("some data"))
+		assert.NoError(t, err)
+
+		sessionOptions := session.Options{
+			Config: aws.Config{
+				Region:      aws.String("us-west-2"),
+				Credentials: credentials.NewStaticCredentials("AKID", "SECRET_KEY", "TOKEN"),
+			},
+		}
+		signer, err := osaws.NewSignerWithService(sessionOptions, osaws.OpenSearchServerless, osaws.WithUnsignedPayload())
+		assert.NoError(t, err)
+
+		assert.NoError(t, signer.SignRequest(req))
+		assert.NotEmpty(t, req.Header.Get("Authorization"))
+		assert.Equal(t, "UNSIGNED-PAYLOAD", req.Header.Get("X-Amz-Content-Sha256"))
+	})
+
 	t.Run("sign request failed due to invalid body", func(t *testing.T) {
 		req, err := http.NewRequest(http.MethodPost, "https://localhost:9200", nil)
 		assert.NoError(t, err)
@@ -158,6 +228,24 @@ func TestV4Signer(t *testing.T) {
 	})
 }
 
+type contextProvider struct {
+	credentials.Expiry
+	retrieved int
+}
+
+func (p *contextProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(context.Background())
+}
+
+func (p *contextProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	if err := ctx.Err(); err != nil {
+		return credentials.Value{}, err
+	}
+	p.retrieved++
+	p.SetExpiration(time.Now().Add(time.Hour), time.Minute)
+	return credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET_KEY"}, nil
+}
+
 type brokenReader string
 
 func (br brokenReader) Read([]byte) (int, error) {
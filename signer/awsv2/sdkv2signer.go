@@ -10,13 +10,9 @@
 package awsv2
 
 import (
-	"bytes"
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -25,41 +21,78 @@ import (
 	awsSignerV4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 
 	"github.com/opensearch-project/opensearch-go/v2/signer"
+	"github.com/opensearch-project/opensearch-go/v2/signer/internal/payload"
 )
 
 const (
 	openSearchService = "es"
-	emptyStringSHA256 = `e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855`
+
+	// credentialsExpiryWindow is the time before their expiry when the cached credentials are refreshed.
+	credentialsExpiryWindow = 5 * time.Minute
 )
 
 type awsSdkV2Signer struct {
-	service string
-	signer  *awsSignerV4.Signer
-	awsCfg  aws.Config
+	service         string
+	signer          *awsSignerV4.Signer
+	awsCfg          aws.Config
+	unsignedPayload bool
+}
+
+// Option configures the Signer.
+type Option func(*awsSdkV2Signer)
+
+// WithUnsignedPayload signs HTTPS requests with UNSIGNED-PAYLOAD instead of the hash of the body.
+// Use it only with services which accept unsigned payloads.
+func WithUnsignedPayload() Option {
+	return func(s *awsSdkV2Signer) { s.unsignedPayload = true }
 }
 
 // NewSigner returns an instance of Signer for AWS OpenSearchService
-func NewSigner(cfg aws.Config) (signer.Signer, error) {
-	return NewSignerWithService(cfg, openSearchService)
+func NewSigner(cfg aws.Config, opts ...Option) (signer.Signer, error) {
+	return NewSignerWithService(cfg, openSearchService, opts...)
 }
 
-// NewSignerWithService returns an instance of Signer for given service
-func NewSignerWithService(cfg aws.Config, service string) (signer.Signer, error) {
+// NewSignerWithService returns an instance of Signer for given service.
+//
+// The credentials are wrapped with aws.CredentialsCache, unless they are cached already,
+// so they are retrieved again only shortly before they expire.
+func NewSignerWithService(cfg aws.Config, service string, opts ...Option) (signer.Signer, error) {
 	if len(strings.TrimSpace(service)) < 1 {
 		return nil, errors.New("service cannot be empty")
 	}
 
-	return &awsSdkV2Signer{
+	if _, ok := cfg.Credentials.(*aws.CredentialsCache); !ok && cfg.Credentials != nil {
+		cfg.Credentials = aws.NewCredentialsCache(cfg.Credentials, func(o *aws.CredentialsCacheOptions) {
+			o.ExpiryWindow = credentialsExpiryWindow
+		})
+	}
+
+	s := &awsSdkV2Signer{
 		service: service,
 		signer:  awsSignerV4.NewSigner(),
 		awsCfg:  cfg,
-	}, nil
+	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s, nil
 }
 
+// SignRequest signs the request using SigV4.
 func (s *awsSdkV2Signer) SignRequest(r *http.Request) error {
-	ctx := context.Background()
+	return s.SignRequestWithContext(r.Context(), r)
+}
+
+// SignRequestWithContext signs the request using SigV4.
+// The context is used to retrieve the credentials.
+func (s *awsSdkV2Signer) SignRequestWithContext(ctx context.Context, r *http.Request) error {
 	t := time.Now()
 
+	if s.awsCfg.Credentials == nil {
+		return errors.New("aws credentials cannot be empty")
+	}
+
 	creds, err := s.awsCfg.Credentials.Retrieve(ctx)
 	if err != nil {
 		return err
@@ -69,35 +102,10 @@ func (s *awsSdkV2Signer) SignRequest(r *http.Request) error {
 		return fmt.Errorf("aws region cannot be empty")
 	}
 
-	hash, err := hexEncodedSha256OfRequest(r)
-	r.Header.Set("X-Amz-Content-Sha256", hash)
-
+	hash, err := payload.Hash(r, s.unsignedPayload)
 	if err != nil {
 		return err
 	}
 
 	return s.signer.SignHTTP(ctx, creds, r, hash, s.service, s.awsCfg.Region, t)
 }
-
-func hexEncodedSha256OfRequest(r *http.Request) (string, error) {
-	if r.Body == nil {
-		return emptyStringSHA256, nil
-	}
-
-	hasher := sha256.New()
-
-	reqBodyBytes, err := io.ReadAll(r.Body)
-	if err != nil {
-		return "", err
-	}
-
-	if err := r.Body.Close(); err != nil {
-		return "", err
-	}
-
-	r.Body = io.NopCloser(bytes.NewBuffer(reqBodyBytes))
-	hasher.Write(reqBodyBytes)
-	digest := hasher.Sum(nil)
-
-	return hex.EncodeToString(digest), nil
-}
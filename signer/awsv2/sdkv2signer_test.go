@@ -15,11 +15,13 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/opensearch-project/opensearch-go/v2/signer"
 	"github.com/opensearch-project/opensearch-go/v2/signer/awsv2"
 )
 
@@ -160,4 +162,58 @@ func TestV4SignerAwsSdkV2(t *testing.T) {
 		_, err = awsv2.NewSignerWithService(awsCfg, "")
 		assert.EqualError(t, err, "service cannot be empty")
 	})
+
+	t.Run("sign request with context", func(t *testing.T) {
+		var retrieved int
+		awsCfg := aws.Config{
+			Region: "us-west-2",
+			Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+				if err := ctx.Err(); err != nil {
+					return aws.Credentials{}, err
+				}
+				retrieved++
+				return aws.Credentials{
+					AccessKeyID:     "AKID",
+					SecretAccessKey: "SECRET_KEY",
+					CanExpire:       true,
+					Expires:         time.Now().Add(time.Hour),
+				}, nil
+			}),
+		}
+
+		s, err := awsv2.NewSigner(awsCfg)
+		assert.NoError(t, err)
+
+		cs, ok := s.(signer.ContextSigner)
+		if !ok {
+			t.Fatalf("Expected signer to implement signer.ContextSigner")
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		req, _ := http.NewRequest(http.MethodGet, "https://localhost:9200", nil)
+		assert.ErrorIs(t, cs.SignRequestWithContext(ctx, req), context.Canceled)
+		assert.Empty(t, req.Header.Get("Authorization"))
+
+		for i := 0; i < 3; i++ {
+			req, _ := http.NewRequest(http.MethodGet, "https://localhost:9200", nil)
+			assert.NoError(t, cs.SignRequestWithContext(context.Background(), req))
+			assert.NotEmpty(t, req.Header.Get("Authorization"))
+		}
+		assert.Equal(t, 1, retrieved, "Expected the credentials to be cached")
+	})
+
+	t.Run("sign request with unsigned payload", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "https://localhost:9200", bytes.NewBufferString("some data"))
+		assert.NoError(t, err)
+
+		awsCfg := aws.Config{Region: "us-west-2", Credentials: getCredentialProvider()}
+		signer, err := awsv2.NewSignerWithService(awsCfg, "aoss", awsv2.WithUnsignedPayload())
+		assert.NoError(t, err)
+
+		assert.NoError(t, signer.SignRequest(req))
+		assert.NotEmpty(t, req.Header.Get("Authorization"))
+		assert.Equal(t, "UNSIGNED-PAYLOAD", req.Header.Get("X-Amz-Content-Sha256"))
+	})
 }
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+// Package payload computes the payload hash of requests signed with SigV4.
+package payload
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	// HeaderContentSHA256 is the header carrying the payload hash, required by Amazon OpenSearch Serverless.
+	HeaderContentSHA256 = "X-Amz-Content-Sha256"
+
+	// Unsigned is the payload hash of requests with an unsigned body.
+	Unsigned = "UNSIGNED-PAYLOAD"
+
+	// EmptySHA256 is the hex encoded SHA-256 hash of an empty body.
+	EmptySHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// Hash returns the payload hash of the request, and sets the X-Amz-Content-Sha256 header to it.
+//
+// The body is hashed for every call, as it may have changed since the header was set,
+// eg. by signing a previous attempt of the request. Only UNSIGNED-PAYLOAD set by the caller is kept.
+// The body is streamed from GetBody when available, otherwise it is read once
+// and GetBody is set, so the body is never copied for hashing.
+//
+// When unsigned is true, UNSIGNED-PAYLOAD is used for HTTPS requests instead of hashing the body.
+func Hash(req *http.Request, unsigned bool) (string, error) {
+	if req.Header.Get(HeaderContentSHA256) == Unsigned {
+		return Unsigned, nil
+	}
+
+	hash, err := hash(req, unsigned)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set(HeaderContentSHA256, hash)
+
+	return hash, nil
+}
+
+func hash(req *http.Request, unsigned bool) (string, error) {
+	if unsigned && req.URL != nil && req.URL.Scheme == "https" {
+		return Unsigned, nil
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return EmptySHA256, nil
+	}
+
+	if req.GetBody == nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return "", fmt.Errorf("failed to read request body: %w", err)
+		}
+		if err := req.Body.Close(); err != nil {
+			return "", fmt.Errorf("failed to close request body: %w", err)
+		}
+
+		req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(b)), nil }
+		req.Body = io.NopCloser(bytes.NewReader(b))
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return "", fmt.Errorf("failed to get request body: %w", err)
+	}
+	defer body.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, body); err != nil {
+		return "", fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package payload_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opensearch-project/opensearch-go/v2/signer/internal/payload"
+)
+
+const someDataSHA256 = "1307990e6ba5ca145eb35e99182a9bec46531bc54ddf656a602c780fa0240dee"
+
+func TestHash(t *testing.T) {
+	t.Run("empty body", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "https://localhost:9200", nil)
+
+		hash, err := payload.Hash(req, false)
+		assert.NoError(t, err)
+		assert.Equal(t, payload.EmptySHA256, hash)
+		assert.Equal(t, payload.EmptySHA256, req.Header.Get(payload.HeaderContentSHA256))
+	})
+
+	t.Run("body is streamed from GetBody", func(t *testing.T) {
+		var calls int
+		req, _ := http.NewRequest(http.MethodPost, "https://localhost:9200", strings.NewReader("some data"))
+		getBody := req.GetBody
+		req.GetBody = func() (io.ReadCloser, error) {
+			calls++
+			return getBody()
+		}
+		body := req.Body
+
+		hash, err := payload.Hash(req, false)
+		assert.NoError(t, err)
+		assert.Equal(t, someDataSHA256, hash)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, body, req.Body, "Expected the request body to be left untouched")
+
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "some data", string(b))
+	})
+
+	t.Run("body without GetBody", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "https://localhost:9200", nil)
+		req.Body = io.NopCloser(strings.NewReader("some data"))
+
+		hash, err := payload.Hash(req, false)
+		assert.NoError(t, err)
+		assert.Equal(t, someDataSHA256, hash)
+		assert.NotNil(t, req.GetBody)
+
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "some data", string(b))
+	})
+
+	t.Run("hash is computed again for each attempt", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "https://localhost:9200", strings.NewReader("some data"))
+		req.Header.Set(payload.HeaderContentSHA256, payload.EmptySHA256)
+
+		hash, err := payload.Hash(req, false)
+		assert.NoError(t, err)
+		assert.Equal(t, someDataSHA256, hash, "Expected a stale header to be ignored")
+		assert.Equal(t, someDataSHA256, req.Header.Get(payload.HeaderContentSHA256))
+
+		req.Body = io.NopCloser(strings.NewReader("other data"))
+		req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader("other data")), nil }
+		hash, err = payload.Hash(req, false)
+		assert.NoError(t, err)
+		assert.NotEqual(t, someDataSHA256, hash, "Expected the changed body to be hashed")
+	})
+
+	t.Run("unsigned payload header is kept", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "http://localhost:9200", strings.NewReader("some data"))
+		req.Header.Set(payload.HeaderContentSHA256, payload.Unsigned)
+
+		hash, err := payload.Hash(req, false)
+		assert.NoError(t, err)
+		assert.Equal(t, payload.Unsigned, hash)
+	})
+
+	t.Run("unsigned payload", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "https://localhost:9200", strings.NewReader("some data"))
+
+		hash, err := payload.Hash(req, true)
+		assert.NoError(t, err)
+		assert.Equal(t, payload.Unsigned, hash)
+		assert.Equal(t, payload.Unsigned, req.Header.Get(payload.HeaderContentSHA256))
+
+		req, _ = http.NewRequest(http.MethodPost, "http://localhost:9200", strings.NewReader("some data"))
+		hash, err = payload.Hash(req, true)
+		assert.NoError(t, err)
+		assert.Equal(t, someDataSHA256, hash, "Expected the payload to be signed without TLS")
+	})
+}
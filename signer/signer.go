@@ -9,9 +9,19 @@
 
 package signer
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 // Signer an interface that will sign http.Request
 type Signer interface {
 	SignRequest(request *http.Request) error
 }
+
+// ContextSigner is a Signer which uses a context, eg. to honor the deadline of the request
+// when retrieving credentials. The transport prefers it over SignRequest.
+type ContextSigner interface {
+	Signer
+	SignRequestWithContext(ctx context.Context, request *http.Request) error
+}
@@ -0,0 +1,388 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package sigv4
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultExpiryWindow = 5 * time.Minute
+	// defaultRefreshInterval is how long the credentials with no expiry are cached.
+	defaultRefreshInterval = 5 * time.Minute
+)
+
+// ErrNoCredentials is returned by the credentials providers which are not configured,
+// eg. when the environment variables are not set.
+var ErrNoCredentials = errors.New("no credentials")
+
+// Credentials represents the AWS credentials.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	Expires         time.Time // Zero when the credentials do not expire.
+}
+
+// CredentialsProvider defines the interface for retrieving AWS credentials.
+type CredentialsProvider interface {
+	Retrieve(ctx context.Context) (Credentials, error)
+}
+
+// CredentialsProviderFunc is an adapter to allow the use of ordinary functions as a CredentialsProvider.
+type CredentialsProviderFunc func(ctx context.Context) (Credentials, error)
+
+// Retrieve calls f(ctx).
+func (f CredentialsProviderFunc) Retrieve(ctx context.Context) (Credentials, error) { return f(ctx) }
+
+// NewStaticCredentialsProvider returns a provider of fixed credentials.
+func NewStaticCredentialsProvider(accessKeyID, secretAccessKey, sessionToken string) CredentialsProvider {
+	creds := Credentials{AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey, SessionToken: sessionToken}
+	return CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+			return Credentials{}, fmt.Errorf("static: %w", ErrNoCredentials)
+		}
+		return creds, nil
+	})
+}
+
+// NewDefaultCredentialsProvider returns a cached provider which tries, in order:
+// the environment variables, the web identity token file and the shared credentials file.
+func NewDefaultCredentialsProvider() CredentialsProvider {
+	return NewCachedCredentialsProvider(NewChainCredentialsProvider(
+		EnvCredentialsProvider{},
+		&WebIdentityCredentialsProvider{},
+		&SharedCredentialsProvider{},
+	), defaultExpiryWindow)
+}
+
+// EnvCredentialsProvider retrieves the credentials from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY
+// and AWS_SESSION_TOKEN environment variables; AWS_ACCESS_KEY and AWS_SECRET_KEY are used as fallbacks.
+type EnvCredentialsProvider struct{}
+
+// Retrieve returns the credentials from the environment.
+func (EnvCredentialsProvider) Retrieve(context.Context) (Credentials, error) {
+	creds := Credentials{
+		AccessKeyID:     firstEnv("AWS_ACCESS_KEY_ID", "AWS_ACCESS_KEY"),
+		SecretAccessKey: firstEnv("AWS_SECRET_ACCESS_KEY", "AWS_SECRET_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return Credentials{}, fmt.Errorf("environment: %w", ErrNoCredentials)
+	}
+	return creds, nil
+}
+
+// SharedCredentialsProvider retrieves the credentials from the shared credentials file.
+type SharedCredentialsProvider struct {
+	// Path of the file. Default: AWS_SHARED_CREDENTIALS_FILE or ~/.aws/credentials.
+	Filename string
+	// Name of the profile. Default: AWS_PROFILE or default.
+	Profile string
+}
+
+// Retrieve returns the credentials of the profile from the file.
+func (p *SharedCredentialsProvider) Retrieve(context.Context) (Credentials, error) {
+	filename := p.Filename
+	if filename == "" {
+		filename = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	}
+	if filename == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return Credentials{}, fmt.Errorf("shared credentials: %w", ErrNoCredentials)
+		}
+		filename = filepath.Join(home, ".aws", "credentials")
+	}
+
+	profile := p.Profile
+	if profile == "" {
+		profile = firstEnv("AWS_PROFILE")
+	}
+	if profile == "" {
+		profile = "default"
+	}
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Credentials{}, fmt.Errorf("shared credentials: %s: %w", filename, ErrNoCredentials)
+		}
+		return Credentials{}, fmt.Errorf("shared credentials: %w", err)
+	}
+
+	values, ok := parseINISection(b, profile)
+	if !ok {
+		return Credentials{}, fmt.Errorf("shared credentials: profile %q not found in %s: %w", profile, filename, ErrNoCredentials)
+	}
+
+	creds := Credentials{
+		AccessKeyID:     values["aws_access_key_id"],
+		SecretAccessKey: values["aws_secret_access_key"],
+		SessionToken:    values["aws_session_token"],
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return Credentials{}, fmt.Errorf("shared credentials: profile %q in %s has no keys: %w", profile, filename, ErrNoCredentials)
+	}
+
+	return creds, nil
+}
+
+// WebIdentityCredentialsProvider retrieves temporary credentials from AWS STS
+// by assuming a role with the web identity token from a file, eg. for IAM roles for service accounts in EKS.
+type WebIdentityCredentialsProvider struct {
+	RoleARN     string        // Default: AWS_ROLE_ARN.
+	TokenFile   string        // Default: AWS_WEB_IDENTITY_TOKEN_FILE.
+	SessionName string        // Default: AWS_ROLE_SESSION_NAME or a generated name.
+	Duration    time.Duration // Default: 1h, as configured for the role.
+
+	// Endpoint of AWS STS. Default: the regional endpoint for AWS_REGION or AWS_DEFAULT_REGION,
+	// or https://sts.amazonaws.com.
+	Endpoint string
+
+	Client *http.Client // Default: http.DefaultClient.
+}
+
+// Retrieve returns the credentials of the assumed role.
+func (p *WebIdentityCredentialsProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	roleARN := p.RoleARN
+	if roleARN == "" {
+		roleARN = os.Getenv("AWS_ROLE_ARN")
+	}
+	tokenFile := p.TokenFile
+	if tokenFile == "" {
+		tokenFile = os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+	}
+	if roleARN == "" || tokenFile == "" {
+		return Credentials{}, fmt.Errorf("web identity: %w", ErrNoCredentials)
+	}
+
+	// The token is read on every call, as it is rotated
+	token, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("web identity: %w", err)
+	}
+
+	sessionName := p.SessionName
+	if sessionName == "" {
+		sessionName = os.Getenv("AWS_ROLE_SESSION_NAME")
+	}
+	if sessionName == "" {
+		sessionName = "opensearch-go-" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	form := url.Values{
+		"Action":           {"AssumeRoleWithWebIdentity"},
+		"Version":          {"2011-06-15"},
+		"RoleArn":          {roleARN},
+		"RoleSessionName":  {sessionName},
+		"WebIdentityToken": {string(bytes.TrimSpace(token))},
+	}
+	if p.Duration > 0 {
+		form.Set("DurationSeconds", strconv.Itoa(int(p.Duration/time.Second)))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Credentials{}, fmt.Errorf("web identity: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("web identity: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("web identity: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		var e struct {
+			Error struct {
+				Code    string `xml:"Code"`
+				Message string `xml:"Message"`
+			} `xml:"Error"`
+		}
+		if xml.Unmarshal(body, &e) == nil && e.Error.Code != "" {
+			return Credentials{}, fmt.Errorf("web identity: %s: %s: %s", res.Status, e.Error.Code, e.Error.Message)
+		}
+		return Credentials{}, fmt.Errorf("web identity: %s", res.Status)
+	}
+
+	var r struct {
+		Credentials struct {
+			AccessKeyID     string    `xml:"AccessKeyId"`
+			SecretAccessKey string    `xml:"SecretAccessKey"`
+			SessionToken    string    `xml:"SessionToken"`
+			Expiration      time.Time `xml:"Expiration"`
+		} `xml:"AssumeRoleWithWebIdentityResult>Credentials"`
+	}
+	if err := xml.Unmarshal(body, &r); err != nil {
+		return Credentials{}, fmt.Errorf("web identity: cannot decode response: %w", err)
+	}
+
+	return Credentials{
+		AccessKeyID:     r.Credentials.AccessKeyID,
+		SecretAccessKey: r.Credentials.SecretAccessKey,
+		SessionToken:    r.Credentials.SessionToken,
+		Expires:         r.Credentials.Expiration,
+	}, nil
+}
+
+func (p *WebIdentityCredentialsProvider) endpoint() string {
+	if p.Endpoint != "" {
+		return p.Endpoint
+	}
+	if region := firstEnv("AWS_REGION", "AWS_DEFAULT_REGION"); region != "" {
+		return "https://sts." + region + ".amazonaws.com"
+	}
+	return "https://sts.amazonaws.com"
+}
+
+// NewChainCredentialsProvider returns a provider which returns the credentials
+// of the first provider to succeed.
+func NewChainCredentialsProvider(providers ...CredentialsProvider) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		var errs []string
+		for _, p := range providers {
+			creds, err := p.Retrieve(ctx)
+			if err == nil {
+				return creds, nil
+			}
+			if ctx.Err() != nil {
+				return Credentials{}, ctx.Err()
+			}
+			errs = append(errs, err.Error())
+		}
+		return Credentials{}, fmt.Errorf("%w: %s", ErrNoCredentials, strings.Join(errs, "; "))
+	})
+}
+
+// cachedProvider caches the credentials until shortly before they expire.
+type cachedProvider struct {
+	provider CredentialsProvider
+	window   time.Duration
+	refresh  time.Duration
+	sem      chan struct{}
+	creds    *Credentials
+	expires  time.Time
+
+	now func() time.Time
+}
+
+// NewCachedCredentialsProvider returns a provider which caches the credentials of p
+// and retrieves them again once they expire within the window.
+//
+// The credentials with no expiry, eg. from the environment or the shared credentials file,
+// are retrieved again every 5 minutes, so that rotated credentials are picked up.
+func NewCachedCredentialsProvider(p CredentialsProvider, window time.Duration) CredentialsProvider {
+	if _, ok := p.(*cachedProvider); ok {
+		return p
+	}
+	return &cachedProvider{
+		provider: p,
+		window:   window,
+		refresh:  defaultRefreshInterval,
+		sem:      make(chan struct{}, 1),
+		now:      time.Now,
+	}
+}
+
+// Retrieve returns the cached credentials, or retrieves them when expired.
+// Concurrent calls wait for a single retrieval, unless their context is done.
+func (c *cachedProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	select {
+	case c.sem <- struct{}{}:
+	case <-ctx.Done():
+		return Credentials{}, ctx.Err()
+	}
+	defer func() { <-c.sem }()
+
+	if c.creds != nil && c.now().Before(c.expires) {
+		return *c.creds, nil
+	}
+
+	creds, err := c.provider.Retrieve(ctx)
+	if err != nil {
+		return Credentials{}, err
+	}
+	c.creds = &creds
+	if creds.Expires.IsZero() {
+		c.expires = c.now().Add(c.refresh)
+	} else {
+		c.expires = creds.Expires.Add(-c.window)
+	}
+
+	return creds, nil
+}
+
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// parseINISection returns the keys of the section in an INI file.
+func parseINISection(b []byte, section string) (map[string]string, bool) {
+	var (
+		found   bool
+		current string
+		values  = make(map[string]string)
+		scanner = bufio.NewScanner(bytes.NewReader(b))
+	)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			if current == section {
+				found = true
+			}
+			continue
+		}
+
+		if current != section {
+			continue
+		}
+		if i := strings.IndexByte(line, '='); i > 0 {
+			values[strings.ToLower(strings.TrimSpace(line[:i]))] = strings.TrimSpace(line[i+1:])
+		}
+	}
+
+	return values, found
+}
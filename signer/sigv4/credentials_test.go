@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package sigv4
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setenv(t *testing.T, env map[string]string) {
+	t.Helper()
+	for k, v := range env {
+		prev, ok := os.LookupEnv(k)
+		os.Setenv(k, v)
+		k := k
+		t.Cleanup(func() {
+			if ok {
+				os.Setenv(k, prev)
+			} else {
+				os.Unsetenv(k)
+			}
+		})
+	}
+}
+
+func TestEnvCredentialsProvider(t *testing.T) {
+	setenv(t, map[string]string{"AWS_ACCESS_KEY_ID": "", "AWS_ACCESS_KEY": "", "AWS_SECRET_ACCESS_KEY": "", "AWS_SECRET_KEY": ""})
+
+	_, err := EnvCredentialsProvider{}.Retrieve(context.Background())
+	assert.ErrorIs(t, err, ErrNoCredentials)
+
+	setenv(t, map[string]string{"AWS_ACCESS_KEY": "AKID", "AWS_SECRET_ACCESS_KEY": "SECRET_KEY", "AWS_SESSION_TOKEN": "TOKEN"})
+
+	creds, err := EnvCredentialsProvider{}.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET_KEY", SessionToken: "TOKEN"}, creds)
+}
+
+func TestSharedCredentialsProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(path, []byte(`
+# comment
+[default]
+aws_access_key_id = AKID
+aws_secret_access_key = SECRET_KEY
+
+[other]
+aws_access_key_id=AKID2
+aws_secret_access_key=SECRET_KEY2
+aws_session_token=TOKEN2
+`), 0o600))
+
+	setenv(t, map[string]string{"AWS_SHARED_CREDENTIALS_FILE": path, "AWS_PROFILE": ""})
+
+	creds, err := (&SharedCredentialsProvider{}).Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET_KEY"}, creds)
+
+	creds, err = (&SharedCredentialsProvider{Filename: path, Profile: "other"}).Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{AccessKeyID: "AKID2", SecretAccessKey: "SECRET_KEY2", SessionToken: "TOKEN2"}, creds)
+
+	_, err = (&SharedCredentialsProvider{Profile: "missing"}).Retrieve(context.Background())
+	assert.ErrorIs(t, err, ErrNoCredentials)
+
+	_, err = (&SharedCredentialsProvider{Filename: filepath.Join(t.TempDir(), "missing")}).Retrieve(context.Background())
+	assert.ErrorIs(t, err, ErrNoCredentials)
+}
+
+func TestWebIdentityCredentialsProvider(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("WEB_IDENTITY_TOKEN\n"), 0o600))
+
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		form, _ = url.ParseQuery(string(body))
+
+		if form.Get("RoleArn") == "arn:aws:iam::123456789012:role/denied" {
+			w.WriteHeader(http.StatusForbidden)
+			io.WriteString(w, `<ErrorResponse><Error><Code>AccessDenied</Code><Message>Not authorized</Message></Error></ErrorResponse>`)
+			return
+		}
+
+		io.WriteString(w, `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>ASIAEXAMPLE</AccessKeyId>
+      <SecretAccessKey>SECRET_KEY</SecretAccessKey>
+      <SessionToken>TOKEN</SessionToken>
+      <Expiration>2023-01-01T01:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+</AssumeRoleWithWebIdentityResponse>`)
+	}))
+	defer server.Close()
+
+	t.Run("not configured", func(t *testing.T) {
+		setenv(t, map[string]string{"AWS_ROLE_ARN": "", "AWS_WEB_IDENTITY_TOKEN_FILE": ""})
+
+		_, err := (&WebIdentityCredentialsProvider{}).Retrieve(context.Background())
+		assert.ErrorIs(t, err, ErrNoCredentials)
+	})
+
+	t.Run("assume role", func(t *testing.T) {
+		setenv(t, map[string]string{
+			"AWS_ROLE_ARN":                "arn:aws:iam::123456789012:role/opensearch",
+			"AWS_WEB_IDENTITY_TOKEN_FILE": tokenFile,
+			"AWS_ROLE_SESSION_NAME":       "session",
+		})
+
+		p := &WebIdentityCredentialsProvider{Endpoint: server.URL, Duration: 15 * time.Minute}
+		creds, err := p.Retrieve(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, Credentials{
+			AccessKeyID:     "ASIAEXAMPLE",
+			SecretAccessKey: "SECRET_KEY",
+			SessionToken:    "TOKEN",
+			Expires:         time.Date(2023, time.January, 1, 1, 0, 0, 0, time.UTC),
+		}, creds)
+
+		assert.Equal(t, "AssumeRoleWithWebIdentity", form.Get("Action"))
+		assert.Equal(t, "arn:aws:iam::123456789012:role/opensearch", form.Get("RoleArn"))
+		assert.Equal(t, "session", form.Get("RoleSessionName"))
+		assert.Equal(t, "WEB_IDENTITY_TOKEN", form.Get("WebIdentityToken"))
+		assert.Equal(t, "900", form.Get("DurationSeconds"))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		p := &WebIdentityCredentialsProvider{
+			RoleARN:   "arn:aws:iam::123456789012:role/denied",
+			TokenFile: tokenFile,
+			Endpoint:  server.URL,
+		}
+		_, err := p.Retrieve(context.Background())
+		assert.EqualError(t, err, "web identity: 403 Forbidden: AccessDenied: Not authorized")
+	})
+}
+
+func TestChainCredentialsProvider(t *testing.T) {
+	mockErr := errors.New("MOCK ERROR")
+
+	p := NewChainCredentialsProvider(
+		NewStaticCredentialsProvider("", "", ""),
+		CredentialsProviderFunc(func(context.Context) (Credentials, error) { return Credentials{}, mockErr }),
+		NewStaticCredentialsProvider("AKID", "SECRET_KEY", ""),
+	)
+	creds, err := p.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "AKID", creds.AccessKeyID)
+
+	_, err = NewChainCredentialsProvider(NewStaticCredentialsProvider("", "", "")).Retrieve(context.Background())
+	assert.ErrorIs(t, err, ErrNoCredentials)
+}
+
+func TestCachedCredentialsProvider(t *testing.T) {
+	var (
+		retrieved int
+		now       = time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	p := NewCachedCredentialsProvider(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		retrieved++
+		return Credentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET_KEY", Expires: now.Add(time.Hour)}, nil
+	}), 5*time.Minute)
+	p.(*cachedProvider).now = func() time.Time { return now }
+
+	assert.Same(t, p, NewCachedCredentialsProvider(p, time.Minute))
+
+	for i := 0; i < 3; i++ {
+		_, err := p.Retrieve(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, retrieved)
+
+	now = now.Add(56 * time.Minute)
+	_, err := p.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, retrieved, "Expected the credentials to be refreshed within the expiry window")
+
+	t.Run("no expiry", func(t *testing.T) {
+		var accessKeyID string
+		p := NewCachedCredentialsProvider(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+			return Credentials{AccessKeyID: accessKeyID, SecretAccessKey: "SECRET_KEY"}, nil
+		}), 5*time.Minute)
+		p.(*cachedProvider).now = func() time.Time { return now }
+
+		accessKeyID = "AKID"
+		creds, err := p.Retrieve(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "AKID", creds.AccessKeyID)
+
+		accessKeyID = "ROTATED_AKID"
+		now = now.Add(4 * time.Minute)
+		creds, err = p.Retrieve(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "AKID", creds.AccessKeyID)
+
+		now = now.Add(time.Minute)
+		creds, err = p.Retrieve(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "ROTATED_AKID", creds.AccessKeyID, "Expected the credentials to be retrieved again after the refresh interval")
+	})
+
+	t.Run("context", func(t *testing.T) {
+		c := p.(*cachedProvider)
+		c.sem <- struct{}{}
+		defer func() { <-c.sem }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := p.Retrieve(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
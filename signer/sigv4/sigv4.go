@@ -0,0 +1,336 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+// Package sigv4 provides a request signer for AWS Signature Version 4 without depending on the AWS SDKs.
+package sigv4
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2/signer"
+	"github.com/opensearch-project/opensearch-go/v2/signer/internal/payload"
+)
+
+var _ signer.ContextSigner = (*Signer)(nil)
+
+const (
+	// OpenSearchService is the signing name of Amazon OpenSearch Service.
+	OpenSearchService = "es"
+	// OpenSearchServerless is the signing name of Amazon OpenSearch Serverless.
+	OpenSearchServerless = "aoss"
+)
+
+const (
+	algorithm   = "AWS4-HMAC-SHA256"
+	timeFormat  = "20060102T150405Z"
+	shortFormat = "20060102"
+
+	headerAuthorization = "Authorization"
+	headerDate          = "X-Amz-Date"
+	headerSecurityToken = "X-Amz-Security-Token"
+)
+
+// ignoredHeaders are not signed, as they may be changed by proxies or the HTTP client.
+var ignoredHeaders = map[string]bool{
+	"authorization":     true,
+	"user-agent":        true,
+	"x-amzn-trace-id":   true,
+	"expect":            true,
+	"transfer-encoding": true,
+}
+
+// Signer signs requests with AWS Signature Version 4.
+// It implements signer.Signer and signer.ContextSigner.
+type Signer struct {
+	region          string
+	service         string
+	credentials     CredentialsProvider
+	unsignedPayload bool
+
+	now func() time.Time
+}
+
+// Option configures the Signer.
+type Option func(*Signer)
+
+// WithUnsignedPayload signs HTTPS requests with UNSIGNED-PAYLOAD instead of the hash of the body.
+// Use it only with services which accept unsigned payloads.
+func WithUnsignedPayload() Option {
+	return func(s *Signer) { s.unsignedPayload = true }
+}
+
+// NewSigner returns a Signer for the region and service, eg. OpenSearchService or OpenSearchServerless.
+//
+// When credentials is nil, the provider returned by NewDefaultCredentialsProvider is used.
+func NewSigner(region, service string, credentials CredentialsProvider, opts ...Option) (*Signer, error) {
+	if strings.TrimSpace(region) == "" {
+		return nil, errors.New("aws region cannot be empty")
+	}
+	if strings.TrimSpace(service) == "" {
+		return nil, errors.New("service cannot be empty")
+	}
+
+	if credentials == nil {
+		credentials = NewDefaultCredentialsProvider()
+	}
+
+	s := &Signer{
+		region:      region,
+		service:     service,
+		credentials: credentials,
+		now:         time.Now,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s, nil
+}
+
+// SignRequest signs the request using SigV4.
+func (s *Signer) SignRequest(req *http.Request) error {
+	return s.SignRequestWithContext(req.Context(), req)
+}
+
+// SignRequestWithContext signs the request using SigV4.
+// The context is used to retrieve the credentials.
+func (s *Signer) SignRequestWithContext(ctx context.Context, req *http.Request) error {
+	creds, err := s.credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve credentials: %w", err)
+	}
+
+	// Add the "X-Amz-Content-Sha256" header as required by Amazon OpenSearch Serverless.
+	hash, err := payload.Hash(req, s.unsignedPayload)
+	if err != nil {
+		return err
+	}
+
+	signHTTP(req, creds, s.region, s.service, hash, s.now().UTC())
+
+	return nil
+}
+
+// signHTTP sets the X-Amz-Date, X-Amz-Security-Token and Authorization headers of the request.
+func signHTTP(req *http.Request, creds Credentials, region, service, payloadHash string, t time.Time) {
+	amzDate := t.Format(timeFormat)
+	scope := strings.Join([]string{t.Format(shortFormat), region, service, "aws4_request"}, "/")
+
+	req.Header.Del(headerAuthorization)
+	req.Header.Set(headerDate, amzDate)
+	if creds.SessionToken != "" {
+		req.Header.Set(headerSecurityToken, creds.SessionToken)
+	} else {
+		req.Header.Del(headerSecurityToken)
+	}
+	sanitizeHost(req)
+
+	creq, signedHeaders := canonicalRequest(req, payloadHash)
+	sts := stringToSign(amzDate, scope, creq)
+
+	key := signingKey(creds.SecretAccessKey, t.Format(shortFormat), region, service)
+	signature := hex.EncodeToString(hmacSHA256(key, sts))
+
+	req.Header.Set(headerAuthorization, algorithm+
+		" Credential="+creds.AccessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+
+		", Signature="+signature)
+}
+
+// canonicalRequest returns the canonical request and the list of signed headers.
+func canonicalRequest(req *http.Request, payloadHash string) (string, string) {
+	headers, values := canonicalHeaders(req)
+
+	var b strings.Builder
+	b.WriteString(req.Method)
+	b.WriteByte('\n')
+	b.WriteString(canonicalURI(req))
+	b.WriteByte('\n')
+	b.WriteString(canonicalQuery(req))
+	b.WriteByte('\n')
+	for i, h := range headers {
+		b.WriteString(h)
+		b.WriteByte(':')
+		b.WriteString(values[i])
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+	b.WriteString(strings.Join(headers, ";"))
+	b.WriteByte('\n')
+	b.WriteString(payloadHash)
+
+	return b.String(), strings.Join(headers, ";")
+}
+
+func stringToSign(amzDate, scope, creq string) string {
+	h := sha256.Sum256([]byte(creq))
+	return algorithm + "\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(h[:])
+}
+
+func signingKey(secret, date, region, service string) []byte {
+	k := hmacSHA256([]byte("AWS4"+secret), date)
+	k = hmacSHA256(k, region)
+	k = hmacSHA256(k, service)
+	return hmacSHA256(k, "aws4_request")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// sanitizeHost removes the default port from the host, as the HTTP client would not send it.
+func sanitizeHost(req *http.Request) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	h, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return
+	}
+	if (port == "80" && req.URL.Scheme == "http") || (port == "443" && req.URL.Scheme == "https") {
+		if strings.Contains(h, ":") {
+			h = "[" + h + "]"
+		}
+		req.Host = h
+	}
+}
+
+// canonicalHeaders returns the sorted lowercase header names, including host, and their canonical values.
+func canonicalHeaders(req *http.Request) ([]string, []string) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	merged := map[string][]string{"host": {host}}
+	for k, vv := range req.Header {
+		name := strings.ToLower(k)
+		if ignoredHeaders[name] || name == "host" {
+			continue
+		}
+		merged[name] = append(merged[name], vv...)
+	}
+
+	names := make([]string, 0, len(merged))
+	for name := range merged {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make([]string, len(names))
+	for i, name := range names {
+		vv := make([]string, len(merged[name]))
+		for j, v := range merged[name] {
+			vv[j] = trimSpaces(v)
+		}
+		values[i] = strings.Join(vv, ",")
+	}
+
+	return names, values
+}
+
+// trimSpaces trims the value and collapses sequential spaces to a single one.
+func trimSpaces(v string) string {
+	v = strings.TrimSpace(v)
+	if !strings.Contains(v, "  ") {
+		return v
+	}
+
+	var (
+		b     strings.Builder
+		space bool
+	)
+	for i := 0; i < len(v); i++ {
+		if v[i] == ' ' {
+			if space {
+				continue
+			}
+			space = true
+		} else {
+			space = false
+		}
+		b.WriteByte(v[i])
+	}
+	return b.String()
+}
+
+// canonicalURI returns the escaped path, escaped once more as required for services other than S3.
+func canonicalURI(req *http.Request) string {
+	path := req.URL.EscapedPath()
+	if path == "" {
+		return "/"
+	}
+	return escape(path, false)
+}
+
+// canonicalQuery returns the escaped query parameters sorted by name and value.
+func canonicalQuery(req *http.Request) string {
+	query := req.URL.Query()
+	if len(query) == 0 {
+		return ""
+	}
+
+	pairs := make([][2]string, 0, len(query))
+	for k, vv := range query {
+		for _, v := range vv {
+			pairs = append(pairs, [2]string{escape(k, true), escape(v, true)})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+
+	params := make([]string, len(pairs))
+	for i, p := range pairs {
+		params[i] = p[0] + "=" + p[1]
+	}
+
+	return strings.Join(params, "&")
+}
+
+// escape percent-encodes every byte except the unreserved characters, and the slash unless encodeSlash is set.
+func escape(s string, encodeSlash bool) string {
+	const hexChars = "0123456789ABCDEF"
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isUnreserved(c) || (c == '/' && !encodeSlash) {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(hexChars[c>>4])
+		b.WriteByte(hexChars[c&15])
+	}
+	return b.String()
+}
+
+func isUnreserved(c byte) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+		c == '-' || c == '_' || c == '.' || c == '~'
+}
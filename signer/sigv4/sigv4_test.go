@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// The OpenSearch Contributors require contributions made to
+// this file be licensed under the Apache-2.0 license or a
+// compatible open source license.
+//
+// Modifications Copyright OpenSearch Contributors. See
+// GitHub history for details.
+
+package sigv4
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// suiteCredentials and suiteTime are used by the AWS SigV4 test suite.
+var (
+	suiteCredentials = Credentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+	suiteTime        = time.Date(2015, time.August, 30, 12, 36, 0, 0, time.UTC)
+)
+
+// parseSuiteRequest parses a request in the format of the test suite,
+// keeping the order of repeated headers.
+func parseSuiteRequest(t *testing.T, b []byte) (*http.Request, []byte) {
+	t.Helper()
+
+	var body []byte
+	parts := strings.SplitN(string(b), "\n\n", 2)
+	if len(parts) == 2 {
+		body = []byte(parts[1])
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(parts[0]))
+	scanner.Scan()
+	line := strings.Fields(scanner.Text())
+
+	var (
+		host    string
+		headers [][2]string
+	)
+	for scanner.Scan() {
+		i := strings.IndexByte(scanner.Text(), ':')
+		k, v := scanner.Text()[:i], scanner.Text()[i+1:]
+		if strings.EqualFold(k, "host") {
+			host = v
+			continue
+		}
+		headers = append(headers, [2]string{k, v})
+	}
+
+	req, err := http.NewRequest(line[0], "https://"+host+line[1], bytes.NewReader(body))
+	require.NoError(t, err)
+	for _, h := range headers {
+		req.Header.Add(h[0], h[1])
+	}
+
+	return req, body
+}
+
+func TestSuite(t *testing.T) {
+	dirs, err := filepath.Glob("testdata/*")
+	require.NoError(t, err)
+	require.NotEmpty(t, dirs)
+
+	read := func(t *testing.T, dir, ext string) string {
+		b, err := os.ReadFile(filepath.Join(dir, filepath.Base(dir)+ext))
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	for _, dir := range dirs {
+		dir := dir
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			req, body := parseSuiteRequest(t, []byte(read(t, dir, ".req")))
+
+			creds := suiteCredentials
+			creds.SessionToken = req.Header.Get(headerSecurityToken)
+
+			sum := sha256.Sum256(body)
+			hash := hex.EncodeToString(sum[:])
+
+			creq, _ := canonicalRequest(req, hash)
+			assert.Equal(t, read(t, dir, ".creq"), creq)
+
+			scope := "20150830/us-east-1/service/aws4_request"
+			assert.Equal(t, read(t, dir, ".sts"), stringToSign(suiteTime.Format(timeFormat), scope, creq))
+
+			signHTTP(req, creds, "us-east-1", "service", hash, suiteTime)
+			assert.Equal(t, read(t, dir, ".authz"), req.Header.Get("Authorization"))
+		})
+	}
+}
+
+func TestSigner(t *testing.T) {
+	t.Run("new signer fails", func(t *testing.T) {
+		_, err := NewSigner("", OpenSearchService, nil)
+		assert.EqualError(t, err, "aws region cannot be empty")
+
+		_, err = NewSigner("us-west-2", " ", nil)
+		assert.EqualError(t, err, "service cannot be empty")
+	})
+
+	t.Run("sign request", func(t *testing.T) {
+		s, err := NewSigner("us-west-2", OpenSearchServerless, NewStaticCredentialsProvider("AKID", "SECRET_KEY", "TOKEN"))
+		require.NoError(t, err)
+		s.now = func() time.Time { return suiteTime }
+
+		req, _ := http.NewRequest(http.MethodPost, "https://localhost:443/_bulk", strings.NewReader("some data"))
+		req.Header.Set("User-Agent", "opensearch-go")
+		require.NoError(t, s.SignRequest(req))
+
+		assert.Equal(t, "localhost", req.Host)
+		assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+		assert.Equal(t, "TOKEN", req.Header.Get("X-Amz-Security-Token"))
+		assert.Equal(t, "1307990e6ba5ca145eb35e99182a9bec46531bc54ddf656a602c780fa0240dee", req.Header.Get("X-Amz-Content-Sha256"))
+
+		authz := req.Header.Get("Authorization")
+		assert.True(t, strings.HasPrefix(authz, "AWS4-HMAC-SHA256 Credential=AKID/20150830/us-west-2/aoss/aws4_request, "), authz)
+		assert.Contains(t, authz, "SignedHeaders=host;x-amz-content-sha256;x-amz-date;x-amz-security-token,")
+
+		// Signing again, eg. on retry, gives the same signature
+		require.NoError(t, s.SignRequest(req))
+		assert.Equal(t, authz, req.Header.Get("Authorization"))
+	})
+
+	t.Run("sign request with unsigned payload", func(t *testing.T) {
+		s, err := NewSigner("us-west-2", OpenSearchServerless, NewStaticCredentialsProvider("AKID", "SECRET_KEY", ""), WithUnsignedPayload())
+		require.NoError(t, err)
+
+		req, _ := http.NewRequest(http.MethodPost, "https://localhost:9200/_bulk", strings.NewReader("some data"))
+		require.NoError(t, s.SignRequest(req))
+
+		assert.Equal(t, "UNSIGNED-PAYLOAD", req.Header.Get("X-Amz-Content-Sha256"))
+		assert.Empty(t, req.Header.Get("X-Amz-Security-Token"))
+		assert.NotEmpty(t, req.Header.Get("Authorization"))
+	})
+
+	t.Run("sign request fails without credentials", func(t *testing.T) {
+		s, err := NewSigner("us-west-2", OpenSearchService, NewStaticCredentialsProvider("", "", ""))
+		require.NoError(t, err)
+
+		req, _ := http.NewRequest(http.MethodGet, "https://localhost:9200", nil)
+		err = s.SignRequestWithContext(context.Background(), req)
+		assert.ErrorIs(t, err, ErrNoCredentials)
+		assert.Empty(t, req.Header.Get("Authorization"))
+	})
+}